@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	getKMatrix "github.com/MatProGo-dev/SymbolicMath.go/get/KMatrix"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+evaluate1.go
+Description:
+
+	This script is meant to show how the Evaluate
+	method can be used to compute the numerical value of your
+	expressions. In this case, we will use it to evaluate
+	a quadratic expression at the point [-3.0, 1.0].
+
+	The result should be a value of 10.0.
+*/
+
+func main() {
+	// Construct quadratic expression
+	N := 2
+	x := symbolic.NewVariableVector(N)
+	Q := getKMatrix.From(
+		symbolic.Identity(N))
+
+	// Create the quadratic polynomial
+	quadPoly := x.Transpose().Multiply(Q).Multiply(x).(symbolic.ScalarExpression)
+	fmt.Println("Polynomial is:", quadPoly)
+
+	// Evaluate with a map of values
+	values := map[symbolic.Variable]float64{
+		x[0]: -3.0,
+		x[1]: 1.0,
+	}
+	fmt.Println("Polynomial at the point x = [ -3 , 1 ] is", quadPoly.Evaluate(values))
+
+	// Evaluate with a vector of values (ordered according to x)
+	x0 := mat.NewVecDense(N, []float64{-3.0, 1.0})
+	fmt.Println("Polynomial at the point x = [ -3 , 1 ] is", quadPoly.EvaluateAt(*x0, x))
+}
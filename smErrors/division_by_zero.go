@@ -0,0 +1,25 @@
+package smErrors
+
+import "fmt"
+
+/*
+division_by_zero.go
+Description:
+
+	Functions related to the division by zero error.
+*/
+
+// Type Definition
+type DivisionByZeroError struct {
+	Operation string
+	Numerator interface{}
+}
+
+// Error
+func (e DivisionByZeroError) Error() string {
+	return fmt.Sprintf(
+		"division by zero in operation %v (the numerator was %v)",
+		e.Operation,
+		e.Numerator,
+	)
+}
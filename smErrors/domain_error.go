@@ -0,0 +1,25 @@
+package smErrors
+
+import "fmt"
+
+/*
+domain_error.go
+Description:
+
+	Functions related to the domain error.
+*/
+
+// Type Definition
+type DomainError struct {
+	Function string
+	Argument float64
+}
+
+// Error
+func (e DomainError) Error() string {
+	return fmt.Sprintf(
+		"domain error: the function %v is not defined at %v",
+		e.Function,
+		e.Argument,
+	)
+}
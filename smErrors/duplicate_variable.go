@@ -0,0 +1,25 @@
+package smErrors
+
+import "fmt"
+
+/*
+duplicate_variable.go
+Description:
+	Defines the DuplicateVariableError which is used when a variable can not be
+	tracked by an environment because its ID or its name is already in use.
+*/
+
+type DuplicateVariableError struct {
+	EnvironmentName string
+	ID              uint64
+	Name            string
+}
+
+func (dve DuplicateVariableError) Error() string {
+	return fmt.Sprintf(
+		"environment \"%v\" already tracks a variable with the ID %v or the name \"%v\"",
+		dve.EnvironmentName,
+		dve.ID,
+		dve.Name,
+	)
+}
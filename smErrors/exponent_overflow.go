@@ -0,0 +1,23 @@
+package smErrors
+
+import "fmt"
+
+/*
+exponent_overflow.go
+Description:
+
+	Functions related to the exponent overflow error.
+*/
+
+// Type Definition
+type ExponentOverflowError struct {
+	Exponent int
+}
+
+// Error
+func (e ExponentOverflowError) Error() string {
+	return fmt.Sprintf(
+		"received exponent (%v) whose negation overflows int; expected an exponent greater than the minimum int",
+		e.Exponent,
+	)
+}
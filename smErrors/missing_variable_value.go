@@ -0,0 +1,23 @@
+package smErrors
+
+import "fmt"
+
+/*
+missing_variable_value.go
+Description:
+	Defines the MissingVariableValueError which is used when an expression
+	is evaluated, but no value was provided for one of its variables.
+*/
+
+type MissingVariableValueError struct {
+	Variable   interface{}
+	Expression interface{}
+}
+
+func (mvve MissingVariableValueError) Error() string {
+	return fmt.Sprintf(
+		"missing variable value error: no value was provided for variable %v while evaluating an expression of type %T",
+		mvve.Variable,
+		mvve.Expression,
+	)
+}
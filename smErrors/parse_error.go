@@ -0,0 +1,25 @@
+package smErrors
+
+import "fmt"
+
+/*
+parse_error.go
+Description:
+	Defines the ParseError which is used when a string can not be parsed
+	into an expression or constraint.
+*/
+
+type ParseError struct {
+	Input    string
+	Position int
+	Message  string
+}
+
+func (pe ParseError) Error() string {
+	return fmt.Sprintf(
+		"parse error at position %v of \"%v\": %v",
+		pe.Position,
+		pe.Input,
+		pe.Message,
+	)
+}
@@ -0,0 +1,23 @@
+package smErrors
+
+import "fmt"
+
+/*
+quadratic_expression_required.go
+Description:
+	Defines the QuadraticExpressionRequiredError which is used when an operation
+	requires an expression of degree at most 2, but receives one of higher degree.
+*/
+
+type QuadraticExpressionRequiredError struct {
+	Operation  string
+	Expression interface{}
+}
+
+func (qere QuadraticExpressionRequiredError) Error() string {
+	return fmt.Sprintf(
+		"Quadratic expression required for operation %v; received an expression which is not quadratic (%T).",
+		qere.Operation,
+		qere.Expression,
+	)
+}
@@ -0,0 +1,32 @@
+package smErrors
+
+import "fmt"
+
+/*
+symbolic_error.go
+Description:
+
+	Functions related to the symbolic error, which wraps the errors of the symbolic
+	package that do not have a more specific type in this package.
+*/
+
+// Type Definition
+type SymbolicError struct {
+	Err error
+}
+
+// Errorf Creates a SymbolicError whose message is formatted as in fmt.Errorf
+// (including the wrapping of the errors given with %w).
+func Errorf(format string, a ...interface{}) error {
+	return SymbolicError{Err: fmt.Errorf(format, a...)}
+}
+
+// Error
+func (se SymbolicError) Error() string {
+	return se.Err.Error()
+}
+
+// Unwrap Returns the wrapped error.
+func (se SymbolicError) Unwrap() error {
+	return se.Err
+}
@@ -0,0 +1,27 @@
+package smErrors
+
+import "fmt"
+
+/*
+univariate_polynomial_required.go
+Description:
+
+	Functions related to the univariate polynomial required error.
+*/
+
+// Type Definition
+type UnivariatePolynomialRequiredError struct {
+	Operation  string
+	Expression interface{}
+	Variable   interface{}
+}
+
+// Error
+func (upre UnivariatePolynomialRequiredError) Error() string {
+	return fmt.Sprintf(
+		"Univariate polynomial in %v required for operation %v; received %v.",
+		upre.Variable,
+		upre.Operation,
+		upre.Expression,
+	)
+}
@@ -0,0 +1,25 @@
+package smErrors
+
+import "fmt"
+
+/*
+unsupported_constraint.go
+Description:
+	This file defines an error for when an operation receives a type of constraint
+	that it can not handle (e.g., a second-order cone constraint, which can not be
+	decomposed into scalar constraints).
+*/
+
+// Error Definition
+type UnsupportedConstraintError struct {
+	Operation  string
+	Constraint interface{}
+}
+
+func (uce UnsupportedConstraintError) Error() string {
+	return fmt.Sprintf(
+		"unsupported constraint error: %v does not support constraints of type %T",
+		uce.Operation,
+		uce.Constraint,
+	)
+}
@@ -1,10 +1,23 @@
 package symbolic
 
+import "sync"
+
 // BasicEnvironment is a simple implementation of the Environment interface
-// that tracks variables in a slice.
+// that tracks variables in a slice (indexed by ID and by name).
+// Its methods are safe for concurrent use; the environment itself must not be
+// copied after first use (pass a *BasicEnvironment, as with the DefaultEnvironment).
 type BasicEnvironment struct {
-	name      string
+	name string
+
+	// Variables lists the tracked variables in the order they were added.
+	// It must not be modified directly (use TrackVariable instead), otherwise the
+	// lookups and the allocated IDs get out of sync with it.
 	Variables []Variable
+
+	mu     sync.RWMutex
+	byID   map[uint64]int
+	byName map[string]int
+	nextID uint64
 }
 
 // GetName returns the name of the environment.
@@ -13,33 +26,146 @@ func (be *BasicEnvironment) GetName() string {
 }
 
 // TrackVariable adds the variable to the environment if it is not already
-// tracked. Returns true if the variable was added, false if it already exists.
+// tracked. Returns true if the variable was added, false if a variable with the
+// same ID or the same (non-empty) name already exists.
 func (be *BasicEnvironment) TrackVariable(v Variable) bool {
-	// Check if the variable is already in the environment
-	for _, existingVar := range be.Variables {
-		if existingVar.ID == v.ID {
-			// Variable already exists, do not add it again
-			return false
-		}
+	be.mu.Lock()
+	defer be.mu.Unlock()
+
+	be.initializeIndices()
+
+	// Check if the variable (or its name) is already in the environment
+	if _, found := be.byID[v.ID]; found {
+		return false
+	}
+
+	if _, found := be.byName[v.Name]; found && v.Name != "" {
+		return false
 	}
 
 	// Add the variable to the environment
 	be.Variables = append(be.Variables, v)
+	be.indexVariable(len(be.Variables) - 1)
 	return true // Variable was added successfully
 }
 
-// AllTrackedVariables returns a slice of all variables tracked by the environment.
+// initializeIndices creates the maps that index the variables of the environment
+// if they do not exist yet (i.e., in a zero BasicEnvironment{}).
+// The caller must hold the write lock.
+func (be *BasicEnvironment) initializeIndices() {
+	if be.byID != nil && be.byName != nil {
+		return
+	}
+
+	be.byID = map[uint64]int{}
+	be.byName = map[string]int{}
+	for ii := range be.Variables {
+		be.indexVariable(ii)
+	}
+}
+
+// indexVariable adds the (ii)th element of Variables to the indices and makes sure
+// that the IDs allocated later do not collide with it.
+// The caller must hold the write lock.
+func (be *BasicEnvironment) indexVariable(ii int) {
+	v := be.Variables[ii]
+	be.byID[v.ID] = ii
+	if v.Name != "" {
+		be.byName[v.Name] = ii
+	}
+
+	if v.ID >= be.nextID {
+		be.nextID = v.ID + 1
+	}
+}
+
+// AllTrackedVariables returns a copy of the slice of all variables tracked by the environment.
 func (be *BasicEnvironment) AllTrackedVariables() []Variable {
-	return be.Variables
+	be.mu.RLock()
+	defer be.mu.RUnlock()
+
+	return append([]Variable{}, be.Variables...)
+}
+
+// AllocateVariableID reserves and returns the next unused variable ID.
+func (be *BasicEnvironment) AllocateVariableID() uint64 {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+
+	be.initializeIndices()
+
+	id := be.nextID
+	be.nextID++
+	return id
+}
+
+// VariableByID returns the tracked variable with the given ID.
+// The boolean is false if no such variable exists.
+func (be *BasicEnvironment) VariableByID(id uint64) (Variable, bool) {
+	be.mu.RLock()
+	defer be.mu.RUnlock()
+
+	if be.byID == nil || be.byName == nil {
+		// Not indexed yet (zero BasicEnvironment{} that never tracked a variable)
+		for _, v := range be.Variables {
+			if v.ID == id {
+				return v, true
+			}
+		}
+		return Variable{}, false
+	}
+
+	idx, found := be.byID[id]
+	if !found {
+		return Variable{}, false
+	}
+	return be.Variables[idx], true
+}
+
+// VariableByName returns the tracked variable with the given name.
+// The boolean is false if no such variable exists.
+func (be *BasicEnvironment) VariableByName(name string) (Variable, bool) {
+	be.mu.RLock()
+	defer be.mu.RUnlock()
+
+	if be.byID == nil || be.byName == nil {
+		// Not indexed yet (zero BasicEnvironment{} that never tracked a variable)
+		for _, v := range be.Variables {
+			if v.Name == name {
+				return v, true
+			}
+		}
+		return Variable{}, false
+	}
+
+	idx, found := be.byName[name]
+	if !found {
+		return Variable{}, false
+	}
+	return be.Variables[idx], true
 }
 
 // MakeBasicEnvironment creates a new BasicEnvironment with the given name.
+// Store the result and pass its address as the Environment (or use NewBasicEnvironment).
 func MakeBasicEnvironment(nameIn string) BasicEnvironment {
 	return BasicEnvironment{
 		name:      nameIn,
 		Variables: []Variable{},
+		byID:      map[uint64]int{},
+		byName:    map[string]int{},
+	}
+}
+
+// NewBasicEnvironment creates a new BasicEnvironment with the given name
+// and returns a pointer to it (which can be used directly as an Environment).
+func NewBasicEnvironment(nameIn string) *BasicEnvironment {
+	return &BasicEnvironment{
+		name:      nameIn,
+		Variables: []Variable{},
+		byID:      map[uint64]int{},
+		byName:    map[string]int{},
 	}
 }
 
 // DefaultEnvironment A variable that exists in the background and used to store information about the variables currently created.
-var DefaultEnvironment = MakeBasicEnvironment("DefaultEnvironment")
+var DefaultEnvironment = NewBasicEnvironment("DefaultEnvironment")
@@ -0,0 +1,244 @@
+package symbolic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+/*
+canonical.go
+Description:
+	Functions used to put expressions in a canonical form and to compare them.
+	In canonical form, the factors of each monomial are sorted by variable ID (with
+	repeated factors merged and zero exponents removed) and the terms of each
+	polynomial are combined and sorted in graded lexicographic order: terms of higher
+	degree come first, and terms of the same degree are ordered by the exponent of the
+	variable with the lowest ID, then of the next variable, and so on
+	(e.g., x_0^2 + x_0 x_1 + x_1^2 + x_0 + x_1 + 1).
+*/
+
+// canonicalMonomial Returns the monomial m with its factors sorted by variable ID,
+// repeated factors merged and zero exponents removed.
+func canonicalMonomial(m Monomial) Monomial {
+	factors := m.sortedFactors()
+
+	mOut := Monomial{
+		Coefficient:     m.Coefficient,
+		VariableFactors: make([]Variable, len(factors)),
+		Exponents:       make([]int, len(factors)),
+	}
+	for ii, factor := range factors {
+		mOut.VariableFactors[ii] = factor.Variable
+		mOut.Exponents[ii] = factor.Exponent
+	}
+
+	return mOut
+}
+
+// gradedLexLess Returns true if the canonical monomial m1 comes before the
+// canonical monomial m2 in graded lexicographic order.
+func gradedLexLess(m1, m2 Monomial) bool {
+	degree1, degree2 := m1.Degree(), m2.Degree()
+	if degree1 != degree2 {
+		return degree1 > degree2
+	}
+
+	for ii := 0; ii < len(m1.VariableFactors) && ii < len(m2.VariableFactors); ii++ {
+		id1, id2 := m1.VariableFactors[ii].ID, m2.VariableFactors[ii].ID
+		if id1 != id2 {
+			// The monomial that contains the variable with the lower ID comes first
+			return id1 < id2
+		}
+
+		if m1.Exponents[ii] != m2.Exponents[ii] {
+			return m1.Exponents[ii] > m2.Exponents[ii]
+		}
+	}
+
+	return false
+}
+
+// canonicalPolynomial Returns the polynomial p with its matching terms combined,
+// each monomial in canonical form and the terms sorted in graded lexicographic order.
+func canonicalPolynomial(p Polynomial) Polynomial {
+	simplified := p.Simplify()
+
+	pOut := Polynomial{Monomials: make([]Monomial, len(simplified.Monomials))}
+	for ii, monomial := range simplified.Monomials {
+		pOut.Monomials[ii] = canonicalMonomial(monomial)
+	}
+
+	sort.SliceStable(pOut.Monomials, func(i, j int) bool {
+		return gradedLexLess(pOut.Monomials[i], pOut.Monomials[j])
+	})
+
+	return pOut
+}
+
+// monomialsOf Returns the monomials of the polynomial-like scalar expression se.
+// The boolean is false if se is not polynomial-like.
+func monomialsOf(se ScalarExpression) ([]Monomial, bool) {
+	switch concrete := se.(type) {
+	case K:
+		return []Monomial{concrete.ToMonomial()}, true
+	case Variable:
+		return []Monomial{concrete.ToMonomial()}, true
+	case Monomial:
+		return []Monomial{concrete}, true
+	case Polynomial:
+		return concrete.Monomials, true
+	default:
+		return nil, false
+	}
+}
+
+// scalarsAreEqual Returns true if the coefficients of the matching terms of
+// left and right differ by at most tol. Function expressions are only equal to
+// other function expressions (see functionExpressionsAreEqual) and rational
+// expressions are compared after multiplying each side by the other's denominator.
+func scalarsAreEqual(left, right ScalarExpression, tol float64) bool {
+	leftFE, leftIsFE := left.(FunctionExpression)
+	rightFE, rightIsFE := right.(FunctionExpression)
+	if leftIsFE || rightIsFE {
+		return leftIsFE && rightIsFE && functionExpressionsAreEqual(leftFE, rightFE, tol)
+	}
+
+	leftRE, leftIsRE := left.(RationalExpression)
+	rightRE, rightIsRE := right.(RationalExpression)
+	if leftIsRE || rightIsRE {
+		// Compare the cross products n1 d2 and n2 d1
+		if !leftIsRE {
+			leftRE = RationalExpression{Numerator: toPolynomial(left), Denominator: K(1.0).ToPolynomial()}
+		}
+		if !rightIsRE {
+			rightRE = RationalExpression{Numerator: toPolynomial(right), Denominator: K(1.0).ToPolynomial()}
+		}
+		return scalarsAreEqual(
+			leftRE.Numerator.Multiply(rightRE.Denominator).(ScalarExpression),
+			rightRE.Numerator.Multiply(leftRE.Denominator).(ScalarExpression),
+			tol,
+		)
+	}
+
+	leftMonomials, leftIsPolynomialLike := monomialsOf(left)
+	rightMonomials, rightIsPolynomialLike := monomialsOf(right)
+	if !leftIsPolynomialLike || !rightIsPolynomialLike {
+		return false
+	}
+
+	difference := make(map[MonomialKey]float64, len(leftMonomials)+len(rightMonomials))
+	for _, monomial := range leftMonomials {
+		difference[monomial.Key()] += monomial.Coefficient
+	}
+	for _, monomial := range rightMonomials {
+		difference[monomial.Key()] -= monomial.Coefficient
+	}
+
+	for _, coefficient := range difference {
+		if math.Abs(coefficient) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+// functionExpressionsAreEqual Returns true if the canonical forms of left and right
+// apply the same function to arguments which are equal (up to tol).
+func functionExpressionsAreEqual(left, right FunctionExpression, tol float64) bool {
+	leftCanonical, leftIsFE := left.Canonicalize().(FunctionExpression)
+	rightCanonical, rightIsFE := right.Canonicalize().(FunctionExpression)
+	if !leftIsFE || !rightIsFE {
+		// At least one of the expressions simplified to a polynomial
+		return scalarsAreEqual(left.Canonicalize().(ScalarExpression), right.Canonicalize().(ScalarExpression), tol)
+	}
+
+	if leftCanonical.Function != rightCanonical.Function ||
+		len(leftCanonical.Arguments) != len(rightCanonical.Arguments) {
+		return false
+	}
+
+	for ii := range leftCanonical.Arguments {
+		if !scalarsAreEqual(leftCanonical.Arguments[ii], rightCanonical.Arguments[ii], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// expressionsAreEqual Returns true if left and right have the same dimensions and
+// each pair of corresponding elements represents the same polynomial (with
+// coefficients that differ by at most tol).
+func expressionsAreEqual(left, right Expression, tol float64) bool {
+	// Input Processing
+	err := left.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if right == nil {
+		return false
+	}
+
+	err = right.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	leftDims, rightDims := left.Dims(), right.Dims()
+	if leftDims[0] != rightDims[0] || leftDims[1] != rightDims[1] {
+		return false
+	}
+
+	for ii := 0; ii < leftDims[0]; ii++ {
+		for jj := 0; jj < leftDims[1]; jj++ {
+			if !scalarsAreEqual(left.At(ii, jj), right.At(ii, jj), tol) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// structuralKey Returns a key that identifies the canonical form of the scalar expression se
+// (see Canonicalize): the functions that it applies, the IDs and exponents of its variables
+// and its coefficients. Unlike String(), the key does not depend on the names of the
+// variables or on the formatting of the coefficients.
+func structuralKey(se ScalarExpression) string {
+	var builder strings.Builder
+	writeStructuralKey(&builder, se.Canonicalize().(ScalarExpression))
+	return builder.String()
+}
+
+// writeStructuralKey Writes the structural key of the canonical scalar expression se.
+func writeStructuralKey(builder *strings.Builder, se ScalarExpression) {
+	switch concrete := se.(type) {
+	case FunctionExpression:
+		builder.WriteString(string(concrete.Function))
+		builder.WriteByte('(')
+		for ii, argument := range concrete.Arguments {
+			if ii > 0 {
+				builder.WriteByte(',')
+			}
+			writeStructuralKey(builder, argument)
+		}
+		builder.WriteByte(')')
+	case RationalExpression:
+		builder.WriteString("rational(")
+		writeStructuralKey(builder, concrete.Numerator)
+		builder.WriteByte('/')
+		writeStructuralKey(builder, concrete.Denominator)
+		builder.WriteByte(')')
+	default:
+		// Polynomial-like expressions are written term by term (coefficient and MonomialKey, in hex)
+		monomials, _ := monomialsOf(concrete)
+		builder.WriteByte('[')
+		for _, monomial := range monomials {
+			fmt.Fprintf(builder, "%x:%x;", math.Float64bits(monomial.Coefficient), string(monomial.Key()))
+		}
+		builder.WriteByte(']')
+	}
+}
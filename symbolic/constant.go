@@ -63,6 +63,29 @@ func (c K) LinearCoeff(wrt ...[]Variable) mat.VecDense {
 	return ZerosVector(len(wrtVars))
 }
 
+// QuadraticRepresentation Returns the matrix Q, the vector c and the constant r
+// such that the constant can be written as 0.5 * x^T Q x + c^T x + r.
+// For a constant, Q and c are always zero and r is the constant's value.
+func (c K) QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64) {
+	// Input Processing
+	var wrtVars []Variable = []Variable{}
+	if len(wrt) > 0 {
+		// If the user provided a slice of variables, use that instead
+		wrtVars = wrt[0]
+	}
+
+	if len(wrtVars) == 0 {
+		// If the user didn't provide any variables, then panic!
+		// We cannot construct zero length vectors in gonum
+		panic(
+			smErrors.EmptyLinearCoeffsError{Expression: c},
+		)
+	}
+
+	// Algorithm
+	return *mat.NewSymDense(len(wrtVars), nil), ZerosVector(len(wrtVars)), float64(c)
+}
+
 // Plus adds the current expression to another and returns the resulting expression
 func (c K) Plus(rightIn interface{}) Expression {
 	// Input Processing
@@ -70,7 +93,7 @@ func (c K) Plus(rightIn interface{}) Expression {
 		rightAsE, _ := ToExpression(rightIn)
 		err := rightAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to %v.Plus: %v", c, err))
+			panic(smErrors.Errorf("error in second argument to %v.Plus: %v", c, err))
 		}
 
 		// Dimension checks should be fine because c is a scalar.
@@ -94,11 +117,15 @@ func (c K) Plus(rightIn interface{}) Expression {
 		return right.Plus(c)
 	case Polynomial:
 		return right.Plus(c)
+	case RationalExpression:
+		return right.Plus(c)
+	case FunctionExpression:
+		return sumOf(c, right)
 	case mat.VecDense:
 		return c.Plus(VecDenseToKVector(right))
 	case *mat.VecDense:
 		return c.Plus(VecDenseToKVector(*right))
-	case KVector, VariableVector, MonomialVector, PolynomialVector:
+	case KVector, VariableVector, MonomialVector, PolynomialVector, FunctionExpressionVector:
 		// Convert to VectorExpression
 		ve, _ := ToVectorExpression(right)
 		return ve.Plus(c)
@@ -106,7 +133,7 @@ func (c K) Plus(rightIn interface{}) Expression {
 		return c.Plus(DenseToKMatrix(right))
 	case *mat.Dense:
 		return c.Plus(DenseToKMatrix(*right))
-	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix:
+	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix, FunctionExpressionMatrix:
 		// Convert to MatrixExpression
 		me, _ := ToMatrixExpression(right)
 		return me.Plus(c)
@@ -128,7 +155,7 @@ func (c K) Minus(rightIn interface{}) Expression {
 		rightAsE, _ := ToExpression(rightIn)
 		err := rightAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to %v.Minus: %v", c, err))
+			panic(smErrors.Errorf("error in second argument to %v.Minus: %v", c, err))
 		}
 
 		//err = smErrors.CheckDimensionsInSubtraction(c, rightAsE)
@@ -173,6 +200,11 @@ func (c K) Eq(rightIn interface{}) Constraint {
 	return c.Comparison(rightIn, SenseEqual)
 }
 
+// Between returns the range constraint lower <= c <= upper.
+func (c K) Between(lower, upper interface{}) Constraint {
+	return newScalarRangeConstraint(c, lower, upper)
+}
+
 // Comparison This method compares the receiver with expression rhs in the sense provided by sense.
 func (c K) Comparison(rhsIn interface{}, sense ConstrSense) Constraint {
 	// InputProcessing
@@ -193,7 +225,7 @@ func (c K) Comparison(rhsIn interface{}, sense ConstrSense) Constraint {
 	case float64:
 		// Use the version of Comparison for K
 		return c.Comparison(K(right), sense)
-	case K, Variable, Monomial, Polynomial:
+	case K, Variable, Monomial, Polynomial, RationalExpression, FunctionExpression:
 		// Cast right to scalar expression
 		se, _ := ToScalarExpression(right)
 		return ScalarConstraint{c, se, sense}
@@ -275,6 +307,10 @@ func (c K) Multiply(term1 interface{}) Expression {
 		return right.Multiply(c)
 	case Polynomial:
 		return right.Multiply(c)
+	case RationalExpression:
+		return right.Multiply(c)
+	case FunctionExpression:
+		return productOf(c, right)
 	}
 
 	// Unrecornized response is a panic
@@ -338,10 +374,19 @@ func (c K) SubstituteAccordingTo(subMap map[Variable]Expression) Expression {
 }
 
 // Power computes the result of the constant taken to the given exponent.
+// Negative exponents are allowed for nonzero constants (e.g., 2^-1 = 0.5).
 func (c K) Power(exponent int) Expression {
+	if exponent < 0 {
+		return scalarReciprocalPower(c, exponent)
+	}
 	return ScalarPowerTemplate(c, exponent)
 }
 
+// Divide Computes the ratio of the constant and another scalar expression.
+func (c K) Divide(rightIn interface{}) Expression {
+	return ScalarDivideTemplate(c, rightIn)
+}
+
 // At Returns the value at the given row and column index.
 // Note:
 //
@@ -363,3 +408,24 @@ func (c K) At(ii, jj int) ScalarExpression {
 func (c K) AsSimplifiedExpression() Expression {
 	return c
 }
+
+// Evaluate Returns the value of the constant. (The values map is not needed.)
+func (c K) Evaluate(values map[Variable]float64) float64 {
+	return float64(c)
+}
+
+// EvaluateAt Returns the value of the constant at the point x.
+func (c K) EvaluateAt(x mat.VecDense, wrt ...[]Variable) float64 {
+	return c.Evaluate(valueMapForEvaluateAt(c, x, wrt))
+}
+
+// Equals Returns true if the constant and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (c K) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(c, other, tol)
+}
+
+// Canonicalize Returns the constant (constants are always in canonical form).
+func (c K) Canonicalize() Expression {
+	return c
+}
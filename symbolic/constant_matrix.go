@@ -234,6 +234,10 @@ func (km KMatrix) Multiply(e interface{}) Expression {
 		}
 		out = ConcretizeExpression(product)
 
+	case RationalExpression:
+		out = right.Multiply(km)
+	case FunctionExpression:
+		out = right.Multiply(km)
 	case *mat.VecDense:
 		// Use gonum's built-in multiplication function
 		var product mat.VecDense
@@ -250,6 +254,10 @@ func (km KMatrix) Multiply(e interface{}) Expression {
 			out = VecDenseToKVector(product)
 		}
 
+	case FunctionExpressionVector:
+		out = MatrixMultiplyTemplate(km, right.Transpose().Transpose().(FunctionExpressionMatrix))
+	case FunctionExpressionMatrix:
+		out = MatrixMultiplyTemplate(km, right)
 	case VariableVector:
 		// Choose the correct output type based on the size of km
 		nR := km.Dims()[0]
@@ -589,3 +597,24 @@ func (km KMatrix) Power(exponent int) Expression {
 func (km KMatrix) AsSimplifiedExpression() Expression {
 	return km
 }
+
+// Evaluate Returns the value of the constant matrix when each variable takes the value given in the values map.
+func (km KMatrix) Evaluate(values map[Variable]float64) mat.Dense {
+	return MatrixEvaluateTemplate(km, values)
+}
+
+// EvaluateAt Returns the value of the constant matrix at the point x.
+func (km KMatrix) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.Dense {
+	return km.Evaluate(valueMapForEvaluateAt(km, x, wrt))
+}
+
+// Equals Returns true if the matrix and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (km KMatrix) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(km, other, tol)
+}
+
+// Canonicalize Returns the matrix (constant matrices are always in canonical form).
+func (km KMatrix) Canonicalize() Expression {
+	return km
+}
@@ -207,6 +207,12 @@ func (kv KVector) Eq(rightIn interface{}) Constraint {
 	return kv.Comparison(rightIn, SenseEqual)
 }
 
+// Between returns the elementwise range constraint lower <= kv <= upper.
+// Each bound is either a constant or a constant vector with the same length as kv.
+func (kv KVector) Between(lower, upper interface{}) Constraint {
+	return newVectorRangeConstraint(kv, lower, upper)
+}
+
 // Comparison creates a constraint comparing the KVector with the given
 // expression in the sense provided by sense.
 func (kv KVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
@@ -334,6 +340,10 @@ func (kv KVector) Multiply(rightIn interface{}) Expression {
 			prod = append(prod, kv[i].Multiply(right).(ScalarExpression))
 		}
 		out = ConcretizeExpression(prod)
+	case RationalExpression:
+		out = right.Multiply(kv)
+	case FunctionExpression:
+		out = right.Multiply(kv)
 	case *mat.VecDense:
 		out = kv.Multiply(*right)
 	case mat.VecDense:
@@ -516,3 +526,24 @@ func (kv KVector) ToScalarExpressions() []ScalarExpression {
 	}
 	return out
 }
+
+// Evaluate Returns the value of the constant vector when each variable takes the value given in the values map.
+func (kv KVector) Evaluate(values map[Variable]float64) mat.VecDense {
+	return VectorEvaluateTemplate(kv, values)
+}
+
+// EvaluateAt Returns the value of the constant vector at the point x.
+func (kv KVector) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.VecDense {
+	return kv.Evaluate(valueMapForEvaluateAt(kv, x, wrt))
+}
+
+// Equals Returns true if the vector and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (kv KVector) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(kv, other, tol)
+}
+
+// Canonicalize Returns the vector (constant vectors are always in canonical form).
+func (kv KVector) Canonicalize() Expression {
+	return kv
+}
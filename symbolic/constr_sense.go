@@ -1,6 +1,6 @@
 package symbolic
 
-import "fmt"
+import "github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 
 // ConstrSense represents if the constraint x <= y, x >= y, or x == y. For easy
 // integration with Gurobi, the senses have been encoding using a byte in
@@ -14,6 +14,12 @@ const (
 	SenseGreaterThanEqual ConstrSense = '>'
 )
 
+// SenseRange is the sense of the range constraints (ScalarRangeConstraint and
+// VectorRangeConstraint): the left hand side lies between the lower and the upper
+// bounds that are given (as columns) by the right hand side. It is not a comparison,
+// so Check rejects it; consumers should handle it before comparing Left() and Right().
+const SenseRange ConstrSense = 'R'
+
 // String returns a string representation of the constraint sense (e.g., "=", "<=", ">=").
 func (cs ConstrSense) String() string {
 	switch cs {
@@ -23,8 +29,10 @@ func (cs ConstrSense) String() string {
 		return "<="
 	case SenseGreaterThanEqual:
 		return ">="
+	case SenseRange:
+		return "in"
 	default:
-		panic(fmt.Errorf("unexpected constraint sense!"))
+		panic(smErrors.Errorf("unexpected constraint sense!"))
 	}
 }
 
@@ -38,6 +46,6 @@ func (cs ConstrSense) Check() error {
 	case SenseGreaterThanEqual:
 		return nil
 	default:
-		return fmt.Errorf("unexpected constraint sense: %v!", cs)
+		return smErrors.Errorf("unexpected constraint sense: %v!", cs)
 	}
 }
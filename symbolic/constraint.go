@@ -1,15 +1,20 @@
 package symbolic
 
-import "fmt"
+import "github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 
 // Constraint is a mathematical constraint (either <=, =, >=) between to expressions from SymbolicMath.go.
-// This interface is later implemented by specific types like: ScalarConstraint, VectorConstraint, MatrixConstraint.
+// This interface is later implemented by specific types like: ScalarConstraint, VectorConstraint, MatrixConstraint,
+// ScalarRangeConstraint, VectorRangeConstraint and SecondOrderConeConstraint.
 type Constraint interface {
 	Left() Expression
 	Right() Expression
 	ConstrSense() ConstrSense
 	Check() error
 	IsLinear() bool
+
+	// IsConvex
+	// Returns true if the set of points that satisfy the constraint is convex (according to Curvature).
+	IsConvex() bool
 	Substitute(vIn Variable, seIn ScalarExpression) Constraint
 	SubstituteAccordingTo(subMap map[Variable]Expression) Constraint
 
@@ -45,6 +50,18 @@ func IsConstraint(c interface{}) bool {
 		return true
 	case *MatrixConstraint:
 		return true
+	case ScalarRangeConstraint:
+		return true
+	case *ScalarRangeConstraint:
+		return true
+	case VectorRangeConstraint:
+		return true
+	case *VectorRangeConstraint:
+		return true
+	case SecondOrderConeConstraint:
+		return true
+	case *SecondOrderConeConstraint:
+		return true
 	}
 
 	// Return false, if the constraint is not a scalar or vector constraint.
@@ -62,14 +79,23 @@ func VariablesInThisConstraint(c Constraint) []Variable {
 		panic(err)
 	}
 
-	// Get variables from the left hand side
-	for _, v := range c.Left().Variables() {
-		varsMap[v] = true
+	// Get the expressions of the constraint
+	// (the bounds of a range constraint are constants, so only its expression is used)
+	var expressions []Expression
+	switch concrete := c.(type) {
+	case ScalarRangeConstraint:
+		expressions = []Expression{concrete.Expression}
+	case VectorRangeConstraint:
+		expressions = []Expression{concrete.Expression}
+	default:
+		expressions = []Expression{c.Left(), c.Right()}
 	}
 
-	// Get variables from the right hand side
-	for _, v := range c.Right().Variables() {
-		varsMap[v] = true
+	// Get variables from each expression
+	for _, e := range expressions {
+		for _, v := range e.Variables() {
+			varsMap[v] = true
+		}
 	}
 
 	// Convert the map to a slice
@@ -81,9 +107,86 @@ func VariablesInThisConstraint(c Constraint) []Variable {
 	return vars
 }
 
-// CompileConstraintsIntoScalarConstraints This method analyzes all constraints in an OptimizationProblem and converts them all
-// into scalar constraints.
+// constraintIsConvex Returns true if the curvature of left - right proves that the set of
+// points that satisfy the constraint c is convex: left - right must be convex for
+// a <= constraint, concave for a >= constraint and affine for an equality constraint.
+// Range constraints are convex when each of their finite halves (see ToScalarConstraints)
+// is convex.
+func constraintIsConvex(c Constraint) bool {
+	switch c.(type) {
+	case ScalarRangeConstraint, VectorRangeConstraint:
+		halves, err := ToScalarConstraints([]Constraint{c})
+		if err != nil {
+			panic(err)
+		}
+
+		for _, half := range halves {
+			if !constraintIsConvex(half) {
+				return false
+			}
+		}
+		return true
+	}
+
+	curvature := Curvature(c.Left().Minus(c.Right()))
+	switch c.ConstrSense() {
+	case SenseLessThanEqual:
+		return curvature.IsConvex()
+	case SenseGreaterThanEqual:
+		return curvature.IsConcave()
+	default:
+		return curvature == CurvatureAffine
+	}
+}
+
+// CompileConstraintsIntoScalarConstraints This method analyzes all constraints of a Problem (see problem.go) and converts them all
+// into scalar constraints. Panics with an UnsupportedConstraintError if one of the constraints can not be
+// decomposed into scalar constraints (e.g., a SecondOrderConeConstraint); use ToScalarConstraints to receive
+// that error instead.
 func CompileConstraintsIntoScalarConstraints(constraints []Constraint) []ScalarConstraint {
+	out, err := ToScalarConstraints(constraints)
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+// ToScalarRows Converts all of the given constraints into rows (as in the LP and MPS file
+// formats), in the order of the constraints: range constraints become one ScalarRangeConstraint
+// per element, and all other constraints become ScalarConstraints (see ToScalarConstraints).
+// Returns an UnsupportedConstraintError if one of the constraints can not be decomposed into rows.
+func ToScalarRows(constraints []Constraint) ([]Constraint, error) {
+	// Setup
+	var rows []Constraint
+
+	// Iterate through all constraints
+	for _, constraint := range constraints {
+		switch concreteConstraint := constraint.(type) {
+		case ScalarRangeConstraint:
+			rows = append(rows, concreteConstraint)
+		case VectorRangeConstraint:
+			for ii := 0; ii < concreteConstraint.Len(); ii++ {
+				rows = append(rows, concreteConstraint.AtVec(ii))
+			}
+		default:
+			scalarConstraints, err := ToScalarConstraints([]Constraint{constraint})
+			if err != nil {
+				return nil, err
+			}
+			for _, sc := range scalarConstraints {
+				rows = append(rows, sc)
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// ToScalarConstraints Converts all of the given constraints into scalar constraints (see
+// CompileConstraintsIntoScalarConstraints). Returns an UnsupportedConstraintError if one of the
+// constraints can not be decomposed into scalar constraints (e.g., a SecondOrderConeConstraint).
+func ToScalarConstraints(constraints []Constraint) ([]ScalarConstraint, error) {
 	// Setup
 	var out []ScalarConstraint
 
@@ -104,15 +207,23 @@ func CompileConstraintsIntoScalarConstraints(constraints []Constraint) []ScalarC
 					out = append(out, concreteConstraint.At(rowIdx, colIdx))
 				}
 			}
+		case ScalarRangeConstraint:
+			out = append(out, concreteConstraint.AsScalarConstraints()...)
+		case VectorRangeConstraint:
+			out = append(out, concreteConstraint.AsScalarConstraints()...)
+		case SecondOrderConeConstraint:
+			// A norm can not be written as a (finite) set of scalar constraints.
+			return nil, smErrors.UnsupportedConstraintError{
+				Operation:  "ToScalarConstraints",
+				Constraint: constraint,
+			}
 		default:
-			panic(
-				fmt.Errorf(
-					"The received constraint type (%T) is not supported by ExtractScalarConstraints!",
-					constraint,
-				),
-			)
+			return nil, smErrors.UnsupportedConstraintError{
+				Operation:  "ToScalarConstraints",
+				Constraint: constraint,
+			}
 		}
 	}
 
-	return out
+	return out, nil
 }
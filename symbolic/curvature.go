@@ -0,0 +1,269 @@
+package symbolic
+
+import (
+	"math"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+curvature.go
+Description:
+	Detection of the curvature (convexity) of expressions. Quadratic expressions are
+	classified exactly, from the eigenvalues of their Hessian. The other expressions are
+	classified with the composition rules of disciplined convex programming (DCP):
+	e.g., a nonnegative sum of convex expressions is convex and the exponential of a
+	convex expression is convex. The rules are sufficient but not necessary, so an
+	expression that is classified as CurvatureUnknown may still be convex.
+*/
+
+// CurvatureType describes whether an expression is convex, concave, both (affine) or
+// whether its curvature could not be determined.
+type CurvatureType string
+
+// The possible curvatures of an expression.
+const (
+	CurvatureAffine  CurvatureType = "Affine"
+	CurvatureConvex  CurvatureType = "Convex"
+	CurvatureConcave CurvatureType = "Concave"
+	CurvatureUnknown CurvatureType = "Unknown"
+)
+
+// CurvatureTolerance is the (relative) tolerance used to decide the signs of the
+// eigenvalues of the Hessian of a quadratic expression.
+const CurvatureTolerance = 1e-9
+
+// String returns a string representation of the curvature (e.g., "Convex").
+func (c CurvatureType) String() string {
+	return string(c)
+}
+
+// Check This method checks if the receiver is one of the allowed curvatures.
+func (c CurvatureType) Check() error {
+	switch c {
+	case CurvatureAffine:
+		return nil
+	case CurvatureConvex:
+		return nil
+	case CurvatureConcave:
+		return nil
+	case CurvatureUnknown:
+		return nil
+	default:
+		return smErrors.Errorf("unexpected curvature: %v!", string(c))
+	}
+}
+
+// IsConvex Returns true if the curvature is CurvatureConvex or CurvatureAffine.
+func (c CurvatureType) IsConvex() bool {
+	return c == CurvatureConvex || c == CurvatureAffine
+}
+
+// IsConcave Returns true if the curvature is CurvatureConcave or CurvatureAffine.
+func (c CurvatureType) IsConcave() bool {
+	return c == CurvatureConcave || c == CurvatureAffine
+}
+
+// Plus Returns the curvature of the sum of two expressions with the curvatures c and other.
+func (c CurvatureType) Plus(other CurvatureType) CurvatureType {
+	switch {
+	case c == CurvatureAffine:
+		return other
+	case other == CurvatureAffine:
+		return c
+	case c == other:
+		return c
+	default:
+		return CurvatureUnknown
+	}
+}
+
+// Negate Returns the curvature of the negative of an expression with the curvature c.
+func (c CurvatureType) Negate() CurvatureType {
+	switch c {
+	case CurvatureConvex:
+		return CurvatureConcave
+	case CurvatureConcave:
+		return CurvatureConvex
+	default:
+		return c
+	}
+}
+
+// scaledBy Returns the curvature of an expression with the curvature c multiplied by
+// the constant factor.
+func (c CurvatureType) scaledBy(factor float64) CurvatureType {
+	switch {
+	case factor == 0.0:
+		return CurvatureAffine
+	case factor < 0.0:
+		return c.Negate()
+	default:
+		return c
+	}
+}
+
+// Curvature Returns the curvature of the expression e. Vector and matrix expressions are
+// convex (concave) if each of their elements is convex (concave).
+func Curvature(e Expression) CurvatureType {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	dims := e.Dims()
+	curvature := CurvatureAffine
+	for ii := 0; ii < dims[0]; ii++ {
+		for jj := 0; jj < dims[1]; jj++ {
+			curvature = curvature.Plus(scalarCurvature(e.At(ii, jj)))
+		}
+	}
+	return curvature
+}
+
+// scalarCurvature Returns the curvature of the scalar expression se.
+func scalarCurvature(se ScalarExpression) CurvatureType {
+	switch concrete := se.(type) {
+	case K, Variable:
+		return CurvatureAffine
+	case Monomial:
+		return polynomialCurvature(concrete.ToPolynomial())
+	case Polynomial:
+		return polynomialCurvature(concrete)
+	case RationalExpression:
+		simplified := concrete.AsSimplifiedExpression().(ScalarExpression)
+		if _, tf := simplified.(RationalExpression); tf {
+			return CurvatureUnknown
+		}
+		return scalarCurvature(simplified)
+	case FunctionExpression:
+		return functionCurvature(concrete)
+	}
+
+	return CurvatureUnknown
+}
+
+// polynomialCurvature Returns the curvature of the polynomial p. The terms of degree
+// at most 2 are classified with the eigenvalues of their Hessian and the terms of higher
+// degree must be even powers of a single variable (which are convex when their coefficient
+// is positive and concave when it is negative).
+func polynomialCurvature(p Polynomial) CurvatureType {
+	simplified := p.Simplify()
+
+	quadratic := Polynomial{Monomials: []Monomial{K(0.0).ToMonomial()}}
+	curvature := CurvatureAffine
+	for _, monomial := range simplified.Monomials {
+		if monomial.Degree() <= 2 {
+			quadratic.Monomials = append(quadratic.Monomials, monomial)
+			continue
+		}
+
+		if len(monomial.Variables()) != 1 || monomial.Degree()%2 != 0 {
+			return CurvatureUnknown
+		}
+		curvature = curvature.Plus(CurvatureConvex.scaledBy(monomial.Coefficient))
+	}
+
+	return curvature.Plus(quadraticCurvature(quadratic))
+}
+
+// quadraticCurvature Returns the curvature of the quadratic polynomial p from the signs of
+// the eigenvalues of its Hessian: it is convex if the Hessian is positive semidefinite,
+// concave if it is negative semidefinite and affine if it is zero.
+func quadraticCurvature(p Polynomial) CurvatureType {
+	variables := p.Variables()
+	if len(variables) == 0 {
+		return CurvatureAffine
+	}
+
+	Q, _, _ := p.QuadraticRepresentation(variables)
+
+	var eigen mat.EigenSym
+	if ok := eigen.Factorize(&Q, false); !ok {
+		return CurvatureUnknown
+	}
+
+	eigenvalues := eigen.Values(nil)
+	scale := 1.0
+	for _, eigenvalue := range eigenvalues {
+		scale = math.Max(scale, math.Abs(eigenvalue))
+	}
+	tolerance := CurvatureTolerance * scale
+
+	hasPositive, hasNegative := false, false
+	for _, eigenvalue := range eigenvalues {
+		hasPositive = hasPositive || eigenvalue > tolerance
+		hasNegative = hasNegative || eigenvalue < -tolerance
+	}
+
+	switch {
+	case hasPositive && hasNegative:
+		return CurvatureUnknown
+	case hasPositive:
+		return CurvatureConvex
+	case hasNegative:
+		return CurvatureConcave
+	default:
+		return CurvatureAffine
+	}
+}
+
+// functionCurvature Applies the DCP rules to the function expression fe:
+//   - sums add the curvatures of their arguments,
+//   - products are only classified when all but one of their arguments are constants,
+//   - exp (convex and nondecreasing) of a convex expression is convex,
+//   - log and sqrt (concave and nondecreasing) of a concave expression are concave,
+//   - abs (convex) of an affine expression is convex, and
+//   - any function of a constant is a constant (affine).
+func functionCurvature(fe FunctionExpression) CurvatureType {
+	if len(fe.Variables()) == 0 {
+		return CurvatureAffine
+	}
+
+	switch fe.Function {
+	case FunctionSum:
+		curvature := CurvatureAffine
+		for _, argument := range fe.Arguments {
+			curvature = curvature.Plus(scalarCurvature(argument))
+		}
+		return curvature
+	case FunctionProduct:
+		factor := 1.0
+		curvature := CurvatureAffine
+		nonConstantArguments := 0
+		for _, argument := range fe.Arguments {
+			if len(argument.Variables()) == 0 {
+				factor *= argument.Evaluate(map[Variable]float64{})
+				continue
+			}
+			nonConstantArguments++
+			curvature = scalarCurvature(argument)
+		}
+
+		if nonConstantArguments > 1 {
+			return CurvatureUnknown
+		}
+		return curvature.scaledBy(factor)
+	}
+
+	argument := scalarCurvature(fe.Arguments[0])
+	switch fe.Function {
+	case FunctionExp:
+		if argument.IsConvex() {
+			return CurvatureConvex
+		}
+	case FunctionLog, FunctionSqrt:
+		if argument.IsConcave() {
+			return CurvatureConcave
+		}
+	case FunctionAbs:
+		if argument == CurvatureAffine {
+			return CurvatureConvex
+		}
+	}
+
+	return CurvatureUnknown
+}
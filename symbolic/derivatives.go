@@ -0,0 +1,143 @@
+package symbolic
+
+import "github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+
+/*
+derivatives.go
+Description:
+	Defines the multivariable derivative operators (Gradient, Jacobian and Hessian)
+	that are built on top of each expression's DerivativeWrt() method.
+	The concrete type of the output only depends on the kind of the derivatives:
+	constant derivatives give a KVector (KMatrix), polynomial derivatives a
+	PolynomialVector (PolynomialMatrix) and any other derivatives a
+	FunctionExpressionVector (FunctionExpressionMatrix).
+*/
+
+// Gradient Computes the gradient of the scalar expression e with respect to the variables in wrt.
+// The ii-th element of the output is the derivative of e with respect to wrt[ii].
+// The output is a KVector when all of the derivatives are constant (e.g., when e is linear),
+// a PolynomialVector when they are polynomials and a FunctionExpressionVector otherwise.
+func Gradient(e ScalarExpression, wrt []Variable) VectorExpression {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(smErrors.EmptyVectorError{Expression: VariableVector(wrt)})
+	}
+
+	// Algorithm
+	var gradient []ScalarExpression
+	for _, v := range wrt {
+		gradient = append(gradient, scalarDerivativeWrt(e, v))
+	}
+
+	return toDerivativeVector(ConcretizeVectorExpression(gradient))
+}
+
+// Jacobian Computes the Jacobian of the vector expression e with respect to the variables in wrt.
+// The output has e.Len() rows and len(wrt) columns; the (ii,jj)-th element is the derivative
+// of e.AtVec(ii) with respect to wrt[jj].
+// The output is a KMatrix when all of the derivatives are constant (e.g., when e is linear),
+// a PolynomialMatrix when they are polynomials and a FunctionExpressionMatrix otherwise.
+func Jacobian(e VectorExpression, wrt []Variable) MatrixExpression {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(smErrors.EmptyVectorError{Expression: VariableVector(wrt)})
+	}
+
+	// Algorithm
+	var jacobian [][]ScalarExpression
+	for ii := 0; ii < e.Len(); ii++ {
+		var row []ScalarExpression
+		for _, v := range wrt {
+			row = append(row, scalarDerivativeWrt(e.AtVec(ii), v))
+		}
+		jacobian = append(jacobian, row)
+	}
+
+	return toDerivativeMatrix(ConcretizeMatrixExpression(jacobian))
+}
+
+// Hessian Computes the Hessian of the scalar expression e with respect to the variables in wrt.
+// The (ii,jj)-th element of the output is the second derivative of e with respect to
+// wrt[ii] and wrt[jj].
+// The output is a KMatrix when all of the second derivatives are constant (e.g., when e is
+// quadratic), a PolynomialMatrix when they are polynomials and a FunctionExpressionMatrix otherwise.
+func Hessian(e ScalarExpression, wrt []Variable) MatrixExpression {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(wrt) == 0 {
+		panic(smErrors.EmptyVectorError{Expression: VariableVector(wrt)})
+	}
+
+	// Algorithm
+	var hessian [][]ScalarExpression
+	for _, vII := range wrt {
+		dEdVII := scalarDerivativeWrt(e, vII)
+
+		var row []ScalarExpression
+		for _, vJJ := range wrt {
+			row = append(row, scalarDerivativeWrt(dEdVII, vJJ))
+		}
+		hessian = append(hessian, row)
+	}
+
+	return toDerivativeMatrix(ConcretizeMatrixExpression(hessian))
+}
+
+// toDerivativeVector Converts the vector of derivatives ve into a KVector, a PolynomialVector
+// or a FunctionExpressionVector (see Gradient).
+func toDerivativeVector(ve VectorExpression) VectorExpression {
+	switch concrete := ve.(type) {
+	case KVector, PolynomialVector, FunctionExpressionVector:
+		return concrete
+	case VariableVector:
+		return concrete.ToPolynomialVector()
+	case MonomialVector:
+		return concrete.ToPolynomialVector()
+	}
+
+	panic(
+		smErrors.Errorf("unexpected vector of derivatives of type %T", ve),
+	)
+}
+
+// toDerivativeMatrix Converts the matrix of derivatives me into a KMatrix, a PolynomialMatrix
+// or a FunctionExpressionMatrix (see Jacobian and Hessian).
+func toDerivativeMatrix(me MatrixExpression) MatrixExpression {
+	switch concrete := me.(type) {
+	case KMatrix, PolynomialMatrix, FunctionExpressionMatrix:
+		return concrete
+	case VariableMatrix:
+		return concrete.ToPolynomialMatrix()
+	case MonomialMatrix:
+		return concrete.ToPolynomialMatrix()
+	}
+
+	panic(
+		smErrors.Errorf("unexpected matrix of derivatives of type %T", me),
+	)
+}
+
+// scalarDerivativeWrt Computes the derivative of the scalar expression e with respect to v
+// and returns it in its simplest scalar form.
+func scalarDerivativeWrt(e ScalarExpression, v Variable) ScalarExpression {
+	derivative, err := ToScalarExpression(e.DerivativeWrt(v))
+	if err != nil {
+		panic(err)
+	}
+
+	return derivative.AsSimplifiedExpression().(ScalarExpression)
+}
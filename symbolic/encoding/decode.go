@@ -0,0 +1,538 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+decode.go
+Description:
+
+	Defines the UnmarshalExpression and UnmarshalConstraint functions, which read
+	documents written by MarshalExpression and MarshalConstraint.
+*/
+
+// UnmarshalExpression Decodes a JSON document written by MarshalExpression.
+// The variables of the document are rebound to env: a stored variable is replaced by
+// the variable in env with the same Name when one exists; otherwise a new variable
+// with the stored name, bounds and type is created in env (the default environment
+// is used when env is nil). env is left unchanged when an error is returned.
+func UnmarshalExpression(data []byte, env symbolic.Environment) (symbolic.Expression, error) {
+	// Input Processing
+	doc, dec, err := newDecoder(data, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.Expression == nil {
+		return nil, fmt.Errorf("encoding: document does not contain an expression")
+	}
+
+	// Algorithm
+	// (decode and check the expression with placeholders for the missing variables
+	// first, so that an invalid document does not add variables to env)
+	_, err = dec.decodeCheckedExpression(*doc.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	dec.registerMissingVariables()
+
+	return dec.decodeCheckedExpression(*doc.Expression)
+}
+
+// decodeCheckedExpression Converts a typed JSON node into an expression and checks it.
+func (dec *decoder) decodeCheckedExpression(node expressionJSON) (symbolic.Expression, error) {
+	e, err := dec.decodeExpression(node)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// UnmarshalConstraint Decodes a JSON document written by MarshalConstraint.
+// Variables are rebound to env as in UnmarshalExpression.
+func UnmarshalConstraint(data []byte, env symbolic.Environment) (symbolic.Constraint, error) {
+	// Input Processing
+	doc, dec, err := newDecoder(data, env)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.Constraint == nil {
+		return nil, fmt.Errorf("encoding: document does not contain a constraint")
+	}
+
+	// Algorithm
+	// (decode and check the constraint with placeholders for the missing variables
+	// first, so that an invalid document does not add variables to env)
+	_, err = dec.decodeConstraint(*doc.Constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	dec.registerMissingVariables()
+
+	return dec.decodeConstraint(*doc.Constraint)
+}
+
+// decodeConstraint Converts the JSON representation of a constraint into a Constraint and checks it.
+func (dec *decoder) decodeConstraint(cJSON constraintJSON) (symbolic.Constraint, error) {
+	var sense symbolic.ConstrSense
+	switch cJSON.Sense {
+	case symbolic.SenseLessThanEqual.String():
+		sense = symbolic.SenseLessThanEqual
+	case symbolic.SenseGreaterThanEqual.String():
+		sense = symbolic.SenseGreaterThanEqual
+	case symbolic.SenseEqual.String():
+		sense = symbolic.SenseEqual
+	case symbolic.SenseRange.String():
+		sense = symbolic.SenseRange
+	default:
+		return nil, fmt.Errorf("encoding: unknown constraint sense %q", cJSON.Sense)
+	}
+
+	left, err := dec.decodeExpression(cJSON.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := dec.decodeExpression(cJSON.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	var c symbolic.Constraint
+	switch cJSON.Type {
+	case "ScalarConstraint":
+		leftAsSE, leftOK := left.(symbolic.ScalarExpression)
+		rightAsSE, rightOK := right.(symbolic.ScalarExpression)
+		if !leftOK || !rightOK {
+			return nil, fmt.Errorf("encoding: ScalarConstraint requires scalar expressions; received %T and %T", left, right)
+		}
+		c = symbolic.ScalarConstraint{LeftHandSide: leftAsSE, RightHandSide: rightAsSE, Sense: sense}
+	case "VectorConstraint":
+		leftAsVE, leftOK := left.(symbolic.VectorExpression)
+		rightAsVE, rightOK := right.(symbolic.VectorExpression)
+		if !leftOK || !rightOK {
+			return nil, fmt.Errorf("encoding: VectorConstraint requires vector expressions; received %T and %T", left, right)
+		}
+		c = symbolic.VectorConstraint{LeftHandSide: leftAsVE, RightHandSide: rightAsVE, Sense: sense}
+	case "MatrixConstraint":
+		leftAsME, leftOK := left.(symbolic.MatrixExpression)
+		rightAsME, rightOK := right.(symbolic.MatrixExpression)
+		if !leftOK || !rightOK {
+			return nil, fmt.Errorf("encoding: MatrixConstraint requires matrix expressions; received %T and %T", left, right)
+		}
+		c = symbolic.MatrixConstraint{LeftHandSide: leftAsME, RightHandSide: rightAsME, Sense: sense}
+	case "ScalarRangeConstraint":
+		leftAsSE, leftOK := left.(symbolic.ScalarExpression)
+		bounds, rightOK := right.(symbolic.KVector)
+		if !leftOK || !rightOK || bounds.Len() != 2 || sense != symbolic.SenseRange {
+			return nil, fmt.Errorf(
+				"encoding: ScalarRangeConstraint requires a scalar expression and two bounds with the sense %q; received %T, %T and %q",
+				symbolic.SenseRange.String(), left, right, cJSON.Sense,
+			)
+		}
+		c = symbolic.ScalarRangeConstraint{Expression: leftAsSE, LowerBound: bounds[0], UpperBound: bounds[1]}
+	case "VectorRangeConstraint":
+		leftAsVE, leftOK := left.(symbolic.VectorExpression)
+		bounds, rightOK := right.(symbolic.KMatrix)
+		if !leftOK || !rightOK || len(bounds) == 0 || len(bounds[0]) != 2 || sense != symbolic.SenseRange {
+			return nil, fmt.Errorf(
+				"encoding: VectorRangeConstraint requires a vector expression and a matrix of bounds with two columns with the sense %q; received %T, %T and %q",
+				symbolic.SenseRange.String(), left, right, cJSON.Sense,
+			)
+		}
+		vrc := symbolic.VectorRangeConstraint{Expression: leftAsVE}
+		for _, row := range bounds {
+			vrc.LowerBound = append(vrc.LowerBound, row[0])
+			vrc.UpperBound = append(vrc.UpperBound, row[1])
+		}
+		c = vrc
+	default:
+		return nil, fmt.Errorf("encoding: unknown constraint type %q", cJSON.Type)
+	}
+
+	err = c.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// decoder maps the ids of a document to the variables they were rebound to.
+// The stored variables that env does not contain are first bound to placeholders
+// (which are not tracked by any environment) until registerMissingVariables is called.
+type decoder struct {
+	variables map[uint64]symbolic.Variable
+	missing   []variableJSON
+	env       symbolic.Environment
+}
+
+// newDecoder Parses data, checks its version and its variables, and binds each stored
+// variable to the variable of env with the same name (or to a placeholder).
+// env is not modified.
+func newDecoder(data []byte, env symbolic.Environment) (document, *decoder, error) {
+	var doc document
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return document{}, nil, err
+	}
+
+	if doc.Version != SchemaVersion {
+		return document{}, nil, fmt.Errorf(
+			"encoding: unsupported schema version %v (expected %v)",
+			doc.Version,
+			SchemaVersion,
+		)
+	}
+
+	if env == nil {
+		env = symbolic.DefaultEnvironment
+	}
+
+	// Bind the variables that env already contains
+	dec := &decoder{variables: map[uint64]symbolic.Variable{}, env: env}
+	var nextPlaceholderID uint64
+	for _, vJSON := range doc.Variables {
+		if _, found := dec.variables[vJSON.ID]; found {
+			return document{}, nil, fmt.Errorf("encoding: variable ID %v appears more than once", vJSON.ID)
+		}
+
+		vType, err := parseVarType(vJSON.Type)
+		if err != nil {
+			return document{}, nil, err
+		}
+
+		v, found, err := existingVariable(vJSON, vType, env)
+		if err != nil {
+			return document{}, nil, err
+		}
+
+		if !found {
+			v = symbolic.Variable{Lower: vJSON.Lower, Upper: vJSON.Upper, Type: vType, Name: vJSON.Name}
+			err = v.Check()
+			if err != nil {
+				return document{}, nil, fmt.Errorf("encoding: variable %v is invalid: %v", vJSON.Name, err)
+			}
+			dec.missing = append(dec.missing, vJSON)
+		} else if v.ID >= nextPlaceholderID {
+			nextPlaceholderID = v.ID + 1
+		}
+
+		dec.variables[vJSON.ID] = v
+	}
+
+	// Give the placeholders IDs that no other variable of the document uses
+	// (stored variables with the same name share a placeholder)
+	placeholders := map[string]symbolic.Variable{}
+	for _, vJSON := range dec.missing {
+		v := dec.variables[vJSON.ID]
+		if placeholder, found := placeholders[vJSON.Name]; found && vJSON.Name != "" {
+			if placeholder.Type != v.Type || placeholder.Lower != v.Lower || placeholder.Upper != v.Upper {
+				return document{}, nil, fmt.Errorf(
+					"encoding: variable %v is stored twice with different types or bounds",
+					vJSON.Name,
+				)
+			}
+			dec.variables[vJSON.ID] = placeholder
+			continue
+		}
+
+		v.ID = nextPlaceholderID
+		nextPlaceholderID++
+		dec.variables[vJSON.ID] = v
+		placeholders[vJSON.Name] = v
+	}
+
+	return doc, dec, nil
+}
+
+// registerMissingVariables Creates the stored variables that env does not contain
+// and binds their ids to them (instead of to their placeholders).
+func (dec *decoder) registerMissingVariables() {
+	for _, vJSON := range dec.missing {
+		v := dec.variables[vJSON.ID]
+		if existing, found := dec.env.VariableByName(vJSON.Name); found && vJSON.Name != "" {
+			// A stored variable with the same name was created earlier in this loop
+			dec.variables[vJSON.ID] = existing
+			continue
+		}
+		dec.variables[vJSON.ID] = symbolic.NewCustomVariable(v.Type, v.Lower, v.Upper, v.Name, dec.env)
+	}
+	dec.missing = nil
+}
+
+// parseVarType Converts the "type" field of a stored variable into a VarType.
+func parseVarType(s string) (symbolic.VarType, error) {
+	switch s {
+	case symbolic.Continuous.String():
+		return symbolic.Continuous, nil
+	case symbolic.Binary.String():
+		return symbolic.Binary, nil
+	case symbolic.Integer.String():
+		return symbolic.Integer, nil
+	default:
+		return 0, fmt.Errorf("encoding: unknown variable type %q", s)
+	}
+}
+
+// existingVariable Returns the variable of env with the stored (non-empty) name, if any.
+// Returns an error if that variable does not have the stored type (vType) and bounds.
+func existingVariable(vJSON variableJSON, vType symbolic.VarType, env symbolic.Environment) (symbolic.Variable, bool, error) {
+	if vJSON.Name == "" {
+		return symbolic.Variable{}, false, nil
+	}
+
+	v, found := env.VariableByName(vJSON.Name)
+	if !found {
+		return symbolic.Variable{}, false, nil
+	}
+
+	if v.Type != vType || v.Lower != vJSON.Lower || v.Upper != vJSON.Upper {
+		return symbolic.Variable{}, false, fmt.Errorf(
+			"encoding: variable %v is stored with type %v and bounds [%v, %v], but the environment %v contains it with type %v and bounds [%v, %v]",
+			vJSON.Name, vType, vJSON.Lower, vJSON.Upper, env.GetName(), v.Type, v.Lower, v.Upper,
+		)
+	}
+
+	return v, true, nil
+}
+
+// variable Returns the variable that was bound to id.
+func (dec *decoder) variable(id uint64) (symbolic.Variable, error) {
+	v, found := dec.variables[id]
+	if !found {
+		return symbolic.Variable{}, fmt.Errorf("encoding: unknown variable ID %v", id)
+	}
+	return v, nil
+}
+
+// decodeVariables Converts a slice of ids into a slice of variables.
+func (dec *decoder) decodeVariables(ids []uint64) ([]symbolic.Variable, error) {
+	out := []symbolic.Variable{}
+	for _, id := range ids {
+		v, err := dec.variable(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// decodeMonomial Converts the JSON representation of a monomial into a Monomial.
+func (dec *decoder) decodeMonomial(mJSON monomialJSON) (symbolic.Monomial, error) {
+	if len(mJSON.Variables) != len(mJSON.Exponents) {
+		return symbolic.Monomial{}, fmt.Errorf(
+			"encoding: monomial has %v variables but %v exponents",
+			len(mJSON.Variables),
+			len(mJSON.Exponents),
+		)
+	}
+
+	factors, err := dec.decodeVariables(mJSON.Variables)
+	if err != nil {
+		return symbolic.Monomial{}, err
+	}
+
+	return symbolic.Monomial{
+		Coefficient:     mJSON.Coefficient,
+		VariableFactors: factors,
+		Exponents:       append([]int{}, mJSON.Exponents...),
+	}, nil
+}
+
+// decodeMonomials Converts a slice of JSON monomials into a slice of Monomials.
+func (dec *decoder) decodeMonomials(msJSON []monomialJSON) ([]symbolic.Monomial, error) {
+	out := []symbolic.Monomial{}
+	for _, mJSON := range msJSON {
+		m, err := dec.decodeMonomial(mJSON)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// decodeKs Converts a slice of floats into a slice of constants.
+func decodeKs(values []float64) []symbolic.K {
+	out := []symbolic.K{}
+	for _, value := range values {
+		out = append(out, symbolic.K(value))
+	}
+	return out
+}
+
+// checkRectangular Returns an error if the rows of a stored matrix have different lengths.
+func checkRectangular[T any](rows [][]T) error {
+	for ii, row := range rows {
+		if len(row) != len(rows[0]) {
+			return fmt.Errorf(
+				"encoding: row %v of the matrix has %v elements; expected %v",
+				ii,
+				len(row),
+				len(rows[0]),
+			)
+		}
+	}
+	return nil
+}
+
+// decodeExpression Converts a typed JSON node into an expression.
+func (dec *decoder) decodeExpression(node expressionJSON) (symbolic.Expression, error) {
+	switch node.Type {
+	case "K":
+		var value float64
+		err := json.Unmarshal(node.Data, &value)
+		return symbolic.K(value), err
+	case "KVector":
+		var values []float64
+		err := json.Unmarshal(node.Data, &values)
+		return symbolic.KVector(decodeKs(values)), err
+	case "KMatrix":
+		var rows [][]float64
+		err := json.Unmarshal(node.Data, &rows)
+		if err != nil {
+			return nil, err
+		}
+		err = checkRectangular(rows)
+		km := symbolic.KMatrix{}
+		for _, row := range rows {
+			km = append(km, decodeKs(row))
+		}
+		return km, err
+
+	case "Variable":
+		var id uint64
+		err := json.Unmarshal(node.Data, &id)
+		if err != nil {
+			return nil, err
+		}
+		return dec.variable(id)
+	case "VariableVector":
+		var ids []uint64
+		err := json.Unmarshal(node.Data, &ids)
+		if err != nil {
+			return nil, err
+		}
+		vv, err := dec.decodeVariables(ids)
+		return symbolic.VariableVector(vv), err
+	case "VariableMatrix":
+		var rows [][]uint64
+		err := json.Unmarshal(node.Data, &rows)
+		if err != nil {
+			return nil, err
+		}
+		err = checkRectangular(rows)
+		if err != nil {
+			return nil, err
+		}
+		vm := symbolic.VariableMatrix{}
+		for _, row := range rows {
+			vRow, err := dec.decodeVariables(row)
+			if err != nil {
+				return nil, err
+			}
+			vm = append(vm, vRow)
+		}
+		return vm, nil
+
+	case "Monomial":
+		var mJSON monomialJSON
+		err := json.Unmarshal(node.Data, &mJSON)
+		if err != nil {
+			return nil, err
+		}
+		return dec.decodeMonomial(mJSON)
+	case "MonomialVector":
+		var msJSON []monomialJSON
+		err := json.Unmarshal(node.Data, &msJSON)
+		if err != nil {
+			return nil, err
+		}
+		mv, err := dec.decodeMonomials(msJSON)
+		return symbolic.MonomialVector(mv), err
+	case "MonomialMatrix":
+		var rows [][]monomialJSON
+		err := json.Unmarshal(node.Data, &rows)
+		if err != nil {
+			return nil, err
+		}
+		err = checkRectangular(rows)
+		if err != nil {
+			return nil, err
+		}
+		mm := symbolic.MonomialMatrix{}
+		for _, row := range rows {
+			mRow, err := dec.decodeMonomials(row)
+			if err != nil {
+				return nil, err
+			}
+			mm = append(mm, mRow)
+		}
+		return mm, nil
+
+	case "Polynomial":
+		var msJSON []monomialJSON
+		err := json.Unmarshal(node.Data, &msJSON)
+		if err != nil {
+			return nil, err
+		}
+		monomials, err := dec.decodeMonomials(msJSON)
+		return symbolic.Polynomial{Monomials: monomials}, err
+	case "PolynomialVector":
+		var psJSON [][]monomialJSON
+		err := json.Unmarshal(node.Data, &psJSON)
+		if err != nil {
+			return nil, err
+		}
+		pv := symbolic.PolynomialVector{}
+		for _, pJSON := range psJSON {
+			monomials, err := dec.decodeMonomials(pJSON)
+			if err != nil {
+				return nil, err
+			}
+			pv = append(pv, symbolic.Polynomial{Monomials: monomials})
+		}
+		return pv, nil
+	case "PolynomialMatrix":
+		var rows [][][]monomialJSON
+		err := json.Unmarshal(node.Data, &rows)
+		if err != nil {
+			return nil, err
+		}
+		err = checkRectangular(rows)
+		if err != nil {
+			return nil, err
+		}
+		pm := symbolic.PolynomialMatrix{}
+		for _, row := range rows {
+			pRow := []symbolic.Polynomial{}
+			for _, pJSON := range row {
+				monomials, err := dec.decodeMonomials(pJSON)
+				if err != nil {
+					return nil, err
+				}
+				pRow = append(pRow, symbolic.Polynomial{Monomials: monomials})
+			}
+			pm = append(pm, pRow)
+		}
+		return pm, nil
+
+	default:
+		return nil, fmt.Errorf("encoding: unknown expression type %q", node.Type)
+	}
+}
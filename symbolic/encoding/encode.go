@@ -0,0 +1,251 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+encode.go
+Description:
+
+	Defines the MarshalExpression and MarshalConstraint functions, which write
+	expressions and constraints in the JSON schema defined in schema.go.
+*/
+
+// MarshalExpression Encodes the expression e (a K, Variable, Monomial or Polynomial
+// scalar, vector or matrix) as a JSON document.
+func MarshalExpression(e symbolic.Expression) ([]byte, error) {
+	// Input Processing
+	err := e.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	enc := newEncoder()
+	node, err := enc.encodeExpression(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(document{
+		Version:    SchemaVersion,
+		Variables:  enc.variableTable(),
+		Expression: &node,
+	})
+}
+
+// MarshalConstraint Encodes the constraint c (a ScalarConstraint, VectorConstraint,
+// MatrixConstraint, ScalarRangeConstraint or VectorRangeConstraint) as a JSON document.
+// The bounds of a range constraint are stored in its right hand side (see SenseRange).
+func MarshalConstraint(c symbolic.Constraint) ([]byte, error) {
+	// Input Processing
+	err := c.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	var typeName string
+	switch c.(type) {
+	case symbolic.ScalarConstraint:
+		typeName = "ScalarConstraint"
+	case symbolic.VectorConstraint:
+		typeName = "VectorConstraint"
+	case symbolic.MatrixConstraint:
+		typeName = "MatrixConstraint"
+	case symbolic.ScalarRangeConstraint:
+		typeName = "ScalarRangeConstraint"
+	case symbolic.VectorRangeConstraint:
+		typeName = "VectorRangeConstraint"
+	default:
+		return nil, fmt.Errorf("encoding: unsupported constraint type %T", c)
+	}
+
+	enc := newEncoder()
+	left, err := enc.encodeExpression(c.Left())
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := enc.encodeExpression(c.Right())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(document{
+		Version:   SchemaVersion,
+		Variables: enc.variableTable(),
+		Constraint: &constraintJSON{
+			Type:  typeName,
+			Left:  left,
+			Right: right,
+			Sense: c.ConstrSense().String(),
+		},
+	})
+}
+
+// encoder collects the variables that appear in the encoded expressions.
+// The first problem that is found (e.g., two different variables sharing an ID)
+// is kept in err.
+type encoder struct {
+	variables map[uint64]symbolic.Variable
+	err       error
+}
+
+// newEncoder Creates an encoder with an empty variable table.
+func newEncoder() *encoder {
+	return &encoder{variables: map[uint64]symbolic.Variable{}}
+}
+
+// variableTable Returns the variables that were seen by the encoder, sorted by ID.
+func (enc *encoder) variableTable() []variableJSON {
+	table := []variableJSON{}
+	for _, v := range enc.variables {
+		table = append(table, variableJSON{
+			ID:    v.ID,
+			Name:  v.Name,
+			Lower: v.Lower,
+			Upper: v.Upper,
+			Type:  v.Type.String(),
+		})
+	}
+
+	sort.Slice(table, func(i, j int) bool {
+		return table[i].ID < table[j].ID
+	})
+	return table
+}
+
+// variableID Records v in the variable table and returns its id.
+// Two different variables with the same ID can not be encoded in the same document.
+func (enc *encoder) variableID(v symbolic.Variable) uint64 {
+	existing, found := enc.variables[v.ID]
+	if found && enc.err == nil && (existing.Name != v.Name || existing.Lower != v.Lower ||
+		existing.Upper != v.Upper || existing.Type != v.Type) {
+		enc.err = fmt.Errorf(
+			"encoding: variables %v and %v share the ID %v",
+			existing.Name,
+			v.Name,
+			v.ID,
+		)
+	}
+
+	enc.variables[v.ID] = v
+	return v.ID
+}
+
+// encodeKs Converts a slice of constants into a slice of floats.
+func encodeKs(ks []symbolic.K) []float64 {
+	out := []float64{}
+	for _, k := range ks {
+		out = append(out, float64(k))
+	}
+	return out
+}
+
+// encodeVariables Converts a slice of variables into a slice of ids.
+func (enc *encoder) encodeVariables(vs []symbolic.Variable) []uint64 {
+	out := []uint64{}
+	for _, v := range vs {
+		out = append(out, enc.variableID(v))
+	}
+	return out
+}
+
+// encodeMonomial Converts m into its JSON representation.
+func (enc *encoder) encodeMonomial(m symbolic.Monomial) monomialJSON {
+	return monomialJSON{
+		Coefficient: m.Coefficient,
+		Variables:   enc.encodeVariables(m.VariableFactors),
+		Exponents:   append([]int{}, m.Exponents...),
+	}
+}
+
+// encodeMonomials Converts a slice of monomials (e.g., the terms of a polynomial)
+// into their JSON representation.
+func (enc *encoder) encodeMonomials(ms []symbolic.Monomial) []monomialJSON {
+	out := []monomialJSON{}
+	for _, m := range ms {
+		out = append(out, enc.encodeMonomial(m))
+	}
+	return out
+}
+
+// encodeExpression Converts e into a typed JSON node.
+func (enc *encoder) encodeExpression(e symbolic.Expression) (expressionJSON, error) {
+	var typeName string
+	var data interface{}
+
+	switch concrete := e.(type) {
+	case symbolic.K:
+		typeName, data = "K", float64(concrete)
+	case symbolic.KVector:
+		typeName, data = "KVector", encodeKs(concrete)
+	case symbolic.KMatrix:
+		rows := [][]float64{}
+		for _, row := range concrete {
+			rows = append(rows, encodeKs(row))
+		}
+		typeName, data = "KMatrix", rows
+
+	case symbolic.Variable:
+		typeName, data = "Variable", enc.variableID(concrete)
+	case symbolic.VariableVector:
+		typeName, data = "VariableVector", enc.encodeVariables(concrete)
+	case symbolic.VariableMatrix:
+		rows := [][]uint64{}
+		for _, row := range concrete {
+			rows = append(rows, enc.encodeVariables(row))
+		}
+		typeName, data = "VariableMatrix", rows
+
+	case symbolic.Monomial:
+		typeName, data = "Monomial", enc.encodeMonomial(concrete)
+	case symbolic.MonomialVector:
+		typeName, data = "MonomialVector", enc.encodeMonomials(concrete)
+	case symbolic.MonomialMatrix:
+		rows := [][]monomialJSON{}
+		for _, row := range concrete {
+			rows = append(rows, enc.encodeMonomials(row))
+		}
+		typeName, data = "MonomialMatrix", rows
+
+	case symbolic.Polynomial:
+		typeName, data = "Polynomial", enc.encodeMonomials(concrete.Monomials)
+	case symbolic.PolynomialVector:
+		polynomials := [][]monomialJSON{}
+		for _, p := range concrete {
+			polynomials = append(polynomials, enc.encodeMonomials(p.Monomials))
+		}
+		typeName, data = "PolynomialVector", polynomials
+	case symbolic.PolynomialMatrix:
+		rows := [][][]monomialJSON{}
+		for _, pRow := range concrete {
+			row := [][]monomialJSON{}
+			for _, p := range pRow {
+				row = append(row, enc.encodeMonomials(p.Monomials))
+			}
+			rows = append(rows, row)
+		}
+		typeName, data = "PolynomialMatrix", rows
+
+	default:
+		return expressionJSON{}, fmt.Errorf("encoding: unsupported expression type %T", e)
+	}
+
+	if enc.err != nil {
+		return expressionJSON{}, enc.err
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return expressionJSON{}, err
+	}
+
+	return expressionJSON{Type: typeName, Data: raw}, nil
+}
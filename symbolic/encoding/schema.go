@@ -0,0 +1,72 @@
+package encoding
+
+import "encoding/json"
+
+/*
+schema.go
+Description:
+
+	Defines the (versioned) JSON schema that is used to store expressions and
+	constraints. A document looks like:
+
+		{
+			"version": 1,
+			"variables": [
+				{"id": 0, "name": "x_0", "lower": -1e100, "upper": 1e100, "type": "C"}
+			],
+			"expression": {"type": "Monomial", "data": {"coefficient": 3, "variables": [0], "exponents": [2]}}
+		}
+
+	or contains a "constraint" object with a "left" expression, a "right"
+	expression and a "sense" ("<=", ">=" or "=") instead of the "expression".
+	The "right" expression of a range constraint (whose sense is "in") holds
+	its bounds: the KVector [lower, upper] for a ScalarRangeConstraint and the
+	KMatrix with the columns lower and upper for a VectorRangeConstraint.
+	Expressions refer to variables by their id in the "variables" table.
+*/
+
+// SchemaVersion is the version of the JSON schema written by this package.
+const SchemaVersion = 1
+
+// document is the top level object of the JSON schema.
+type document struct {
+	Version    int             `json:"version"`
+	Variables  []variableJSON  `json:"variables"`
+	Expression *expressionJSON `json:"expression,omitempty"`
+	Constraint *constraintJSON `json:"constraint,omitempty"`
+}
+
+// variableJSON describes a single variable.
+type variableJSON struct {
+	ID    uint64  `json:"id"`
+	Name  string  `json:"name"`
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Type  string  `json:"type"`
+}
+
+// monomialJSON describes a single monomial.
+type monomialJSON struct {
+	Coefficient float64  `json:"coefficient"`
+	Variables   []uint64 `json:"variables"`
+	Exponents   []int    `json:"exponents"`
+}
+
+// expressionJSON describes an expression. The shape of Data depends on Type:
+//
+//	K: number, KVector: [number], KMatrix: [[number]]
+//	Variable: id, VariableVector: [id], VariableMatrix: [[id]]
+//	Monomial: monomial, MonomialVector: [monomial], MonomialMatrix: [[monomial]]
+//	Polynomial: [monomial], PolynomialVector: [[monomial]], PolynomialMatrix: [[[monomial]]]
+type expressionJSON struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// constraintJSON describes a scalar, vector, matrix or range constraint.
+type constraintJSON struct {
+	Type  string         `json:"type"`
+	Left  expressionJSON `json:"left"`
+	Right expressionJSON `json:"right"`
+	Sense string         `json:"sense"`
+}
@@ -1,8 +1,23 @@
 package symbolic
 
 // Environment defines the environment where the symbolic variables are stored.
+// Implementations must be safe for concurrent use by multiple goroutines.
 type Environment interface {
 	GetName() string
+
+	// TrackVariable adds v to the environment. It returns false (and does not add v)
+	// if the environment already tracks a variable with the same ID or the same
+	// (non-empty) Name.
 	TrackVariable(v Variable) bool
 	AllTrackedVariables() []Variable
+
+	// AllocateVariableID reserves and returns an ID that no other variable
+	// of the environment uses (or will be given).
+	AllocateVariableID() uint64
+
+	// VariableByID returns the tracked variable with the given ID (if any).
+	VariableByID(id uint64) (Variable, bool)
+
+	// VariableByName returns the tracked variable with the given name (if any).
+	VariableByName(name string) (Variable, bool)
 }
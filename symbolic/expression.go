@@ -1,10 +1,6 @@
 package symbolic
 
-import (
-	"fmt"
-
-	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
-)
+import "github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 
 // Expression is a mathematical object that we can perform operations on with SymbolicMath.go.
 // This interface should be implemented by any scalar, vector, etc. in the package.
@@ -70,6 +66,13 @@ type Expression interface {
 
 	// Simplify simplifies the expression and returns the simplified version
 	AsSimplifiedExpression() Expression
+
+	// Equals returns true if the current expression and other represent the same
+	// expression (the coefficients of matching terms may differ by at most tol)
+	Equals(other Expression, tol float64) bool
+
+	// Canonicalize returns the expression in canonical form (see canonical.go)
+	Canonicalize() Expression
 }
 
 // NumVariables returns the number of unique symbolic.Variable objects contained in this Expression.
@@ -159,7 +162,7 @@ func HStack(eIn ...Expression) Expression {
 	// TODO: Panic if there are 0 expressions in the input
 	if len(eIn) == 0 {
 		panic(
-			fmt.Errorf("HStack: There must be at least one expression in the input; received 0"),
+			smErrors.Errorf("HStack: There must be at least one expression in the input; received 0"),
 		)
 	}
 
@@ -206,7 +209,7 @@ func VStack(eIn ...Expression) Expression {
 	// Panic if there are 0 expressions in the input
 	if len(eIn) == 0 {
 		panic(
-			fmt.Errorf("VStack: There must be at least one expression in the input; received 0"),
+			smErrors.Errorf("VStack: There must be at least one expression in the input; received 0"),
 		)
 	}
 
@@ -0,0 +1,988 @@
+package symbolic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+function_expression.go
+Description:
+	Defines the FunctionExpression, a scalar expression which is not a polynomial.
+	A FunctionExpression applies one of the elementary functions (exp, log, sin, cos, sqrt
+	and abs) to a scalar expression, or it is the sum or the product of scalar
+	expressions at least one of which is not polynomial (e.g., x_0 + sin(x_1)).
+
+	FunctionExpressions are not polynomial-like, so the linear and quadratic
+	methods of the package (e.g., LinearCoeff) do not accept them.
+*/
+
+// ScalarFunction names the operation that a FunctionExpression applies to its arguments.
+type ScalarFunction string
+
+const (
+	FunctionExp        ScalarFunction = "exp"
+	FunctionLog        ScalarFunction = "log"
+	FunctionSin        ScalarFunction = "sin"
+	FunctionCos        ScalarFunction = "cos"
+	FunctionSqrt       ScalarFunction = "sqrt"
+	FunctionAbs        ScalarFunction = "abs"
+	FunctionSign       ScalarFunction = "sign"       // The derivative of abs
+	FunctionReciprocal ScalarFunction = "reciprocal" // 1 / x (used in the derivatives of log and sqrt)
+	FunctionSum        ScalarFunction = "sum"
+	FunctionProduct    ScalarFunction = "product"
+)
+
+// Check Returns an error if the function is not one of the supported functions.
+func (f ScalarFunction) Check() error {
+	switch f {
+	case FunctionExp, FunctionLog, FunctionSin, FunctionCos, FunctionSqrt, FunctionAbs,
+		FunctionSign, FunctionReciprocal, FunctionSum, FunctionProduct:
+		return nil
+	default:
+		return smErrors.Errorf("unrecognized scalar function \"%v\"", string(f))
+	}
+}
+
+// IsUnary Returns true if the function takes exactly one argument
+// (i.e., it is not a sum or a product).
+func (f ScalarFunction) IsUnary() bool {
+	return f != FunctionSum && f != FunctionProduct
+}
+
+// evaluate Returns the value of the function when its arguments take the given values.
+func (f ScalarFunction) evaluate(arguments []float64) float64 {
+	switch f {
+	case FunctionExp:
+		return math.Exp(arguments[0])
+	case FunctionLog:
+		return math.Log(arguments[0])
+	case FunctionSin:
+		return math.Sin(arguments[0])
+	case FunctionCos:
+		return math.Cos(arguments[0])
+	case FunctionSqrt:
+		return math.Sqrt(arguments[0])
+	case FunctionAbs:
+		return math.Abs(arguments[0])
+	case FunctionSign:
+		switch {
+		case arguments[0] > 0:
+			return 1.0
+		case arguments[0] < 0:
+			return -1.0
+		default:
+			return 0.0
+		}
+	case FunctionReciprocal:
+		return 1.0 / arguments[0]
+	case FunctionSum:
+		sum := 0.0
+		for _, argument := range arguments {
+			sum += argument
+		}
+		return sum
+	case FunctionProduct:
+		product := 1.0
+		for _, argument := range arguments {
+			product *= argument
+		}
+		return product
+	default:
+		panic(f.Check())
+	}
+}
+
+// checkDomain Returns an error if the unary function is not defined at the given
+// (constant) argument: log requires a positive argument, sqrt a nonnegative one and
+// reciprocal a nonzero one.
+func (f ScalarFunction) checkDomain(argument float64) error {
+	switch {
+	case f == FunctionLog && argument <= 0.0,
+		f == FunctionSqrt && argument < 0.0:
+		return smErrors.DomainError{Function: string(f), Argument: argument}
+	case f == FunctionReciprocal && argument == 0.0:
+		return smErrors.DivisionByZeroError{Operation: string(f), Numerator: 1.0}
+	default:
+		return nil
+	}
+}
+
+// FunctionExpression Type Definition
+type FunctionExpression struct {
+	Function  ScalarFunction
+	Arguments []ScalarExpression
+}
+
+// =========
+// Functions
+// =========
+
+// Exp Returns the exponential of the input expression (applied elementwise to vectors and matrices).
+func Exp(e interface{}) Expression {
+	return applyElementwise(FunctionExp, "Exp", e)
+}
+
+// Log Returns the natural logarithm of the input expression (applied elementwise to vectors and matrices).
+func Log(e interface{}) Expression {
+	return applyElementwise(FunctionLog, "Log", e)
+}
+
+// Sin Returns the sine of the input expression (applied elementwise to vectors and matrices).
+func Sin(e interface{}) Expression {
+	return applyElementwise(FunctionSin, "Sin", e)
+}
+
+// Cos Returns the cosine of the input expression (applied elementwise to vectors and matrices).
+func Cos(e interface{}) Expression {
+	return applyElementwise(FunctionCos, "Cos", e)
+}
+
+// Sqrt Returns the square root of the input expression (applied elementwise to vectors and matrices).
+func Sqrt(e interface{}) Expression {
+	return applyElementwise(FunctionSqrt, "Sqrt", e)
+}
+
+// Abs Returns the absolute value of the input expression (applied elementwise to vectors and matrices).
+func Abs(e interface{}) Expression {
+	return applyElementwise(FunctionAbs, "Abs", e)
+}
+
+// applyElementwise Applies the unary function f to the scalar, vector or matrix expression e.
+func applyElementwise(f ScalarFunction, functionName string, e interface{}) Expression {
+	// Input Processing
+	switch number := e.(type) {
+	case int:
+		e = K(float64(number))
+	case float64:
+		e = K(number)
+	}
+
+	if !IsExpression(e) {
+		panic(
+			smErrors.UnsupportedInputError{
+				FunctionName: functionName,
+				Input:        e,
+			},
+		)
+	}
+
+	eAsE, _ := ToExpression(e)
+	err := eAsE.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	switch concrete := eAsE.(type) {
+	case ScalarExpression:
+		return applyFunction(f, concrete)
+	case VectorExpression:
+		var out []ScalarExpression
+		for ii := 0; ii < concrete.Len(); ii++ {
+			out = append(out, applyFunction(f, concrete.AtVec(ii)))
+		}
+		return ConcretizeVectorExpression(out)
+	default:
+		dims := eAsE.Dims()
+		var out [][]ScalarExpression
+		for ii := 0; ii < dims[0]; ii++ {
+			var row []ScalarExpression
+			for jj := 0; jj < dims[1]; jj++ {
+				row = append(row, applyFunction(f, eAsE.At(ii, jj)))
+			}
+			out = append(out, row)
+		}
+		return ConcretizeMatrixExpression(out)
+	}
+}
+
+// applyFunction Returns the unary function f applied to the scalar expression argument.
+// Functions of constants are evaluated immediately; panics with a DomainError (or a
+// DivisionByZeroError) if f is not defined at the constant (e.g., the log of -1).
+func applyFunction(f ScalarFunction, argument ScalarExpression) ScalarExpression {
+	if argumentAsK, isK := argument.AsSimplifiedExpression().(K); isK {
+		err := f.checkDomain(float64(argumentAsK))
+		if err != nil {
+			panic(err)
+		}
+		return K(f.evaluate([]float64{float64(argumentAsK)}))
+	}
+
+	return FunctionExpression{
+		Function:  f,
+		Arguments: []ScalarExpression{argument},
+	}
+}
+
+// sumOf Returns the sum of the given scalar expressions.
+// Nested sums are flattened, all of the polynomial-like terms are combined into a single
+// term (placed where the first polynomial-like term appeared) and the other terms which
+// only differ by a constant coefficient (e.g., sin(x) and -2 * sin(x)) are combined.
+func sumOf(terms ...ScalarExpression) ScalarExpression {
+	// Flatten the nested sums
+	var flattened []ScalarExpression
+	for _, term := range terms {
+		if termAsFE, isFE := term.(FunctionExpression); isFE && termAsFE.Function == FunctionSum {
+			flattened = append(flattened, termAsFE.Arguments...)
+		} else {
+			flattened = append(flattened, term)
+		}
+	}
+
+	// Combine the polynomial-like terms and the matching non-polynomial terms
+	type termGroup struct {
+		Coefficient float64
+		Factor      ScalarExpression
+	}
+	var (
+		groups             []termGroup
+		groupIndex         = make(map[string]int)
+		polynomialPart     ScalarExpression
+		polynomialPosition int = -1
+	)
+	for _, term := range flattened {
+		if IsPolynomialLikeScalar(term) {
+			if polynomialPart == nil {
+				polynomialPart = term
+				polynomialPosition = len(groups)
+			} else {
+				polynomialPart = polynomialPart.Plus(term).(ScalarExpression)
+			}
+			continue
+		}
+
+		coefficient, factor := splitCoefficient(term)
+		key := structuralKey(factor)
+		if index, found := groupIndex[key]; found {
+			groups[index].Coefficient += coefficient
+		} else {
+			groupIndex[key] = len(groups)
+			groups = append(groups, termGroup{Coefficient: coefficient, Factor: factor})
+		}
+	}
+
+	var out []ScalarExpression
+	for ii, group := range groups {
+		if ii == polynomialPosition && !isConstantWithValue(polynomialPart, 0.0) {
+			out = append(out, polynomialPart)
+		}
+		if group.Coefficient != 0.0 {
+			out = append(out, productOf(K(group.Coefficient), group.Factor))
+		}
+	}
+	if polynomialPosition == len(groups) && !isConstantWithValue(polynomialPart, 0.0) {
+		out = append(out, polynomialPart)
+	}
+
+	switch len(out) {
+	case 0:
+		return K(0.0)
+	case 1:
+		return out[0]
+	default:
+		return FunctionExpression{Function: FunctionSum, Arguments: out}
+	}
+}
+
+// splitCoefficient Returns the constant coefficient and the remaining factor of the
+// non-polynomial term (e.g., -2 and sin(x) for -2 * sin(x)).
+func splitCoefficient(term ScalarExpression) (float64, ScalarExpression) {
+	if termAsFE, isFE := term.(FunctionExpression); isFE && termAsFE.Function == FunctionProduct {
+		if coefficient, isK := termAsFE.Arguments[0].(K); isK {
+			return float64(coefficient), productOf(termAsFE.Arguments[1:]...)
+		}
+	}
+	return 1.0, term
+}
+
+// productOf Returns the product of the given scalar expressions.
+// Nested products are flattened and all of the polynomial-like factors are combined into a
+// single factor (placed first, like a coefficient).
+func productOf(factors ...ScalarExpression) ScalarExpression {
+	// Flatten the nested products
+	var flattened []ScalarExpression
+	for _, factor := range factors {
+		if factorAsFE, isFE := factor.(FunctionExpression); isFE && factorAsFE.Function == FunctionProduct {
+			flattened = append(flattened, factorAsFE.Arguments...)
+		} else {
+			flattened = append(flattened, factor)
+		}
+	}
+
+	// Combine the polynomial-like factors
+	var (
+		others         []ScalarExpression
+		polynomialPart ScalarExpression = K(1.0)
+	)
+	for _, factor := range flattened {
+		if IsPolynomialLikeScalar(factor) {
+			polynomialPart = polynomialPart.Multiply(factor).(ScalarExpression)
+		} else {
+			others = append(others, factor)
+		}
+	}
+
+	switch {
+	case isConstantWithValue(polynomialPart, 0.0):
+		return K(0.0)
+	case len(others) == 0:
+		return polynomialPart
+	case isSumExpression(others[0]) && len(others) == 1 && len(polynomialPart.Variables()) == 0 &&
+		!isConstantWithValue(polynomialPart, 1.0):
+		// Distribute the constant over the terms of the sum
+		var terms []ScalarExpression
+		for _, term := range others[0].(FunctionExpression).Arguments {
+			terms = append(terms, productOf(polynomialPart, term))
+		}
+		return sumOf(terms...)
+	case isConstantWithValue(polynomialPart, 1.0) && len(others) == 1:
+		return others[0]
+	case isConstantWithValue(polynomialPart, 1.0):
+		return FunctionExpression{Function: FunctionProduct, Arguments: others}
+	default:
+		return FunctionExpression{
+			Function:  FunctionProduct,
+			Arguments: append([]ScalarExpression{polynomialPart}, others...),
+		}
+	}
+}
+
+// isSumExpression Returns true if se is a FunctionExpression which represents a sum.
+func isSumExpression(se ScalarExpression) bool {
+	seAsFE, isFE := se.(FunctionExpression)
+	return isFE && seAsFE.Function == FunctionSum
+}
+
+// isConstantWithValue Returns true if the polynomial-like scalar se is the constant value.
+func isConstantWithValue(se ScalarExpression, value float64) bool {
+	if !IsPolynomialLikeScalar(se) {
+		return false
+	}
+
+	simplified := se.AsSimplifiedExpression().(ScalarExpression)
+	return len(simplified.Variables()) == 0 && simplified.Constant() == value
+}
+
+// newFunctionExpression Returns the (simplified) expression which applies f to the arguments.
+func newFunctionExpression(f ScalarFunction, arguments []ScalarExpression) ScalarExpression {
+	switch f {
+	case FunctionSum:
+		return sumOf(arguments...)
+	case FunctionProduct:
+		return productOf(arguments...)
+	default:
+		return applyFunction(f, arguments[0])
+	}
+}
+
+// ==============
+// Member Methods
+// ==============
+
+// Check Verifies that the function is supported and that each of its arguments is well formed.
+func (fe FunctionExpression) Check() error {
+	// Check the function
+	err := fe.Function.Check()
+	if err != nil {
+		return err
+	}
+
+	// Check the number of arguments
+	if fe.Function.IsUnary() && len(fe.Arguments) != 1 {
+		return smErrors.Errorf(
+			"the function %v takes 1 argument; received %v",
+			string(fe.Function),
+			len(fe.Arguments),
+		)
+	}
+
+	if len(fe.Arguments) == 0 {
+		return smErrors.Errorf("the function %v has no arguments", string(fe.Function))
+	}
+
+	// Check each argument
+	for ii, argument := range fe.Arguments {
+		if argument == nil {
+			return smErrors.Errorf("argument %v of the function %v is nil", ii, string(fe.Function))
+		}
+
+		err = argument.Check()
+		if err != nil {
+			return smErrors.Errorf("error in argument %v of the function %v: %v", ii, string(fe.Function), err)
+		}
+	}
+
+	// All checks passed
+	return nil
+}
+
+// Variables Returns the unique variables in the arguments of the function expression.
+func (fe FunctionExpression) Variables() []Variable {
+	var variables []Variable
+	for _, argument := range fe.Arguments {
+		variables = append(variables, argument.Variables()...)
+	}
+	return UniqueVars(variables)
+}
+
+// Dims The scalar function expression should have dimensions [1,1].
+func (fe FunctionExpression) Dims() []int {
+	return []int{1, 1}
+}
+
+// Constant Returns the constant additive value in the expression.
+// For a sum, this is the constant of its polynomial-like terms; the elementary
+// functions (e.g., exp(x)) are not expanded, so this is 0 for all other expressions.
+func (fe FunctionExpression) Constant() float64 {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	if fe.Function != FunctionSum {
+		return 0.0
+	}
+
+	constant := 0.0
+	for _, term := range fe.Arguments {
+		if IsPolynomialLikeScalar(term) {
+			constant += term.Constant()
+		}
+	}
+	return constant
+}
+
+// LinearCoeff Panics, because a function expression is not linear.
+func (fe FunctionExpression) LinearCoeff(wrt ...[]Variable) mat.VecDense {
+	panic(
+		smErrors.LinearExpressionRequiredError{
+			Operation:  "LinearCoeff",
+			Expression: fe,
+		},
+	)
+}
+
+// QuadraticRepresentation Panics, because a function expression is not quadratic.
+func (fe FunctionExpression) QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64) {
+	panic(
+		smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticRepresentation",
+			Expression: fe,
+		},
+	)
+}
+
+// Plus Defines an addition between the function expression and another expression.
+func (fe FunctionExpression) Plus(rightIn interface{}) Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case float64:
+		return fe.Plus(K(right))
+	case int:
+		return fe.Plus(K(float64(right)))
+	case ScalarExpression:
+		return sumOf(fe, right)
+	case mat.VecDense:
+		return fe.Plus(VecDenseToKVector(right))
+	case *mat.VecDense:
+		return fe.Plus(VecDenseToKVector(*right))
+	case mat.Dense:
+		return fe.Plus(DenseToKMatrix(right))
+	case *mat.Dense:
+		return fe.Plus(DenseToKMatrix(*right))
+	case VectorExpression, MatrixExpression:
+		return elementwise(right.(Expression), func(se ScalarExpression) ScalarExpression {
+			return sumOf(fe, se)
+		})
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpression.Plus",
+			Input:        rightIn,
+		},
+	)
+}
+
+// Minus Defines a subtraction between the function expression and another expression.
+func (fe FunctionExpression) Minus(rightIn interface{}) Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+
+		// Use Expression's Minus() method
+		return Minus(fe, rightAsE)
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case int:
+		return fe.Minus(K(float64(right)))
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpression.Minus",
+			Input:        rightIn,
+		},
+	)
+}
+
+// Multiply Defines a multiplication between the function expression and another expression.
+func (fe FunctionExpression) Multiply(rightIn interface{}) Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case float64:
+		return fe.Multiply(K(right))
+	case int:
+		return fe.Multiply(K(float64(right)))
+	case ScalarExpression:
+		return productOf(fe, right)
+	case mat.VecDense:
+		return fe.Multiply(VecDenseToKVector(right))
+	case *mat.VecDense:
+		return fe.Multiply(VecDenseToKVector(*right))
+	case mat.Dense:
+		return fe.Multiply(DenseToKMatrix(right))
+	case *mat.Dense:
+		return fe.Multiply(DenseToKMatrix(*right))
+	case VectorExpression, MatrixExpression:
+		return elementwise(right.(Expression), func(se ScalarExpression) ScalarExpression {
+			return productOf(fe, se)
+		})
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpression.Multiply",
+			Input:        rightIn,
+		},
+	)
+}
+
+// Divide Computes the ratio of the function expression and another scalar expression.
+func (fe FunctionExpression) Divide(rightIn interface{}) Expression {
+	return ScalarDivideTemplate(fe, rightIn)
+}
+
+// elementwise Applies operation to each element of the vector or matrix expression e
+// and returns the concretized result.
+func elementwise(e Expression, operation func(se ScalarExpression) ScalarExpression) Expression {
+	dims := e.Dims()
+	var out [][]ScalarExpression
+	for ii := 0; ii < dims[0]; ii++ {
+		var row []ScalarExpression
+		for jj := 0; jj < dims[1]; jj++ {
+			row = append(row, operation(e.At(ii, jj)))
+		}
+		out = append(out, row)
+	}
+
+	return ConcretizeExpression(out)
+}
+
+// Transpose The transpose of a scalar is the same scalar.
+func (fe FunctionExpression) Transpose() Expression {
+	return fe
+}
+
+// LessEq Creates a less than equal constraint between the function expression and another expression.
+func (fe FunctionExpression) LessEq(rightIn interface{}) Constraint {
+	return fe.Comparison(rightIn, SenseLessThanEqual)
+}
+
+// GreaterEq Creates a greater than equal constraint between the function expression and another expression.
+func (fe FunctionExpression) GreaterEq(rightIn interface{}) Constraint {
+	return fe.Comparison(rightIn, SenseGreaterThanEqual)
+}
+
+// Eq Creates an equality constraint between the function expression and another expression.
+func (fe FunctionExpression) Eq(rightIn interface{}) Constraint {
+	return fe.Comparison(rightIn, SenseEqual)
+}
+
+// Between returns the range constraint lower <= fe <= upper.
+func (fe FunctionExpression) Between(lower, upper interface{}) Constraint {
+	return newScalarRangeConstraint(fe, lower, upper)
+}
+
+// Comparison Creates a constraint between the function expression and another scalar expression
+// of the sense provided in sense.
+func (fe FunctionExpression) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case float64:
+		return fe.Comparison(K(right), sense)
+	case int:
+		return fe.Comparison(K(float64(right)), sense)
+	case ScalarExpression:
+		return ScalarConstraint{fe, right, sense}
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpression.Comparison (" + sense.String() + ")",
+			Input:        rightIn,
+		},
+	)
+}
+
+// DerivativeWrt Computes the derivative of the function expression with respect to vIn
+// using the sum, product and chain rules.
+func (fe FunctionExpression) DerivativeWrt(vIn Variable) Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = vIn.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	if foundIndex, _ := FindInSlice(vIn, fe.Variables()); foundIndex == -1 {
+		return K(0.0)
+	}
+
+	switch fe.Function {
+	case FunctionSum:
+		var terms []ScalarExpression
+		for _, term := range fe.Arguments {
+			terms = append(terms, term.DerivativeWrt(vIn).(ScalarExpression))
+		}
+		return sumOf(terms...)
+
+	case FunctionProduct:
+		// d(f_0 f_1 ... f_n) = sum_i f_0 ... d(f_i) ... f_n
+		var terms []ScalarExpression
+		for ii, factor := range fe.Arguments {
+			factors := make([]ScalarExpression, len(fe.Arguments))
+			copy(factors, fe.Arguments)
+			factors[ii] = factor.DerivativeWrt(vIn).(ScalarExpression)
+			terms = append(terms, productOf(factors...))
+		}
+		return sumOf(terms...)
+
+	default:
+		// Chain Rule
+		argument := fe.Arguments[0]
+		return productOf(
+			fe.outerDerivative(),
+			argument.DerivativeWrt(vIn).(ScalarExpression),
+		)
+	}
+}
+
+// outerDerivative Returns the derivative of the unary function fe.Function evaluated at
+// fe's argument (e.g., cos(g) for sin(g)).
+func (fe FunctionExpression) outerDerivative() ScalarExpression {
+	argument := fe.Arguments[0]
+	switch fe.Function {
+	case FunctionExp:
+		return fe
+	case FunctionLog:
+		return applyFunction(FunctionReciprocal, argument)
+	case FunctionSin:
+		return applyFunction(FunctionCos, argument)
+	case FunctionCos:
+		return productOf(K(-1.0), applyFunction(FunctionSin, argument))
+	case FunctionSqrt:
+		return productOf(K(0.5), applyFunction(FunctionReciprocal, fe))
+	case FunctionAbs:
+		return applyFunction(FunctionSign, argument)
+	case FunctionSign:
+		return K(0.0)
+	case FunctionReciprocal:
+		return productOf(K(-1.0), fe, fe)
+	default:
+		panic(
+			smErrors.Errorf("the function %v is not unary", string(fe.Function)),
+		)
+	}
+}
+
+// String Returns a string representation of the function expression (e.g., "x_0 + sin(x_1)").
+func (fe FunctionExpression) String() string {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	switch fe.Function {
+	case FunctionSum:
+		var out string
+		for ii, term := range fe.Arguments {
+			termString := term.String()
+			switch {
+			case ii == 0:
+				out += termString
+			case strings.HasPrefix(termString, "-"):
+				out += " - " + strings.TrimPrefix(termString, "-")
+			default:
+				out += " + " + termString
+			}
+		}
+		return out
+
+	case FunctionProduct:
+		var factorStrings []string
+		for _, factor := range fe.Arguments {
+			factorStrings = append(factorStrings, stringAsFactor(factor))
+		}
+
+		// Write -1 * f as -f
+		if coefficient, isK := fe.Arguments[0].(K); isK && coefficient == -1.0 {
+			return "-" + strings.Join(factorStrings[1:], " * ")
+		}
+		return strings.Join(factorStrings, " * ")
+
+	case FunctionReciprocal:
+		return fmt.Sprintf("1/(%v)", fe.Arguments[0])
+
+	default:
+		return fmt.Sprintf("%v(%v)", string(fe.Function), fe.Arguments[0])
+	}
+}
+
+// stringAsFactor Returns the string of se, wrapped in parentheses when se is a sum
+// (or a ratio).
+func stringAsFactor(se ScalarExpression) string {
+	_, isRatio := se.(RationalExpression)
+	isSum := isSumExpression(se) || isRatio
+	if seAsPolynomial, isPolynomial := se.(Polynomial); isPolynomial {
+		isSum = len(seAsPolynomial.Monomials) > 1
+	}
+
+	if isSum {
+		return "(" + se.String() + ")"
+	}
+	return se.String()
+}
+
+// Substitute Replaces the variable vIn with the expression seIn in each of the arguments.
+func (fe FunctionExpression) Substitute(vIn Variable, seIn ScalarExpression) Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = vIn.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = seIn.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var arguments []ScalarExpression
+	for _, argument := range fe.Arguments {
+		arguments = append(arguments, argument.Substitute(vIn, seIn).(ScalarExpression))
+	}
+
+	return newFunctionExpression(fe.Function, arguments)
+}
+
+// SubstituteAccordingTo Replaces the variables in the map with the corresponding expressions
+// in each of the arguments.
+func (fe FunctionExpression) SubstituteAccordingTo(subMap map[Variable]Expression) Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = CheckSubstitutionMap(subMap)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var arguments []ScalarExpression
+	for _, argument := range fe.Arguments {
+		arguments = append(arguments, argument.SubstituteAccordingTo(subMap).(ScalarExpression))
+	}
+
+	return newFunctionExpression(fe.Function, arguments)
+}
+
+// Power Computes the power of the function expression.
+// Negative exponents produce powers of the reciprocal (e.g., sin(x)^-1 = 1/(sin(x))).
+func (fe FunctionExpression) Power(exponent int) Expression {
+	if exponent < 0 {
+		return scalarReciprocalPower(fe, exponent)
+	}
+	return ScalarPowerTemplate(fe, exponent)
+}
+
+// At Returns the value at the given row and column index.
+// Note:
+//
+// For a scalar, the indices should always be 0.
+func (fe FunctionExpression) At(ii, jj int) ScalarExpression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check to see whether or not the index is valid.
+	err = smErrors.CheckIndexOnMatrix(ii, jj, fe)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return fe
+}
+
+// AsSimplifiedExpression Simplifies each of the arguments, evaluates the functions of
+// constants and combines the polynomial-like terms of sums and products.
+func (fe FunctionExpression) AsSimplifiedExpression() Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var arguments []ScalarExpression
+	for _, argument := range fe.Arguments {
+		arguments = append(arguments, argument.AsSimplifiedExpression().(ScalarExpression))
+	}
+
+	return newFunctionExpression(fe.Function, arguments)
+}
+
+// Evaluate Returns the value of the function expression when each variable takes the value given in values.
+// If one of the expression's variables is not in the map, then this panics with a MissingVariableValueError.
+func (fe FunctionExpression) Evaluate(values map[Variable]float64) float64 {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	checkValuesForEvaluate(fe, values)
+
+	// Algorithm
+	var arguments []float64
+	for _, argument := range fe.Arguments {
+		arguments = append(arguments, argument.Evaluate(values))
+	}
+
+	return fe.Function.evaluate(arguments)
+}
+
+// EvaluateAt Returns the value of the function expression at the point x.
+func (fe FunctionExpression) EvaluateAt(x mat.VecDense, wrt ...[]Variable) float64 {
+	return fe.Evaluate(valueMapForEvaluateAt(fe, x, wrt))
+}
+
+// Equals Returns true if the function expression and other represent the same expression:
+// the same function applied to equal arguments (where the coefficients of matching terms
+// may differ by at most tol). The terms of sums and the factors of products may be in any order.
+func (fe FunctionExpression) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(fe, other, tol)
+}
+
+// Canonicalize Returns a copy of the function expression in which each argument is in
+// canonical form and the terms of sums (and the factors of products) are sorted: the
+// polynomial-like term comes first and the others are sorted by their String().
+func (fe FunctionExpression) Canonicalize() Expression {
+	// Input Processing
+	err := fe.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	simplified, isFE := fe.AsSimplifiedExpression().(FunctionExpression)
+	if !isFE {
+		return fe.AsSimplifiedExpression().Canonicalize()
+	}
+
+	var arguments []ScalarExpression
+	for _, argument := range simplified.Arguments {
+		arguments = append(arguments, argument.Canonicalize().(ScalarExpression))
+	}
+
+	if !simplified.Function.IsUnary() {
+		sort.SliceStable(arguments, func(i, j int) bool {
+			iIsPolynomialLike := IsPolynomialLikeScalar(arguments[i])
+			jIsPolynomialLike := IsPolynomialLikeScalar(arguments[j])
+			if iIsPolynomialLike != jIsPolynomialLike {
+				return iIsPolynomialLike
+			}
+			return arguments[i].String() < arguments[j].String()
+		})
+	}
+
+	return FunctionExpression{Function: simplified.Function, Arguments: arguments}
+}
@@ -0,0 +1,508 @@
+package symbolic
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+function_expression_matrix.go
+Description:
+
+	Defines a matrix of scalar expressions, at least one of which is a FunctionExpression
+	(e.g., the result of applying Exp to a VariableMatrix).
+*/
+
+// ===============
+// Type Definition
+// ===============
+
+// FunctionExpressionMatrix is a matrix whose elements may be any scalar expressions
+// (including FunctionExpressions).
+type FunctionExpressionMatrix [][]ScalarExpression
+
+// =========
+// Functions
+// =========
+
+// Check Verifies that:
+// - The matrix has at least one row
+// - The number of columns is the same in each row.
+// - Each of the elements in the matrix is valid.
+func (fem FunctionExpressionMatrix) Check() error {
+	// Check that the matrix has at least one row
+	if len(fem) == 0 {
+		return smErrors.EmptyMatrixError{Expression: fem}
+	}
+
+	// Check that the number of columns is the same in each row
+	numColumns := len(fem[0])
+	for ii, row := range fem {
+		if len(row) != numColumns {
+			return smErrors.MatrixColumnMismatchError{
+				ExpectedNColumns: numColumns,
+				ActualNColumns:   len(row),
+				Row:              ii,
+			}
+		}
+	}
+
+	// Check that each of the elements is well formed
+	for ii, row := range fem {
+		for jj, element := range row {
+			if element == nil {
+				return smErrors.Errorf("element %v,%v of the function expression matrix is nil", ii, jj)
+			}
+
+			err := element.Check()
+			if err != nil {
+				return smErrors.Errorf("error in element %v,%v: %v", ii, jj, err)
+			}
+		}
+	}
+
+	// All checks passed
+	return nil
+}
+
+// Variables Returns the unique variables in the matrix.
+func (fem FunctionExpressionMatrix) Variables() []Variable {
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	variables := []Variable{}
+	for _, row := range fem {
+		for _, element := range row {
+			variables = append(variables, element.Variables()...)
+		}
+	}
+
+	return UniqueVars(variables)
+}
+
+// Dims Returns the dimensions of the matrix.
+func (fem FunctionExpressionMatrix) Dims() []int {
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return []int{len(fem), len(fem[0])}
+}
+
+// Plus Addition of the matrix with another expression.
+func (fem FunctionExpressionMatrix) Plus(e interface{}) Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(e) {
+		eAsE, _ := ToExpression(e)
+		err = eAsE.Check()
+		if err != nil {
+			panic(smErrors.Errorf("error in second argument to Plus: %v", err))
+		}
+
+		err = smErrors.CheckDimensionsInAddition(fem, eAsE)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := e.(type) {
+	case float64:
+		return fem.Plus(K(right))
+	case mat.Dense:
+		return fem.Plus(DenseToKMatrix(right))
+	case *mat.Dense:
+		return fem.Plus(DenseToKMatrix(*right))
+	case ScalarExpression:
+		var sum [][]ScalarExpression
+		for _, row := range fem {
+			var sumRow []ScalarExpression
+			for _, element := range row {
+				sumRow = append(sumRow, element.Plus(right).(ScalarExpression))
+			}
+			sum = append(sum, sumRow)
+		}
+		return ConcretizeExpression(sum)
+	case MatrixExpression:
+		return MatrixPlusTemplate(fem, right)
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionMatrix.Plus",
+			Input:        e,
+		},
+	)
+}
+
+// Minus Subtracts another expression from the matrix and returns the result.
+func (fem FunctionExpressionMatrix) Minus(e interface{}) Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(e) {
+		eAsE, _ := ToExpression(e)
+		err = eAsE.Check()
+		if err != nil {
+			panic(smErrors.Errorf("error in second argument to Minus: %v", err))
+		}
+
+		err = smErrors.CheckDimensionsInSubtraction(fem, eAsE)
+		if err != nil {
+			panic(err)
+		}
+
+		// Perform the subtraction with Expression's Minus() function
+		return Minus(fem, eAsE)
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionMatrix.Minus",
+			Input:        e,
+		},
+	)
+}
+
+// Multiply Multiplication of the matrix with another expression.
+func (fem FunctionExpressionMatrix) Multiply(e interface{}) Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(e) {
+		eAsE, _ := ToExpression(e)
+		err = eAsE.Check()
+		if err != nil {
+			panic(smErrors.Errorf("error in second argument to Multiply: %v", err))
+		}
+
+		err = smErrors.CheckDimensionsInMultiplication(fem, eAsE)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := e.(type) {
+	case float64:
+		return fem.Multiply(K(right))
+	case mat.Dense:
+		return fem.Multiply(DenseToKMatrix(right))
+	case *mat.Dense:
+		return fem.Multiply(DenseToKMatrix(*right))
+	case ScalarExpression:
+		var product [][]ScalarExpression
+		for _, row := range fem {
+			var productRow []ScalarExpression
+			for _, element := range row {
+				productRow = append(productRow, element.Multiply(right).(ScalarExpression))
+			}
+			product = append(product, productRow)
+		}
+		return ConcretizeExpression(product)
+	case VectorExpression:
+		// Treat the vector as a matrix with a single column
+		var rightAsSlice [][]ScalarExpression
+		for ii := 0; ii < right.Len(); ii++ {
+			rightAsSlice = append(rightAsSlice, []ScalarExpression{right.AtVec(ii)})
+		}
+		return MatrixMultiplyTemplate(fem, FunctionExpressionMatrix(rightAsSlice))
+	case MatrixExpression:
+		return MatrixMultiplyTemplate(fem, right)
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionMatrix.Multiply",
+			Input:        e,
+		},
+	)
+}
+
+// Transpose Transposes the matrix.
+func (fem FunctionExpressionMatrix) Transpose() Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	nRows, nCols := fem.Dims()[0], fem.Dims()[1]
+	var femT FunctionExpressionMatrix
+	for rowIndex := 0; rowIndex < nCols; rowIndex++ {
+		tempRow := make([]ScalarExpression, nRows)
+		for colIndex := 0; colIndex < nRows; colIndex++ {
+			tempRow[colIndex] = fem[colIndex][rowIndex]
+		}
+		femT = append(femT, tempRow)
+	}
+
+	return femT
+}
+
+// Comparison Compares the matrix to another expression.
+func (fem FunctionExpressionMatrix) Comparison(e interface{}, sense ConstrSense) Constraint {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(e) {
+		eAsE, _ := ToExpression(e)
+		err = eAsE.Check()
+		if err != nil {
+			panic(smErrors.Errorf("error in second argument to Comparison: %v", err))
+		}
+
+		err = CheckDimensionsInComparison(fem, eAsE, sense)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := e.(type) {
+	case float64:
+		return fem.Comparison(K(right), sense)
+	case K:
+		// Convert the scalar to a constant matrix
+		onesMat := OnesMatrix(fem.Dims()[0], fem.Dims()[1])
+		var KAsDense mat.Dense
+		KAsDense.Scale(float64(right), &onesMat)
+		return fem.Comparison(DenseToKMatrix(KAsDense), sense)
+	case mat.Dense:
+		return fem.Comparison(DenseToKMatrix(right), sense)
+	case *mat.Dense:
+		return fem.Comparison(DenseToKMatrix(*right), sense)
+	case MatrixExpression:
+		return MatrixConstraint{
+			LeftHandSide:  fem,
+			RightHandSide: right,
+			Sense:         sense,
+		}
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionMatrix.Comparison",
+			Input:        e,
+		},
+	)
+}
+
+// LessEq Compares the matrix to another expression using the SenseLessThanEqual sense.
+func (fem FunctionExpressionMatrix) LessEq(e interface{}) Constraint {
+	return fem.Comparison(e, SenseLessThanEqual)
+}
+
+// GreaterEq Compares the matrix to another expression using the SenseGreaterThanEqual sense.
+func (fem FunctionExpressionMatrix) GreaterEq(e interface{}) Constraint {
+	return fem.Comparison(e, SenseGreaterThanEqual)
+}
+
+// Eq Compares the matrix to another expression using the SenseEqual sense.
+func (fem FunctionExpressionMatrix) Eq(e interface{}) Constraint {
+	return fem.Comparison(e, SenseEqual)
+}
+
+// DerivativeWrt Returns the derivative of each element of the matrix with respect to vIn.
+func (fem FunctionExpressionMatrix) DerivativeWrt(vIn Variable) Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = vIn.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var derivative [][]ScalarExpression
+	for _, row := range fem {
+		var derivativeRow []ScalarExpression
+		for _, element := range row {
+			derivativeRow = append(derivativeRow, element.DerivativeWrt(vIn).(ScalarExpression))
+		}
+		derivative = append(derivative, derivativeRow)
+	}
+
+	return ConcretizeMatrixExpression(derivative)
+}
+
+// At Returns the (ii, jj)-th element of the matrix.
+func (fem FunctionExpressionMatrix) At(ii int, jj int) ScalarExpression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = smErrors.CheckIndexOnMatrix(ii, jj, fem)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return fem[ii][jj]
+}
+
+// Constant Returns the constant additive value of each element (see FunctionExpression.Constant).
+func (fem FunctionExpressionMatrix) Constant() mat.Dense {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	nRows, nCols := fem.Dims()[0], fem.Dims()[1]
+	constant := ZerosMatrix(nRows, nCols)
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			constant.Set(ii, jj, fem[ii][jj].Constant())
+		}
+	}
+
+	return constant
+}
+
+// AsSimplifiedExpression Simplifies each element of the matrix.
+func (fem FunctionExpressionMatrix) AsSimplifiedExpression() Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var simplified [][]ScalarExpression
+	for _, row := range fem {
+		var simplifiedRow []ScalarExpression
+		for _, element := range row {
+			simplifiedRow = append(simplifiedRow, element.AsSimplifiedExpression().(ScalarExpression))
+		}
+		simplified = append(simplified, simplifiedRow)
+	}
+
+	return ConcretizeMatrixExpression(simplified)
+}
+
+// String Returns a string representation of the matrix.
+func (fem FunctionExpressionMatrix) String() string {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	nRows, nCols := fem.Dims()[0], fem.Dims()[1]
+	var out string = "FunctionExpressionMatrix =\n["
+	for ii, row := range fem {
+		out += "["
+		for jj, element := range row {
+			out += element.String()
+			if jj != nCols-1 {
+				out += ", "
+			}
+		}
+		out += "]"
+		if ii != nRows-1 {
+			out += ",\n"
+		}
+	}
+	out += "]"
+
+	return out
+}
+
+// Substitute Substitutes the variable vIn with the expression eIn in each element.
+func (fem FunctionExpressionMatrix) Substitute(vIn Variable, eIn ScalarExpression) Expression {
+	return MatrixSubstituteTemplate(fem, vIn, eIn)
+}
+
+// SubstituteAccordingTo Substitutes the variables in the map with the corresponding
+// expressions in each element.
+func (fem FunctionExpressionMatrix) SubstituteAccordingTo(subMap map[Variable]Expression) Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = CheckSubstitutionMap(subMap)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var result [][]ScalarExpression
+	for _, row := range fem {
+		var resultRow []ScalarExpression
+		for _, element := range row {
+			resultRow = append(resultRow, element.SubstituteAccordingTo(subMap).(ScalarExpression))
+		}
+		result = append(result, resultRow)
+	}
+
+	return ConcretizeMatrixExpression(result)
+}
+
+// Power Computes the power of the (square) matrix.
+func (fem FunctionExpressionMatrix) Power(exponent int) Expression {
+	return MatrixPowerTemplate(fem, exponent)
+}
+
+// Evaluate Returns the value of the matrix when each variable takes the value given in the values map.
+func (fem FunctionExpressionMatrix) Evaluate(values map[Variable]float64) mat.Dense {
+	return MatrixEvaluateTemplate(fem, values)
+}
+
+// EvaluateAt Returns the value of the matrix at the point x.
+func (fem FunctionExpressionMatrix) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.Dense {
+	return fem.Evaluate(valueMapForEvaluateAt(fem, x, wrt))
+}
+
+// Equals Returns true if the matrix and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (fem FunctionExpressionMatrix) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(fem, other, tol)
+}
+
+// Canonicalize Returns a copy of the matrix in which each element is in canonical form.
+func (fem FunctionExpressionMatrix) Canonicalize() Expression {
+	// Input Processing
+	err := fem.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var femOut FunctionExpressionMatrix
+	for _, row := range fem {
+		var rowOut []ScalarExpression
+		for _, element := range row {
+			rowOut = append(rowOut, element.Canonicalize().(ScalarExpression))
+		}
+		femOut = append(femOut, rowOut)
+	}
+	return femOut
+}
@@ -0,0 +1,461 @@
+package symbolic
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+function_expression_vector.go
+Description:
+	Defines a vector of scalar expressions, at least one of which is a FunctionExpression
+	(e.g., the result of applying Sin to a VariableVector).
+*/
+
+// FunctionExpressionVector is a vector whose elements may be any scalar expressions
+// (including FunctionExpressions).
+type FunctionExpressionVector []ScalarExpression
+
+// =========
+// Functions
+// =========
+
+// Check Verifies that the vector is not empty and that each of its elements is valid.
+func (fev FunctionExpressionVector) Check() error {
+	// Check that the vector has at least one element
+	if len(fev) == 0 {
+		return smErrors.EmptyVectorError{Expression: fev}
+	}
+
+	// Check each element
+	for ii, element := range fev {
+		if element == nil {
+			return smErrors.Errorf("element %v of the function expression vector is nil", ii)
+		}
+
+		err := element.Check()
+		if err != nil {
+			return smErrors.Errorf("error in element %v: %v", ii, err)
+		}
+	}
+
+	// All checks passed
+	return nil
+}
+
+// Length The number of elements in the vector.
+func (fev FunctionExpressionVector) Length() int {
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return len(fev)
+}
+
+// Len Mirrors the gonum api for vectors.
+func (fev FunctionExpressionVector) Len() int {
+	return fev.Length()
+}
+
+// At Returns the element at the (ii,jj) index.
+// Note:
+//
+// - The jj index should always be 0.
+func (fev FunctionExpressionVector) At(ii, jj int) ScalarExpression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = smErrors.CheckIndexOnMatrix(ii, jj, fev)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return fev[ii]
+}
+
+// AtVec Retrieves the element at the index idx.
+func (fev FunctionExpressionVector) AtVec(idx int) ScalarExpression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = smErrors.CheckIndexOnVector(idx, fev)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return fev[idx]
+}
+
+// Variables Retrieves the set of all unique variables in the vector.
+func (fev FunctionExpressionVector) Variables() []Variable {
+	var variables []Variable
+	for _, element := range fev {
+		variables = append(variables, element.Variables()...)
+	}
+	return UniqueVars(variables)
+}
+
+// Constant Returns the constant additive value of each element (see FunctionExpression.Constant).
+func (fev FunctionExpressionVector) Constant() mat.VecDense {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	constant := ZerosVector(fev.Len())
+	for ii, element := range fev {
+		constant.SetVec(ii, element.Constant())
+	}
+	return constant
+}
+
+// LinearCoeff Panics, because a vector containing function expressions is not linear.
+func (fev FunctionExpressionVector) LinearCoeff(wrt ...[]Variable) mat.Dense {
+	panic(
+		smErrors.LinearExpressionRequiredError{
+			Operation:  "LinearCoeff",
+			Expression: fev,
+		},
+	)
+}
+
+// Plus Defines an addition between the vector and another expression.
+func (fev FunctionExpressionVector) Plus(e interface{}) Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(e) {
+		eAsE, _ := ToExpression(e)
+		err = eAsE.Check()
+		if err != nil {
+			panic(smErrors.Errorf("error in second argument to Plus: %v", err))
+		}
+
+		err = smErrors.CheckDimensionsInAddition(fev, eAsE)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := e.(type) {
+	case float64:
+		return fev.Plus(K(right))
+	case mat.VecDense:
+		return fev.Plus(VecDenseToKVector(right))
+	case *mat.VecDense:
+		return fev.Plus(VecDenseToKVector(*right))
+	case Expression:
+		return VectorPlusTemplate(fev, right)
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionVector.Plus",
+			Input:        e,
+		},
+	)
+}
+
+// Minus Defines a subtraction between the vector and another expression.
+func (fev FunctionExpressionVector) Minus(e interface{}) Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(e) {
+		eAsE, _ := ToExpression(e)
+		err = eAsE.Check()
+		if err != nil {
+			panic(smErrors.Errorf("error in second argument to Minus: %v", err))
+		}
+
+		err = smErrors.CheckDimensionsInSubtraction(fev, eAsE)
+		if err != nil {
+			panic(err)
+		}
+
+		// Use the Expression's Minus method
+		return Minus(fev, eAsE)
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionVector.Minus",
+			Input:        e,
+		},
+	)
+}
+
+// Multiply Computes the product of the vector and a scalar expression.
+func (fev FunctionExpressionVector) Multiply(rightIn interface{}) Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+
+		err = smErrors.CheckDimensionsInMultiplication(fev, rightAsE)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case float64:
+		return fev.Multiply(K(right))
+	case ScalarExpression:
+		return VectorMultiplyTemplate(fev, right)
+	case VectorExpression:
+		// This should only be true if right is a vector of length 1.
+		return fev.Multiply(right.AtVec(0))
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionVector.Multiply",
+			Input:        rightIn,
+		},
+	)
+}
+
+// Transpose Returns the transpose of the vector (a matrix with a single row).
+func (fev FunctionExpressionVector) Transpose() Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	row := make([]ScalarExpression, len(fev))
+	copy(row, fev)
+	return FunctionExpressionMatrix{row}
+}
+
+// Dims Returns the shape of the vector which should always be (fev.Len(), 1).
+func (fev FunctionExpressionVector) Dims() []int {
+	return []int{fev.Len(), 1}
+}
+
+// Comparison Creates the vector constraint between the vector and another
+// expression according to the sense senseIn.
+func (fev FunctionExpressionVector) Comparison(e interface{}, senseIn ConstrSense) Constraint {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(e) {
+		eAsE, _ := ToExpression(e)
+		err := eAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+
+		err = CheckDimensionsInComparison(fev, eAsE, senseIn)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := e.(type) {
+	case float64:
+		return fev.Comparison(K(right), senseIn)
+	case K:
+		// Convert the scalar to a constant vector
+		tempVD := OnesVector(fev.Len())
+		tempVD.ScaleVec(float64(right), &tempVD)
+		return fev.Comparison(VecDenseToKVector(tempVD), senseIn)
+	case mat.VecDense:
+		return fev.Comparison(VecDenseToKVector(right), senseIn)
+	case *mat.VecDense:
+		return fev.Comparison(VecDenseToKVector(*right), senseIn)
+	case VectorExpression:
+		return VectorConstraint{
+			LeftHandSide:  fev,
+			RightHandSide: right,
+			Sense:         senseIn,
+		}
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "FunctionExpressionVector.Comparison",
+			Input:        e,
+		},
+	)
+}
+
+// LessEq Returns a vector constraint between fev and the input expression.
+func (fev FunctionExpressionVector) LessEq(e interface{}) Constraint {
+	return fev.Comparison(e, SenseLessThanEqual)
+}
+
+// GreaterEq Returns a vector constraint between fev and the input expression.
+func (fev FunctionExpressionVector) GreaterEq(e interface{}) Constraint {
+	return fev.Comparison(e, SenseGreaterThanEqual)
+}
+
+// Eq Returns a vector constraint between fev and the input expression.
+func (fev FunctionExpressionVector) Eq(e interface{}) Constraint {
+	return fev.Comparison(e, SenseEqual)
+}
+
+// Between returns the elementwise range constraint lower <= fev <= upper.
+// Each bound is either a constant or a constant vector with the same length as fev.
+func (fev FunctionExpressionVector) Between(lower, upper interface{}) Constraint {
+	return newVectorRangeConstraint(fev, lower, upper)
+}
+
+// DerivativeWrt Returns the derivative of each element of the vector with respect to vIn.
+func (fev FunctionExpressionVector) DerivativeWrt(vIn Variable) Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var derivative []ScalarExpression
+	for _, element := range fev {
+		derivative = append(derivative, element.DerivativeWrt(vIn).(ScalarExpression))
+	}
+
+	return ConcretizeVectorExpression(derivative)
+}
+
+// AsSimplifiedExpression Simplifies each element of the vector.
+func (fev FunctionExpressionVector) AsSimplifiedExpression() Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var simplified []ScalarExpression
+	for _, element := range fev {
+		simplified = append(simplified, element.AsSimplifiedExpression().(ScalarExpression))
+	}
+
+	return ConcretizeVectorExpression(simplified)
+}
+
+// String Returns a string representation of the vector.
+func (fev FunctionExpressionVector) String() string {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var output string = "FunctionExpressionVector = ["
+	for ii, element := range fev {
+		output += element.String()
+		if ii != len(fev)-1 {
+			output += ", "
+		}
+	}
+	output += "]"
+	return output
+}
+
+// Substitute Substitutes the variable vIn with the expression eIn in each element.
+func (fev FunctionExpressionVector) Substitute(vIn Variable, eIn ScalarExpression) Expression {
+	return VectorSubstituteTemplate(fev, vIn, eIn)
+}
+
+// SubstituteAccordingTo Substitutes the variables in the map with the corresponding
+// expressions in each element.
+func (fev FunctionExpressionVector) SubstituteAccordingTo(subMap map[Variable]Expression) Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = CheckSubstitutionMap(subMap)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var result []ScalarExpression
+	for _, element := range fev {
+		result = append(result, element.SubstituteAccordingTo(subMap).(ScalarExpression))
+	}
+
+	return ConcretizeVectorExpression(result)
+}
+
+// Power Computes the power of the vector (which must have length 1).
+func (fev FunctionExpressionVector) Power(exponent int) Expression {
+	return VectorPowerTemplate(fev, exponent)
+}
+
+// ToScalarExpressions Returns the elements of the vector as a slice of ScalarExpressions.
+func (fev FunctionExpressionVector) ToScalarExpressions() []ScalarExpression {
+	out := make([]ScalarExpression, len(fev))
+	copy(out, fev)
+	return out
+}
+
+// Evaluate Returns the value of the vector when each variable takes the value given in the values map.
+func (fev FunctionExpressionVector) Evaluate(values map[Variable]float64) mat.VecDense {
+	return VectorEvaluateTemplate(fev, values)
+}
+
+// EvaluateAt Returns the value of the vector at the point x.
+func (fev FunctionExpressionVector) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.VecDense {
+	return fev.Evaluate(valueMapForEvaluateAt(fev, x, wrt))
+}
+
+// Equals Returns true if the vector and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (fev FunctionExpressionVector) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(fev, other, tol)
+}
+
+// Canonicalize Returns a copy of the vector in which each element is in canonical form.
+func (fev FunctionExpressionVector) Canonicalize() Expression {
+	// Input Processing
+	err := fev.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var fevOut FunctionExpressionVector
+	for _, element := range fev {
+		fevOut = append(fevOut, element.Canonicalize().(ScalarExpression))
+	}
+	return fevOut
+}
@@ -0,0 +1,490 @@
+package symbolic
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+)
+
+/*
+groebner.go
+Description:
+	Monomial orders, multivariate division (Reduce) and the computation of reduced
+	Groebner bases (with Buchberger's algorithm).
+
+	The computations are done in exact rational arithmetic (every float64 coefficient is a
+	rational number), because the cancellations that Buchberger's algorithm relies on are
+	destroyed by rounding errors. The results are converted back to float64 coefficients.
+*/
+
+// MonomialOrder is a total order on the monomials that is used to choose the leading
+// term of a polynomial. The variables are ordered by ID: the variable with the lowest ID
+// is the largest (i.e., x_0 > x_1 > x_2 > ...).
+type MonomialOrder string
+
+// The supported monomial orders.
+const (
+	// LexOrder compares the exponents of x_0, then of x_1, and so on.
+	LexOrder MonomialOrder = "lex"
+	// GradedLexOrder compares the total degrees and then breaks ties with LexOrder.
+	GradedLexOrder MonomialOrder = "grlex"
+	// GradedReverseLexOrder compares the total degrees and then breaks ties by the
+	// exponent of the variable with the highest ID (the smaller exponent is larger).
+	GradedReverseLexOrder MonomialOrder = "grevlex"
+)
+
+// String returns a string representation of the monomial order (e.g., "grevlex").
+func (order MonomialOrder) String() string {
+	return string(order)
+}
+
+// Check This method checks if the receiver is one of the supported monomial orders.
+func (order MonomialOrder) Check() error {
+	switch order {
+	case LexOrder:
+		return nil
+	case GradedLexOrder:
+		return nil
+	case GradedReverseLexOrder:
+		return nil
+	default:
+		return smErrors.Errorf("unexpected monomial order: %v!", string(order))
+	}
+}
+
+// compareExponents Compares the exponent vectors a and b (listed in increasing order of
+// variable ID) and returns +1 if a is larger than b, -1 if it is smaller and 0 if they are equal.
+func (order MonomialOrder) compareExponents(a, b []int) int {
+	sign := func(difference int) int {
+		switch {
+		case difference > 0:
+			return 1
+		case difference < 0:
+			return -1
+		default:
+			return 0
+		}
+	}
+
+	if order != LexOrder {
+		degreeA, degreeB := 0, 0
+		for ii := range a {
+			degreeA += a[ii]
+			degreeB += b[ii]
+		}
+		if degreeA != degreeB {
+			return sign(degreeA - degreeB)
+		}
+	}
+
+	if order == GradedReverseLexOrder {
+		for ii := len(a) - 1; ii >= 0; ii-- {
+			if a[ii] != b[ii] {
+				return sign(b[ii] - a[ii])
+			}
+		}
+		return 0
+	}
+
+	for ii := range a {
+		if a[ii] != b[ii] {
+			return sign(a[ii] - b[ii])
+		}
+	}
+	return 0
+}
+
+// Compare Compares the monomials m1 and m2 (ignoring their coefficients) in the
+// monomial order. It returns +1 if m1 is larger than m2, -1 if it is smaller and 0 if
+// the two monomials are the same product of variables.
+func (order MonomialOrder) Compare(m1, m2 Monomial) int {
+	// Input Processing
+	err := order.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, m := range []Monomial{m1, m2} {
+		err = m.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	ring := newExactRing(
+		[]Polynomial{m1.ToPolynomial(), m2.ToPolynomial()},
+		order,
+	)
+	return order.compareExponents(ring.exponentsOf(m1), ring.exponentsOf(m2))
+}
+
+// exactTerm is a term of an exactPolynomial: a rational coefficient times the product of
+// the variables of the ring raised to the given exponents.
+type exactTerm struct {
+	Exponents   []int
+	Coefficient *big.Rat
+}
+
+// exactPolynomial is a polynomial with rational coefficients whose (nonzero) terms are
+// sorted in decreasing monomial order. The zero polynomial has no terms.
+type exactPolynomial []exactTerm
+
+// exactRing contains the variables (sorted by ID) and the monomial order that are used to
+// represent polynomials as exactPolynomials.
+type exactRing struct {
+	Variables []Variable
+	Order     MonomialOrder
+	indexOfID map[uint64]int
+}
+
+// newExactRing Creates the ring of all of the variables of the given polynomials.
+func newExactRing(polynomials []Polynomial, order MonomialOrder) exactRing {
+	var variables []Variable
+	for _, p := range polynomials {
+		variables = append(variables, p.Variables()...)
+	}
+	variables = UniqueVars(variables)
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].ID < variables[j].ID
+	})
+
+	ring := exactRing{
+		Variables: variables,
+		Order:     order,
+		indexOfID: make(map[uint64]int, len(variables)),
+	}
+	for ii, v := range variables {
+		ring.indexOfID[v.ID] = ii
+	}
+	return ring
+}
+
+// exponentsOf Returns the exponent vector of the monomial m in the ring.
+func (ring exactRing) exponentsOf(m Monomial) []int {
+	exponents := make([]int, len(ring.Variables))
+	for _, factor := range m.sortedFactors() {
+		exponents[ring.indexOfID[factor.Variable.ID]] = factor.Exponent
+	}
+	return exponents
+}
+
+// fromPolynomial Converts the polynomial p into an exactPolynomial, combining its
+// matching terms exactly.
+func (ring exactRing) fromPolynomial(p Polynomial) exactPolynomial {
+	var ep exactPolynomial
+	indexOfKey := make(map[MonomialKey]int, len(p.Monomials))
+	for _, monomial := range p.Monomials {
+		coefficient := new(big.Rat).SetFloat64(monomial.Coefficient)
+		key := monomial.Key()
+		if index, found := indexOfKey[key]; found {
+			ep[index].Coefficient.Add(ep[index].Coefficient, coefficient)
+			continue
+		}
+
+		indexOfKey[key] = len(ep)
+		ep = append(ep, exactTerm{Exponents: ring.exponentsOf(monomial), Coefficient: coefficient})
+	}
+
+	nonZero := ep[:0]
+	for _, term := range ep {
+		if term.Coefficient.Sign() != 0 {
+			nonZero = append(nonZero, term)
+		}
+	}
+	sort.Slice(nonZero, func(i, j int) bool {
+		return ring.Order.compareExponents(nonZero[i].Exponents, nonZero[j].Exponents) > 0
+	})
+	return nonZero
+}
+
+// toPolynomial Converts the exactPolynomial ep into a Polynomial (with its terms in
+// decreasing monomial order).
+func (ring exactRing) toPolynomial(ep exactPolynomial) Polynomial {
+	if len(ep) == 0 {
+		return K(0.0).ToPolynomial()
+	}
+
+	p := Polynomial{Monomials: make([]Monomial, len(ep))}
+	for ii, term := range ep {
+		coefficient, _ := term.Coefficient.Float64()
+		monomial := Monomial{
+			Coefficient:     coefficient,
+			VariableFactors: []Variable{},
+			Exponents:       []int{},
+		}
+		for jj, exponent := range term.Exponents {
+			if exponent != 0 {
+				monomial.VariableFactors = append(monomial.VariableFactors, ring.Variables[jj])
+				monomial.Exponents = append(monomial.Exponents, exponent)
+			}
+		}
+		p.Monomials[ii] = monomial
+	}
+	return p
+}
+
+// exponentsDivide Returns true if the monomial with exponents a divides the monomial with
+// exponents b.
+func exponentsDivide(a, b []int) bool {
+	for ii := range a {
+		if a[ii] > b[ii] {
+			return false
+		}
+	}
+	return true
+}
+
+// subtractMultiple Returns p - coefficient * x^shift * q (where x^shift is the monomial
+// with the exponents shift).
+func (ring exactRing) subtractMultiple(p, q exactPolynomial, coefficient *big.Rat, shift []int) exactPolynomial {
+	scaled := make(exactPolynomial, len(q))
+	for ii, term := range q {
+		exponents := make([]int, len(shift))
+		for jj := range shift {
+			exponents[jj] = term.Exponents[jj] + shift[jj]
+		}
+		scaled[ii] = exactTerm{
+			Exponents:   exponents,
+			Coefficient: new(big.Rat).Neg(new(big.Rat).Mul(coefficient, term.Coefficient)),
+		}
+	}
+
+	// Merge the two sorted lists of terms
+	result := make(exactPolynomial, 0, len(p)+len(scaled))
+	ii, jj := 0, 0
+	for ii < len(p) || jj < len(scaled) {
+		switch {
+		case jj == len(scaled):
+			result = append(result, p[ii])
+			ii++
+		case ii == len(p):
+			result = append(result, scaled[jj])
+			jj++
+		default:
+			switch ring.Order.compareExponents(p[ii].Exponents, scaled[jj].Exponents) {
+			case 1:
+				result = append(result, p[ii])
+				ii++
+			case -1:
+				result = append(result, scaled[jj])
+				jj++
+			default:
+				sum := new(big.Rat).Add(p[ii].Coefficient, scaled[jj].Coefficient)
+				if sum.Sign() != 0 {
+					result = append(result, exactTerm{Exponents: p[ii].Exponents, Coefficient: sum})
+				}
+				ii++
+				jj++
+			}
+		}
+	}
+	return result
+}
+
+// normalForm Returns the remainder of the (full) multivariate division of p by the
+// nonzero polynomials of the basis: the leading term of p is repeatedly cancelled with
+// the first element of the basis whose leading monomial divides it, and is otherwise
+// moved to the remainder.
+func (ring exactRing) normalForm(p exactPolynomial, basis []exactPolynomial) exactPolynomial {
+	var remainder exactPolynomial
+	for len(p) > 0 {
+		lead := p[0]
+		divided := false
+		for _, g := range basis {
+			if !exponentsDivide(g[0].Exponents, lead.Exponents) {
+				continue
+			}
+
+			shift := make([]int, len(lead.Exponents))
+			for ii := range shift {
+				shift[ii] = lead.Exponents[ii] - g[0].Exponents[ii]
+			}
+			p = ring.subtractMultiple(p, g, new(big.Rat).Quo(lead.Coefficient, g[0].Coefficient), shift)
+			divided = true
+			break
+		}
+
+		if !divided {
+			remainder = append(remainder, lead)
+			p = p[1:]
+		}
+	}
+	return remainder
+}
+
+// monic Returns the (nonzero) exactPolynomial p divided by its leading coefficient.
+func (ep exactPolynomial) monic() exactPolynomial {
+	lead := ep[0].Coefficient
+	result := make(exactPolynomial, len(ep))
+	for ii, term := range ep {
+		result[ii] = exactTerm{Exponents: term.Exponents, Coefficient: new(big.Rat).Quo(term.Coefficient, lead)}
+	}
+	return result
+}
+
+// sPolynomial Returns the S-polynomial of the (monic) polynomials f and g:
+// (L / LM(f)) f - (L / LM(g)) g, where L is the least common multiple of their leading monomials.
+func (ring exactRing) sPolynomial(f, g exactPolynomial) exactPolynomial {
+	n := len(ring.Variables)
+	shiftF, shiftG := make([]int, n), make([]int, n)
+	for ii := 0; ii < n; ii++ {
+		lcm := max(f[0].Exponents[ii], g[0].Exponents[ii])
+		shiftF[ii] = lcm - f[0].Exponents[ii]
+		shiftG[ii] = lcm - g[0].Exponents[ii]
+	}
+
+	scaledF := ring.subtractMultiple(nil, f, big.NewRat(-1, 1), shiftF)
+	return ring.subtractMultiple(scaledF, g, big.NewRat(1, 1), shiftG)
+}
+
+// leadingMonomialsAreCoprime Returns true if the leading monomials of f and g have no
+// common variable (in which case the S-polynomial of f and g reduces to zero).
+func leadingMonomialsAreCoprime(f, g exactPolynomial) bool {
+	for ii := range f[0].Exponents {
+		if f[0].Exponents[ii] > 0 && g[0].Exponents[ii] > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Reduce Returns the remainder of the multivariate division of the polynomial p by the
+// polynomials of the basis, using the given monomial order. No term of the remainder is
+// divisible by the leading monomial of an element of the basis. When the basis is a
+// Groebner basis, the remainder is unique (and is zero exactly when p belongs to the
+// ideal generated by the basis).
+func Reduce(p Polynomial, basis []Polynomial, order MonomialOrder) Polynomial {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, g := range basis {
+		err = g.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	err = order.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	ring := newExactRing(append([]Polynomial{p}, basis...), order)
+
+	var exactBasis []exactPolynomial
+	for _, g := range basis {
+		if eg := ring.fromPolynomial(g); len(eg) > 0 {
+			exactBasis = append(exactBasis, eg)
+		}
+	}
+
+	return ring.toPolynomial(ring.normalForm(ring.fromPolynomial(p), exactBasis))
+}
+
+// GroebnerBasis Returns the reduced Groebner basis of the ideal generated by the given
+// polynomials, using the given monomial order. The polynomials of the basis are monic and
+// are sorted in decreasing order of their leading monomials, so that, for LexOrder, the
+// polynomials which only contain the variables with the highest IDs come last (which is
+// useful to eliminate variables). The basis of the zero ideal is empty.
+//
+// The basis is computed with Buchberger's algorithm, skipping the pairs whose leading
+// monomials are coprime.
+func GroebnerBasis(polys []Polynomial, order MonomialOrder) []Polynomial {
+	// Input Processing
+	for _, p := range polys {
+		err := p.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	err := order.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	ring := newExactRing(polys, order)
+
+	var basis []exactPolynomial
+	for _, p := range polys {
+		if ep := ring.fromPolynomial(p); len(ep) > 0 {
+			basis = append(basis, ep.monic())
+		}
+	}
+
+	// Buchberger's algorithm
+	type pair struct{ I, J int }
+	var pairs []pair
+	for jj := range basis {
+		for ii := 0; ii < jj; ii++ {
+			pairs = append(pairs, pair{ii, jj})
+		}
+	}
+
+	for len(pairs) > 0 {
+		current := pairs[0]
+		pairs = pairs[1:]
+
+		f, g := basis[current.I], basis[current.J]
+		if leadingMonomialsAreCoprime(f, g) {
+			continue
+		}
+
+		remainder := ring.normalForm(ring.sPolynomial(f, g), basis)
+		if len(remainder) == 0 {
+			continue
+		}
+
+		basis = append(basis, remainder.monic())
+		for ii := 0; ii < len(basis)-1; ii++ {
+			pairs = append(pairs, pair{ii, len(basis) - 1})
+		}
+	}
+
+	// Keep the polynomials whose leading monomial is not divisible by another one
+	// (of the polynomials with the same leading monomial, the first one is kept)
+	var minimal []exactPolynomial
+	for ii, f := range basis {
+		isMinimal := true
+		for jj, g := range basis {
+			if ii == jj || !exponentsDivide(g[0].Exponents, f[0].Exponents) {
+				continue
+			}
+
+			if ring.Order.compareExponents(g[0].Exponents, f[0].Exponents) != 0 || jj < ii {
+				isMinimal = false
+				break
+			}
+		}
+
+		if isMinimal {
+			minimal = append(minimal, f)
+		}
+	}
+
+	// Reduce each polynomial by the others
+	reduced := make([]exactPolynomial, len(minimal))
+	for ii, f := range minimal {
+		others := make([]exactPolynomial, 0, len(minimal)-1)
+		others = append(others, minimal[:ii]...)
+		others = append(others, minimal[ii+1:]...)
+		reduced[ii] = append(exactPolynomial{f[0]}, ring.normalForm(f[1:], others)...)
+	}
+
+	sort.Slice(reduced, func(i, j int) bool {
+		return ring.Order.compareExponents(reduced[i][0].Exponents, reduced[j][0].Exponents) > 0
+	})
+
+	groebnerBasis := make([]Polynomial, len(reduced))
+	for ii, f := range reduced {
+		groebnerBasis[ii] = ring.toPolynomial(f)
+	}
+	return groebnerBasis
+}
@@ -0,0 +1,207 @@
+package symbolic
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+integrals.go
+Description:
+	Exact integration of polynomial-like expressions: antiderivatives (IntegrateWrt),
+	definite integrals (DefiniteIntegral), integrals over boxes (IntegrateOverBox) and
+	the closed-form integrals of monomials over the unit simplex (IntegrateOverUnitSimplex).
+	Vector and matrix expressions are integrated element by element.
+*/
+
+// checkIntegrand Panics if e is not a well-defined polynomial-like expression and
+// otherwise returns it as an Expression.
+func checkIntegrand(e PolynomialLike, operation string) Expression {
+	if !IsPolynomialLike(e) {
+		panic(
+			smErrors.UnsupportedInputError{
+				FunctionName: operation,
+				Input:        e,
+			},
+		)
+	}
+
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	eAsExpression, err := ToExpression(e)
+	if err != nil {
+		panic(err)
+	}
+	return eAsExpression
+}
+
+// integrateMonomials Applies the operation to each monomial of each element of the
+// polynomial-like expression e and returns the (concretized) sums of the results.
+func integrateMonomials(e Expression, operation func(m Monomial) Monomial) Expression {
+	return elementwise(e, func(se ScalarExpression) ScalarExpression {
+		p := toPolynomial(se)
+		integral := Polynomial{Monomials: make([]Monomial, len(p.Monomials))}
+		for ii, monomial := range p.Monomials {
+			integral.Monomials[ii] = operation(monomial)
+		}
+		return integral.Simplify().AsSimplifiedExpression().(ScalarExpression)
+	})
+}
+
+// splitMonomial Returns the exponent of v in the monomial m and the monomial m without
+// the factors of v.
+func splitMonomial(m Monomial, v Variable) (int, Monomial) {
+	exponent := 0
+	rest := Monomial{
+		Coefficient:     m.Coefficient,
+		VariableFactors: []Variable{},
+		Exponents:       []int{},
+	}
+	for _, factor := range m.sortedFactors() {
+		if factor.Variable.ID == v.ID {
+			exponent = factor.Exponent
+			continue
+		}
+		rest.VariableFactors = append(rest.VariableFactors, factor.Variable)
+		rest.Exponents = append(rest.Exponents, factor.Exponent)
+	}
+	return exponent, rest
+}
+
+// IntegrateWrt Returns the antiderivative of the polynomial-like expression e with
+// respect to v (with zero constant of integration), e.g., the antiderivative of
+// 3 x^2 y with respect to x is x^3 y. The other variables are treated as constants.
+func IntegrateWrt(e PolynomialLike, v Variable) Expression {
+	// Input Processing
+	integrand := checkIntegrand(e, "IntegrateWrt")
+
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return integrateMonomials(integrand, func(m Monomial) Monomial {
+		exponent, rest := splitMonomial(m, v)
+		rest.Coefficient /= float64(exponent + 1)
+		rest.VariableFactors = append(rest.VariableFactors, v)
+		rest.Exponents = append(rest.Exponents, exponent+1)
+		return rest
+	})
+}
+
+// DefiniteIntegral Returns the integral of the polynomial-like expression e with respect
+// to v from lo to hi. The result only depends on the other variables of e (and is a
+// constant if e only depends on v).
+func DefiniteIntegral(e PolynomialLike, v Variable, lo, hi float64) Expression {
+	// Input Processing
+	integrand := checkIntegrand(e, "DefiniteIntegral")
+
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return integrateMonomials(integrand, func(m Monomial) Monomial {
+		exponent, rest := splitMonomial(m, v)
+		rest.Coefficient *= (powInt(hi, exponent+1) - powInt(lo, exponent+1)) / float64(exponent+1)
+		return rest
+	})
+}
+
+// powInt Returns x raised to the non-negative integer power n.
+func powInt(x float64, n int) float64 {
+	result := 1.0
+	for ii := 0; ii < n; ii++ {
+		result *= x
+	}
+	return result
+}
+
+// IntegrateOverBox Returns the integral of the polynomial-like expression e over the box
+// lo[ii] <= variables[ii] <= hi[ii], computed by integrating with respect to each of the
+// variables in turn. The result only depends on the variables of e that are not in variables.
+func IntegrateOverBox(e PolynomialLike, variables []Variable, lo, hi mat.VecDense) Expression {
+	// Input Processing
+	integral := checkIntegrand(e, "IntegrateOverBox")
+
+	for _, bound := range []mat.VecDense{lo, hi} {
+		if bound.Len() != len(variables) {
+			panic(
+				smErrors.VectorDimensionError{
+					Operation: "IntegrateOverBox",
+					Arg1:      VariableVector(variables),
+					Arg2:      VecDenseToKVector(bound),
+				},
+			)
+		}
+	}
+
+	// Algorithm
+	for ii, v := range variables {
+		integrand, err := ToPolynomialLike(integral)
+		if err != nil {
+			panic(err)
+		}
+		integral = DefiniteIntegral(integrand, v, lo.AtVec(ii), hi.AtVec(ii))
+	}
+
+	return integral
+}
+
+// IntegrateOverUnitSimplex Returns the integral of the polynomial-like expression e over
+// the unit simplex {x >= 0, x_1 + ... + x_n <= 1} in the given variables, using the closed
+// form of the integral of a monomial:
+//
+//	integral of x_1^a_1 ... x_n^a_n = a_1! ... a_n! / (a_1 + ... + a_n + n)!
+//
+// The result only depends on the variables of e that are not in variables.
+func IntegrateOverUnitSimplex(e PolynomialLike, variables []Variable) Expression {
+	// Input Processing
+	integrand := checkIntegrand(e, "IntegrateOverUnitSimplex")
+
+	if len(variables) == 0 {
+		panic(smErrors.EmptyVectorError{Expression: VariableVector(variables)})
+	}
+
+	if len(UniqueVars(variables)) != len(variables) {
+		panic(
+			smErrors.Errorf("the variables of the unit simplex must be distinct; received %v", VariableVector(variables)),
+		)
+	}
+
+	// Algorithm
+	return integrateMonomials(integrand, func(m Monomial) Monomial {
+		rest := m
+		exponents := make([]int, len(variables))
+		for ii, v := range variables {
+			exponents[ii], rest = splitMonomial(rest, v)
+		}
+		rest.Coefficient *= simplexMomentOf(exponents)
+		return rest
+	})
+}
+
+// simplexMomentOf Returns a_1! ... a_n! / (a_1 + ... + a_n + n)!, where a_ii are the given
+// exponents. The factors of the numerator and the denominator are interleaved so that
+// the intermediate values do not overflow.
+func simplexMomentOf(exponents []int) float64 {
+	moment := 1.0
+	denominatorFactor := 1
+	for _, exponent := range exponents {
+		for factor := 1; factor <= exponent; factor++ {
+			moment *= float64(factor) / float64(denominatorFactor)
+			denominatorFactor++
+		}
+	}
+
+	total := denominatorFactor - 1 + len(exponents)
+	for ; denominatorFactor <= total; denominatorFactor++ {
+		moment /= float64(denominatorFactor)
+	}
+	return moment
+}
@@ -0,0 +1,499 @@
+package lp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+write.go
+Description:
+
+	Defines the Write function, which writes an optimization problem
+	(i.e., an objective, its sense and a list of constraints) in the
+	CPLEX LP file format.
+*/
+
+const (
+	// maxLineLength is the length after which a line of the LP file is broken.
+	// (CPLEX rejects lines that are longer than 510 characters.)
+	maxLineLength = 255
+
+	// validNameCharacters contains all of the non-alphanumeric characters that
+	// are allowed in the name of a variable.
+	validNameCharacters = "!\"#$%&()/,.;?@_`'{}|~"
+)
+
+// Write Writes the optimization problem
+//
+//	sense      objective
+//	subject to constraints
+//
+// to w in the CPLEX LP file format. Vector and matrix constraints are decomposed
+// into scalar constraints (named c0, c1, ...) with ToScalarConstraints; constraints that
+// can not be decomposed (e.g., second-order cone constraints) produce an error.
+// Range constraints are written as a single ranged row (lo <= expression <= hi) per element;
+// elements whose bounds are both infinite are skipped (as they do not constrain the problem).
+// Quadratic terms of the objective are written in the [ ... ]/2 syntax and
+// quadratic terms of the constraints are written in the [ ... ] syntax, as required
+// by the LP format.
+func Write(w io.Writer, objective symbolic.ScalarExpression, sense symbolic.ObjSense, constraints []symbolic.Constraint) error {
+	// Input Processing
+	err := objective.Check()
+	if err != nil {
+		return err
+	}
+
+	err = sense.Check()
+	if err != nil {
+		return err
+	}
+
+	for _, constraint := range constraints {
+		err = constraint.Check()
+		if err != nil {
+			return err
+		}
+	}
+
+	objectiveAsP, err := toPolynomial(objective)
+	if err != nil {
+		return err
+	}
+
+	objectiveTokens, objectiveConstant, err := termTokens(objectiveAsP, true)
+	if err != nil {
+		return err
+	}
+
+	rows, err := symbolic.ToScalarRows(constraints)
+	if err != nil {
+		return err
+	}
+
+	var constraintsAsP []symbolic.Polynomial
+	var constraintsTokens [][]string
+	for _, row := range rows {
+		var rowExpression symbolic.Expression
+		switch concrete := row.(type) {
+		case symbolic.ScalarConstraint:
+			rowExpression = concrete.LeftHandSide.Minus(concrete.RightHandSide)
+		case symbolic.ScalarRangeConstraint:
+			rowExpression = concrete.Expression
+		}
+
+		rowAsP, err := toPolynomial(rowExpression.(symbolic.ScalarExpression))
+		if err != nil {
+			return err
+		}
+
+		if len(rowAsP.Variables()) == 0 {
+			return fmt.Errorf(
+				"lp: the constraint %v does not contain any variables and can not be written to an LP file",
+				row,
+			)
+		}
+		termsTokens, constant, err := termTokens(rowAsP, false)
+		if err != nil {
+			return err
+		}
+
+		constraintsAsP = append(constraintsAsP, rowAsP)
+		if tokens := rowTokens(row, termsTokens, constant); tokens != nil {
+			constraintsTokens = append(constraintsTokens, tokens)
+		}
+	}
+
+	variables := collectVariables(objectiveAsP, constraintsAsP)
+	err = checkVariableNames(variables)
+	if err != nil {
+		return err
+	}
+
+	// Algorithm
+	lw := &lineWriter{w: bufio.NewWriter(w)}
+
+	// Write the objective
+	lw.writeLine(sense.String())
+	lw.startLine(" obj:")
+	lw.writeTokens(objectiveTokens)
+	if objectiveConstant != 0 {
+		lw.writeTokens(signedTokens(objectiveConstant, "", len(objectiveTokens) == 0))
+	}
+	lw.endLine()
+
+	// Write the constraints
+	lw.writeLine("Subject To")
+	for ii, tokens := range constraintsTokens {
+		lw.startLine(fmt.Sprintf(" c%v:", ii))
+		lw.writeTokens(tokens)
+		lw.endLine()
+	}
+
+	// Write the bounds
+	var binaries, generals []symbolic.Variable
+	lw.writeLine("Bounds")
+	for _, v := range variables {
+		switch v.Type {
+		case symbolic.Binary:
+			binaries = append(binaries, v)
+			continue
+		case symbolic.Integer:
+			generals = append(generals, v)
+		}
+
+		lw.writeLine(" " + boundString(v))
+	}
+
+	// Write the integrality sections
+	if len(binaries) > 0 {
+		lw.writeLine("Binaries")
+		for _, v := range binaries {
+			lw.writeLine(" " + v.Name)
+		}
+	}
+
+	if len(generals) > 0 {
+		lw.writeLine("Generals")
+		for _, v := range generals {
+			lw.writeLine(" " + v.Name)
+		}
+	}
+
+	lw.writeLine("End")
+
+	return lw.flush()
+}
+
+// rowTokens Returns the tokens of the row (after its name), given the tokens of the terms
+// of its expression and the constant of its expression (which is moved to the bounds).
+// An infinite bound of a range constraint is omitted, turning the row into an inequality;
+// nil is returned for a range constraint whose bounds are both infinite (which constrains nothing).
+func rowTokens(row symbolic.Constraint, termsTokens []string, constant float64) []string {
+	switch concrete := row.(type) {
+	case symbolic.ScalarRangeConstraint:
+		lowerIsInfinite := concrete.Lower() <= -symbolic.Infinity
+		upperIsInfinite := concrete.Upper() >= symbolic.Infinity
+		lower := formatFloat(float64(concrete.Lower()) - constant)
+		upper := formatFloat(float64(concrete.Upper()) - constant)
+
+		switch {
+		case lowerIsInfinite && !upperIsInfinite:
+			return append(termsTokens, "<=", upper)
+		case upperIsInfinite && !lowerIsInfinite:
+			return append(termsTokens, ">=", lower)
+		case lowerIsInfinite && upperIsInfinite:
+			return nil
+		}
+
+		tokens := append([]string{lower, "<="}, termsTokens...)
+		return append(tokens, "<=", upper)
+	default:
+		return append(termsTokens, row.ConstrSense().String(), formatFloat(-constant))
+	}
+}
+
+// toPolynomial Converts the polynomial-like scalar expression e into a simplified Polynomial.
+func toPolynomial(e symbolic.ScalarExpression) (symbolic.Polynomial, error) {
+	switch concrete := e.(type) {
+	case symbolic.K:
+		return concrete.ToPolynomial(), nil
+	case symbolic.Variable:
+		return concrete.ToPolynomial(), nil
+	case symbolic.Monomial:
+		return concrete.ToPolynomial(), nil
+	case symbolic.Polynomial:
+		return concrete.Simplify(), nil
+	default:
+		return symbolic.Polynomial{}, smErrors.UnsupportedInputError{
+			FunctionName: "lp.Write",
+			Input:        e,
+		}
+	}
+}
+
+// collectVariables Returns all of the unique variables in the objective and the constraints,
+// sorted by their IDs.
+func collectVariables(objective symbolic.Polynomial, constraints []symbolic.Polynomial) []symbolic.Variable {
+	// Setup
+	varsMap := make(map[symbolic.Variable]bool)
+	var variables []symbolic.Variable
+
+	// Algorithm
+	for _, p := range append([]symbolic.Polynomial{objective}, constraints...) {
+		for _, v := range p.Variables() {
+			if !varsMap[v] {
+				varsMap[v] = true
+				variables = append(variables, v)
+			}
+		}
+	}
+
+	sort.SliceStable(variables, func(ii, jj int) bool {
+		return variables[ii].ID < variables[jj].ID
+	})
+
+	return variables
+}
+
+// checkVariableNames Returns an error if any of the variables has a name that can not be
+// used in an LP file or if two different variables share the same name.
+func checkVariableNames(variables []symbolic.Variable) error {
+	namesMap := make(map[string]symbolic.Variable)
+	for _, v := range variables {
+		if !isValidName(v.Name) {
+			return fmt.Errorf(
+				"lp: the variable with ID %v has name \"%v\", which is not a valid LP name",
+				v.ID,
+				v.Name,
+			)
+		}
+
+		if other, found := namesMap[v.Name]; found {
+			return fmt.Errorf(
+				"lp: the variables with IDs %v and %v share the name \"%v\"",
+				other.ID,
+				v.ID,
+				v.Name,
+			)
+		}
+		namesMap[v.Name] = v
+	}
+
+	return nil
+}
+
+// isValidName Returns true if name can be used as the name of a variable in an LP file.
+// Names must be non-empty, contain at most 255 characters, must not begin with a digit
+// or a period and may only contain letters, digits and the characters in validNameCharacters.
+func isValidName(name string) bool {
+	if len(name) == 0 || len(name) > 255 {
+		return false
+	}
+
+	if (name[0] >= '0' && name[0] <= '9') || name[0] == '.' {
+		return false
+	}
+
+	for _, r := range name {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+		if !isLetter && !isDigit && !strings.ContainsRune(validNameCharacters, r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// termTokens Returns the tokens that describe the linear and quadratic terms of p
+// (sorted by the IDs of their variables) along with the constant term of p.
+// When isObjective is true, the quadratic terms are doubled and written in the [ ... ]/2 syntax.
+func termTokens(p symbolic.Polynomial, isObjective bool) ([]string, float64, error) {
+	// Setup
+	var linearMonomials, quadraticMonomials []symbolic.Monomial
+	constant := 0.0
+
+	// Sort the monomials by degree
+	for _, monomial := range p.Monomials {
+		if monomial.Coefficient == 0 {
+			continue
+		}
+
+		switch monomial.Degree() {
+		case 0:
+			constant += monomial.Coefficient
+		case 1:
+			linearMonomials = append(linearMonomials, monomial)
+		case 2:
+			quadraticMonomials = append(quadraticMonomials, monomial)
+		default:
+			return nil, 0, smErrors.QuadraticExpressionRequiredError{
+				Operation:  "lp.Write",
+				Expression: p,
+			}
+		}
+	}
+
+	sort.SliceStable(linearMonomials, func(ii, jj int) bool {
+		return lessFactors(factorsOf(linearMonomials[ii]), factorsOf(linearMonomials[jj]))
+	})
+	sort.SliceStable(quadraticMonomials, func(ii, jj int) bool {
+		return lessFactors(factorsOf(quadraticMonomials[ii]), factorsOf(quadraticMonomials[jj]))
+	})
+
+	// Create the tokens
+	var tokens []string
+	for _, monomial := range linearMonomials {
+		tokens = append(
+			tokens,
+			signedTokens(monomial.Coefficient, monomial.VariableFactors[0].Name, len(tokens) == 0)...,
+		)
+	}
+
+	if len(quadraticMonomials) > 0 {
+		if len(tokens) > 0 {
+			tokens = append(tokens, "+")
+		}
+		tokens = append(tokens, "[")
+		for ii, monomial := range quadraticMonomials {
+			coefficient := monomial.Coefficient
+			if isObjective {
+				coefficient *= 2
+			}
+
+			factors := factorsOf(monomial)
+			product := factors[0].Name + " * " + factors[1].Name
+			if factors[0] == factors[1] {
+				product = factors[0].Name + " ^ 2"
+			}
+			tokens = append(tokens, signedTokens(coefficient, product, ii == 0)...)
+		}
+		if isObjective {
+			tokens = append(tokens, "]/2")
+		} else {
+			tokens = append(tokens, "]")
+		}
+	}
+
+	return tokens, constant, nil
+}
+
+// factorsOf Returns the variables of the monomial m, repeated according to their exponents
+// and sorted by their IDs (e.g., x^2 y becomes [x, x, y]).
+func factorsOf(m symbolic.Monomial) []symbolic.Variable {
+	var factors []symbolic.Variable
+	for ii, v := range m.VariableFactors {
+		for jj := 0; jj < m.Exponents[ii]; jj++ {
+			factors = append(factors, v)
+		}
+	}
+
+	sort.SliceStable(factors, func(ii, jj int) bool {
+		return factors[ii].ID < factors[jj].ID
+	})
+
+	return factors
+}
+
+// lessFactors Returns true if the factors in left come before the factors in right
+// when they are compared ID by ID.
+func lessFactors(left, right []symbolic.Variable) bool {
+	for ii := 0; ii < len(left) && ii < len(right); ii++ {
+		if left[ii].ID != right[ii].ID {
+			return left[ii].ID < right[ii].ID
+		}
+	}
+	return len(left) < len(right)
+}
+
+// signedTokens Returns the tokens for the term coefficient * name.
+// The sign is omitted for non-negative coefficients when isFirst is true.
+func signedTokens(coefficient float64, name string, isFirst bool) []string {
+	var tokens []string
+	switch {
+	case coefficient < 0:
+		tokens = append(tokens, "-")
+	case !isFirst:
+		tokens = append(tokens, "+")
+	}
+
+	tokens = append(tokens, formatFloat(math.Abs(coefficient)))
+	if name != "" {
+		tokens = append(tokens, name)
+	}
+
+	return tokens
+}
+
+// boundString Returns the line of the Bounds section describing the bounds of v.
+func boundString(v symbolic.Variable) string {
+	lowerIsInfinite := v.Lower <= -float64(symbolic.Infinity)
+	upperIsInfinite := v.Upper >= float64(symbolic.Infinity)
+
+	if lowerIsInfinite && upperIsInfinite {
+		return v.Name + " free"
+	}
+
+	lower, upper := "-inf", "+inf"
+	if !lowerIsInfinite {
+		lower = formatFloat(v.Lower)
+	}
+	if !upperIsInfinite {
+		upper = formatFloat(v.Upper)
+	}
+
+	return fmt.Sprintf("%v <= %v <= %v", lower, v.Name, upper)
+}
+
+// formatFloat Returns the shortest representation of f that can be parsed back into f.
+// Negative zero (e.g., the negated constant of a constraint without one) is written as 0.
+func formatFloat(f float64) string {
+	if f == 0 {
+		f = 0
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// lineWriter Writes space separated tokens to an underlying writer, breaking lines
+// that would become longer than maxLineLength. The first error encountered is
+// stored and returned by flush.
+type lineWriter struct {
+	w          *bufio.Writer
+	lineLength int
+	err        error
+}
+
+// writeString Writes s to the underlying writer unless an error has already occurred.
+func (lw *lineWriter) writeString(s string) {
+	if lw.err != nil {
+		return
+	}
+	_, lw.err = lw.w.WriteString(s)
+}
+
+// writeLine Writes s as a complete line.
+func (lw *lineWriter) writeLine(s string) {
+	lw.writeString(s + "\n")
+	lw.lineLength = 0
+}
+
+// startLine Starts a new line with the prefix s.
+func (lw *lineWriter) startLine(s string) {
+	lw.writeString(s)
+	lw.lineLength = len(s)
+}
+
+// writeTokens Writes each token (preceded by a space) to the current line, continuing on
+// a new line whenever the current one would become too long.
+func (lw *lineWriter) writeTokens(tokens []string) {
+	for _, token := range tokens {
+		if lw.lineLength+len(token)+1 > maxLineLength {
+			lw.writeString("\n")
+			lw.lineLength = 0
+		}
+		lw.writeString(" " + token)
+		lw.lineLength += len(token) + 1
+	}
+}
+
+// endLine Ends the current line.
+func (lw *lineWriter) endLine() {
+	lw.writeLine("")
+}
+
+// flush Flushes the underlying writer and returns the first error encountered.
+func (lw *lineWriter) flush() error {
+	if lw.err != nil {
+		return lw.err
+	}
+	return lw.w.Flush()
+}
@@ -0,0 +1,524 @@
+package mps
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+read.go
+Description:
+
+	Defines the Model object and the Read function, which parses a linear
+	(or mixed integer linear) optimization problem from a free MPS file.
+*/
+
+// Model contains the optimization problem that was read from an MPS file.
+// The problem is
+//
+//	Sense      Objective
+//	subject to Constraints
+//
+// where the bounds and types of each of the Variables are stored in the variables themselves
+// (the fixed variables of the file have equal lower and upper bounds).
+type Model struct {
+	Name        string
+	Variables   []symbolic.Variable
+	Objective   symbolic.ScalarExpression
+	Sense       symbolic.ObjSense
+	Constraints []symbolic.Constraint
+}
+
+// mpsRow describes a row of the ROWS section.
+type mpsRow struct {
+	Name     string
+	Type     string
+	RHS      float64
+	Range    float64
+	HasRange bool
+}
+
+// mpsColumn describes a column of the COLUMNS section along with its bounds.
+type mpsColumn struct {
+	Name       string
+	Type       symbolic.VarType
+	Lower      float64
+	Upper      float64
+	LowerIsSet bool
+	Coeffs     map[string]float64
+	RowOrder   []string
+}
+
+// mpsReader holds the state of the parser while the file is being read.
+type mpsReader struct {
+	name          string
+	sense         symbolic.ObjSense
+	objectiveName string
+	freeRows      map[string]bool
+	rows          []*mpsRow
+	rowsByName    map[string]*mpsRow
+	columns       []*mpsColumn
+	columnsByName map[string]*mpsColumn
+	inIntegerSet  bool
+	objectiveRHS  float64
+}
+
+// Read Parses the free MPS file in r and returns the optimization problem that it describes.
+// All of the variables (i.e., columns) are created in the environment env, with the bounds,
+// types and names given in the file. Ranged rows become ScalarRangeConstraints, the other
+// rows become ScalarConstraints and fixed (FX) variables are given the fixed value as both their lower and upper bound.
+func Read(r io.Reader, env symbolic.Environment) (Model, error) {
+	// Setup
+	reader := &mpsReader{
+		sense:         symbolic.SenseMinimize,
+		freeRows:      make(map[string]bool),
+		rowsByName:    make(map[string]*mpsRow),
+		columnsByName: make(map[string]*mpsColumn),
+	}
+
+	// Parse each line of the file
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	section := ""
+	lineNumber := 0
+	foundEnd := false
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "*") {
+			continue
+		}
+
+		// Section headers begin in the first column
+		if line[0] != ' ' && line[0] != '\t' {
+			section = strings.ToUpper(fields[0])
+			var err error
+			switch section {
+			case "NAME":
+				if len(fields) > 1 {
+					reader.name = fields[1]
+				}
+			case "OBJSENSE":
+				if len(fields) > 1 {
+					err = reader.parseObjSense(fields[1])
+				}
+			case "ROWS", "COLUMNS", "RHS", "RANGES", "BOUNDS":
+			case "ENDATA":
+				foundEnd = true
+			default:
+				err = fmt.Errorf("unsupported section %v", fields[0])
+			}
+			if err != nil {
+				return Model{}, fmt.Errorf("mps: line %v: %v", lineNumber, err)
+			}
+
+			if foundEnd {
+				break
+			}
+			continue
+		}
+
+		// Parse the data lines of the current section
+		var err error
+		switch section {
+		case "OBJSENSE":
+			err = reader.parseObjSense(fields[0])
+		case "ROWS":
+			err = reader.parseRow(fields)
+		case "COLUMNS":
+			err = reader.parseColumn(fields)
+		case "RHS":
+			err = reader.parseRHS(fields)
+		case "RANGES":
+			err = reader.parseRange(fields)
+		case "BOUNDS":
+			err = reader.parseBound(fields)
+		default:
+			err = fmt.Errorf("data line found outside of a section")
+		}
+		if err != nil {
+			return Model{}, fmt.Errorf("mps: line %v: %v", lineNumber, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Model{}, err
+	}
+
+	if !foundEnd {
+		return Model{}, fmt.Errorf("mps: the file ended before the ENDATA section")
+	}
+
+	if reader.objectiveName == "" {
+		return Model{}, fmt.Errorf("mps: the file does not contain an objective (N) row")
+	}
+
+	return reader.model(env)
+}
+
+// parseObjSense Parses the value of the OBJSENSE section.
+func (reader *mpsReader) parseObjSense(value string) error {
+	switch strings.ToUpper(value) {
+	case "MIN", "MINIMIZE":
+		reader.sense = symbolic.SenseMinimize
+	case "MAX", "MAXIMIZE":
+		reader.sense = symbolic.SenseMaximize
+	default:
+		return fmt.Errorf("unexpected objective sense %v", value)
+	}
+	return nil
+}
+
+// parseRow Parses a line of the ROWS section (e.g., "L c0").
+func (reader *mpsReader) parseRow(fields []string) error {
+	if len(fields) != 2 {
+		return fmt.Errorf("expected 2 fields in the ROWS section; received %v", len(fields))
+	}
+
+	rowType, name := strings.ToUpper(fields[0]), fields[1]
+	if _, found := reader.rowsByName[name]; found || reader.freeRows[name] {
+		return fmt.Errorf("the row %v is defined more than once", name)
+	}
+
+	switch rowType {
+	case "N":
+		// Only the first free row is used as the objective; the others are ignored.
+		if reader.objectiveName == "" {
+			reader.objectiveName = name
+		}
+		reader.freeRows[name] = true
+		return nil
+	case "L", "G", "E":
+	default:
+		return fmt.Errorf("unexpected row type %v", fields[0])
+	}
+
+	row := &mpsRow{Name: name, Type: rowType}
+	reader.rows = append(reader.rows, row)
+	reader.rowsByName[name] = row
+	return nil
+}
+
+// parseColumn Parses a line of the COLUMNS section
+// (e.g., "x c0 1.0 c1 2.0" or "MARKER 'MARKER' 'INTORG'").
+func (reader *mpsReader) parseColumn(fields []string) error {
+	// Handle the integer markers
+	if len(fields) == 3 && strings.Trim(fields[1], "'") == "MARKER" {
+		switch strings.Trim(fields[2], "'") {
+		case "INTORG":
+			reader.inIntegerSet = true
+		case "INTEND":
+			reader.inIntegerSet = false
+		default:
+			return fmt.Errorf("unexpected marker %v", fields[2])
+		}
+		return nil
+	}
+
+	if len(fields) != 3 && len(fields) != 5 {
+		return fmt.Errorf("expected 3 or 5 fields in the COLUMNS section; received %v", len(fields))
+	}
+
+	// Find (or create) the column
+	column, found := reader.columnsByName[fields[0]]
+	if !found {
+		column = &mpsColumn{
+			Name:   fields[0],
+			Type:   symbolic.Continuous,
+			Lower:  0.0,
+			Upper:  float64(symbolic.Infinity),
+			Coeffs: make(map[string]float64),
+		}
+		if reader.inIntegerSet {
+			column.Type = symbolic.Integer
+		}
+		reader.columns = append(reader.columns, column)
+		reader.columnsByName[column.Name] = column
+	}
+
+	// Add each coefficient
+	for ii := 1; ii < len(fields); ii += 2 {
+		rowName := fields[ii]
+		value, err := parseFloat(fields[ii+1])
+		if err != nil {
+			return err
+		}
+
+		if _, isRow := reader.rowsByName[rowName]; !isRow && !reader.freeRows[rowName] {
+			return fmt.Errorf("unknown row %v in the COLUMNS section", rowName)
+		}
+
+		if reader.freeRows[rowName] && rowName != reader.objectiveName {
+			// Coefficients of additional free rows are ignored
+			continue
+		}
+
+		if _, found := column.Coeffs[rowName]; !found {
+			column.RowOrder = append(column.RowOrder, rowName)
+		}
+		column.Coeffs[rowName] += value
+	}
+
+	return nil
+}
+
+// parseRHS Parses a line of the RHS section (e.g., "RHS c0 4.0" or "c0 4.0").
+func (reader *mpsReader) parseRHS(fields []string) error {
+	return reader.parseRowValues(fields, "RHS", func(row *mpsRow, value float64) {
+		row.RHS = value
+	})
+}
+
+// parseRange Parses a line of the RANGES section (e.g., "RNG c0 2.0" or "c0 2.0").
+func (reader *mpsReader) parseRange(fields []string) error {
+	return reader.parseRowValues(fields, "RANGES", func(row *mpsRow, value float64) {
+		row.Range = value
+		row.HasRange = true
+	})
+}
+
+// parseRowValues Parses the (row, value) pairs of a line in the RHS or RANGES sections.
+// The name of the set at the beginning of the line is optional.
+func (reader *mpsReader) parseRowValues(fields []string, section string, setValue func(*mpsRow, float64)) error {
+	if len(fields)%2 == 1 {
+		fields = fields[1:]
+	}
+
+	if len(fields) != 2 && len(fields) != 4 {
+		return fmt.Errorf("unexpected number of fields in the %v section", section)
+	}
+
+	for ii := 0; ii < len(fields); ii += 2 {
+		value, err := parseFloat(fields[ii+1])
+		if err != nil {
+			return err
+		}
+
+		if reader.freeRows[fields[ii]] {
+			if section == "RANGES" {
+				return fmt.Errorf("the free row %v can not have a range", fields[ii])
+			}
+			if fields[ii] == reader.objectiveName {
+				reader.objectiveRHS = value
+			}
+			continue
+		}
+
+		row, found := reader.rowsByName[fields[ii]]
+		if !found {
+			return fmt.Errorf("unknown row %v in the %v section", fields[ii], section)
+		}
+		setValue(row, value)
+	}
+
+	return nil
+}
+
+// parseBound Parses a line of the BOUNDS section (e.g., "UP BND x 4.0" or "FR BND x").
+// The name of the bound set is optional.
+func (reader *mpsReader) parseBound(fields []string) error {
+	boundType := strings.ToUpper(fields[0])
+
+	needsValue := true
+	switch boundType {
+	case "FR", "MI", "PL", "BV":
+		needsValue = false
+	case "UP", "LO", "FX", "LI", "UI":
+	default:
+		return fmt.Errorf("unsupported bound type %v", fields[0])
+	}
+
+	// Remove the (optional) bound set name
+	expectedLength := 2
+	if needsValue {
+		expectedLength = 3
+	}
+	switch len(fields) {
+	case expectedLength:
+	case expectedLength + 1:
+		fields = append(fields[:1], fields[2:]...)
+	default:
+		return fmt.Errorf("unexpected number of fields for a bound of type %v", boundType)
+	}
+
+	column, found := reader.columnsByName[fields[1]]
+	if !found {
+		return fmt.Errorf("unknown column %v in the BOUNDS section", fields[1])
+	}
+
+	var value float64
+	if needsValue {
+		var err error
+		value, err = parseFloat(fields[2])
+		if err != nil {
+			return err
+		}
+	}
+
+	// Apply the bound
+	switch boundType {
+	case "UP", "UI":
+		column.Upper = value
+		if value < 0 && !column.LowerIsSet && column.Lower == 0 {
+			// A negative upper bound without a lower bound makes the variable unbounded below.
+			column.Lower = -float64(symbolic.Infinity)
+		}
+	case "LO", "LI":
+		column.Lower = value
+		column.LowerIsSet = true
+	case "FX":
+		column.Lower, column.Upper = value, value
+		column.LowerIsSet = true
+	case "FR":
+		column.Lower, column.Upper = -float64(symbolic.Infinity), float64(symbolic.Infinity)
+	case "MI":
+		column.Lower = -float64(symbolic.Infinity)
+		column.LowerIsSet = true
+	case "PL":
+		column.Upper = float64(symbolic.Infinity)
+	case "BV":
+		column.Type = symbolic.Binary
+		column.Lower, column.Upper = 0.0, 1.0
+	}
+
+	if boundType == "LI" || boundType == "UI" {
+		column.Type = symbolic.Integer
+	}
+
+	return nil
+}
+
+// model Creates the variables of the parsed file in env and assembles the Model.
+func (reader *mpsReader) model(env symbolic.Environment) (Model, error) {
+	// Setup
+	model := Model{Name: reader.name, Sense: reader.sense}
+	variablesByName := make(map[string]symbolic.Variable)
+
+	// Check that the names of the columns are not used by the environment
+	for _, column := range reader.columns {
+		if _, found := env.VariableByName(column.Name); found {
+			return Model{}, fmt.Errorf(
+				"mps: the environment %v already has a variable named %v",
+				env.GetName(),
+				column.Name,
+			)
+		}
+	}
+
+	// Create the variables
+	for _, column := range reader.columns {
+		lower, upper := column.Lower, column.Upper
+		if lower > upper {
+			return Model{}, fmt.Errorf(
+				"mps: the column %v has a lower bound (%v) above its upper bound (%v)",
+				column.Name,
+				lower,
+				upper,
+			)
+		}
+
+		v := symbolic.NewCustomVariable(column.Type, lower, upper, column.Name, env)
+		variablesByName[column.Name] = v
+		model.Variables = append(model.Variables, v)
+	}
+
+	// Collect the monomials of each row (in the order of the columns)
+	rowMonomials := make(map[string][]symbolic.Monomial)
+	for _, column := range reader.columns {
+		for _, rowName := range column.RowOrder {
+			if column.Coeffs[rowName] == 0 {
+				continue
+			}
+			rowMonomials[rowName] = append(rowMonomials[rowName], symbolic.Monomial{
+				Coefficient:     column.Coeffs[rowName],
+				VariableFactors: []symbolic.Variable{variablesByName[column.Name]},
+				Exponents:       []int{1},
+			})
+		}
+	}
+
+	// Create the objective
+	objectiveMonomials := rowMonomials[reader.objectiveName]
+	if reader.objectiveRHS != 0 {
+		objectiveMonomials = append(objectiveMonomials, symbolic.K(-reader.objectiveRHS).ToMonomial())
+	}
+	model.Objective = rowExpression(objectiveMonomials)
+
+	// Create the constraints
+	for _, row := range reader.rows {
+		lhs := rowExpression(rowMonomials[row.Name])
+
+		if !row.HasRange {
+			sense := map[string]symbolic.ConstrSense{
+				"L": symbolic.SenseLessThanEqual,
+				"G": symbolic.SenseGreaterThanEqual,
+				"E": symbolic.SenseEqual,
+			}[row.Type]
+			model.Constraints = append(model.Constraints, symbolic.ScalarConstraint{
+				LeftHandSide:  lhs,
+				RightHandSide: symbolic.K(row.RHS),
+				Sense:         sense,
+			})
+			continue
+		}
+
+		// Ranged rows become range constraints
+		var lower, upper float64
+		switch {
+		case row.Type == "L":
+			lower, upper = row.RHS-math.Abs(row.Range), row.RHS
+		case row.Type == "G":
+			lower, upper = row.RHS, row.RHS+math.Abs(row.Range)
+		case row.Range >= 0:
+			lower, upper = row.RHS, row.RHS+row.Range
+		default:
+			lower, upper = row.RHS+row.Range, row.RHS
+		}
+
+		model.Constraints = append(model.Constraints, symbolic.ScalarRangeConstraint{
+			Expression: lhs,
+			LowerBound: symbolic.K(lower),
+			UpperBound: symbolic.K(upper),
+		})
+	}
+
+	return model, nil
+}
+
+// rowExpression Returns the simplest scalar expression that represents the sum of the monomials.
+func rowExpression(monomials []symbolic.Monomial) symbolic.ScalarExpression {
+	switch len(monomials) {
+	case 0:
+		return symbolic.K(0.0)
+	case 1:
+		return monomials[0]
+	default:
+		return symbolic.Polynomial{Monomials: monomials}
+	}
+}
+
+// parseFloat Parses a numerical field of the MPS file. Values beyond 1e30 are
+// treated as infinite (as is common in MPS files).
+func parseFloat(field string) (float64, error) {
+	value, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse the number %v", field)
+	}
+
+	switch {
+	case value >= 1e30:
+		return float64(symbolic.Infinity), nil
+	case value <= -1e30:
+		return -float64(symbolic.Infinity), nil
+	default:
+		return value, nil
+	}
+}
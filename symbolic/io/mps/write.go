@@ -0,0 +1,352 @@
+package mps
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+write.go
+Description:
+
+	Defines the Write function, which writes a linear (or mixed integer linear)
+	optimization problem in the free MPS file format.
+*/
+
+const (
+	// objectiveRowName is the name of the objective row in the files written by Write.
+	objectiveRowName = "obj"
+
+	// boundSetName is the name of the bound set in the files written by Write.
+	boundSetName = "BND"
+
+	// rhsSetName is the name of the right hand side set in the files written by Write.
+	rhsSetName = "RHS"
+
+	// rangeSetName is the name of the range set in the files written by Write.
+	rangeSetName = "RNG"
+)
+
+// Write Writes the linear optimization problem
+//
+//	sense      objective
+//	subject to constraints
+//
+// to w in the free MPS file format. The rows of the constraints are named c0, c1, ...
+// and are built with each constraint's LinearInequalityConstraintRepresentation
+// (or LinearEqualityConstraintRepresentation for equality constraints); vector and matrix
+// constraints are decomposed into scalar constraints with ToScalarConstraints.
+// Range constraints are written as a single row (per element) with its LinearRangeRepresentation:
+// a G row with the lower bound as right hand side and, when both bounds are finite, the
+// difference of the bounds in the RANGES section.
+// Constants in the objective are written as the negated right hand side of the objective row.
+// Every constraint is written as a row; a (non-binary) variable whose lower and upper
+// bounds are equal is written with the fixed bound "FX value".
+func Write(w io.Writer, objective symbolic.ScalarExpression, sense symbolic.ObjSense, constraints []symbolic.Constraint) error {
+	// Input Processing
+	err := objective.Check()
+	if err != nil {
+		return err
+	}
+
+	err = sense.Check()
+	if err != nil {
+		return err
+	}
+
+	if !symbolic.IsLinear(objective) {
+		return smErrors.LinearExpressionRequiredError{
+			Operation:  "mps.Write",
+			Expression: objective,
+		}
+	}
+
+	for _, constraint := range constraints {
+		err = constraint.Check()
+		if err != nil {
+			return err
+		}
+	}
+
+	rows, err := symbolic.ToScalarRows(constraints)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		// (The bounds of a range constraint are constants, so only its expression is checked.)
+		sides := []symbolic.Expression{row.Left()}
+		if sc, isScalarConstraint := row.(symbolic.ScalarConstraint); isScalarConstraint {
+			sides = append(sides, sc.RightHandSide)
+		}
+
+		for _, side := range sides {
+			if !symbolic.IsLinear(side) {
+				return smErrors.LinearExpressionRequiredError{
+					Operation:  "mps.Write",
+					Expression: side,
+				}
+			}
+		}
+	}
+
+	variables := collectVariables(objective, rows)
+	if len(variables) == 0 {
+		return fmt.Errorf("mps: the problem does not contain any variables and can not be written to an MPS file")
+	}
+
+	err = checkVariableNames(variables)
+	if err != nil {
+		return err
+	}
+
+	// Compute the coefficients of each row
+	objectiveCoeffs := objective.LinearCoeff(variables)
+
+	var (
+		rowSenses []string
+		rowCoeffs []mat.VecDense
+		rowRHS    []float64
+		rowRanges = make(map[int]float64)
+	)
+	for ii, row := range rows {
+		var (
+			coeffs mat.VecDense
+			rhs    float64
+		)
+		switch constraint := row.(type) {
+		case symbolic.ScalarConstraint:
+			switch constraint.Sense {
+			case symbolic.SenseEqual:
+				coeffs, rhs = constraint.LinearEqualityConstraintRepresentation(variables)
+				rowSenses = append(rowSenses, "E")
+			case symbolic.SenseLessThanEqual:
+				coeffs, rhs = constraint.LinearInequalityConstraintRepresentation(variables)
+				rowSenses = append(rowSenses, "L")
+			case symbolic.SenseGreaterThanEqual:
+				// The representation is flipped into a <= constraint, so flip it back
+				coeffs, rhs = constraint.LinearInequalityConstraintRepresentation(variables)
+				coeffs.ScaleVec(-1, &coeffs)
+				rhs = -rhs
+				rowSenses = append(rowSenses, "G")
+			}
+		case symbolic.ScalarRangeConstraint:
+			var lo, hi float64
+			coeffs, lo, hi = constraint.LinearRangeRepresentation(variables)
+			lowerIsInfinite := lo <= -float64(symbolic.Infinity)
+			upperIsInfinite := hi >= float64(symbolic.Infinity)
+			switch {
+			case lo == hi:
+				rhs = lo
+				rowSenses = append(rowSenses, "E")
+			case lowerIsInfinite && !upperIsInfinite:
+				rhs = hi
+				rowSenses = append(rowSenses, "L")
+			default:
+				rhs = lo
+				rowSenses = append(rowSenses, "G")
+				if !lowerIsInfinite && !upperIsInfinite {
+					rowRanges[ii] = hi - lo
+				}
+			}
+		}
+
+		rowCoeffs = append(rowCoeffs, coeffs)
+		rowRHS = append(rowRHS, rhs)
+	}
+
+	// Algorithm
+	bw := bufio.NewWriter(w)
+	lines := []string{"NAME", "OBJSENSE"}
+	switch sense {
+	case symbolic.SenseMinimize:
+		lines = append(lines, "    MIN")
+	case symbolic.SenseMaximize:
+		lines = append(lines, "    MAX")
+	}
+
+	// Write the rows
+	lines = append(lines, "ROWS", " N  "+objectiveRowName)
+	for ii, rowSense := range rowSenses {
+		lines = append(lines, fmt.Sprintf(" %v  c%v", rowSense, ii))
+	}
+
+	// Write the columns (surrounding the integer variables with markers)
+	lines = append(lines, "COLUMNS")
+	inIntegerSection := false
+	for jj, v := range variables {
+		isInteger := v.Type == symbolic.Integer
+		if isInteger != inIntegerSection {
+			marker := "'INTORG'"
+			if !isInteger {
+				marker = "'INTEND'"
+			}
+			lines = append(lines, "    MARKER  'MARKER'  "+marker)
+			inIntegerSection = isInteger
+		}
+
+		// Every column must appear at least once, so the objective coefficient
+		// is written when the variable does not appear in any row.
+		var entries []string
+		if objectiveCoeffs.AtVec(jj) != 0 {
+			entries = append(entries, objectiveRowName+"  "+formatFloat(objectiveCoeffs.AtVec(jj)))
+		}
+		for ii, coeffs := range rowCoeffs {
+			if coeffs.AtVec(jj) != 0 {
+				entries = append(entries, fmt.Sprintf("c%v  %v", ii, formatFloat(coeffs.AtVec(jj))))
+			}
+		}
+		if len(entries) == 0 {
+			entries = append(entries, objectiveRowName+"  0")
+		}
+
+		for _, entry := range entries {
+			lines = append(lines, "    "+v.Name+"  "+entry)
+		}
+	}
+	if inIntegerSection {
+		lines = append(lines, "    MARKER  'MARKER'  'INTEND'")
+	}
+
+	// Write the right hand sides
+	lines = append(lines, "RHS")
+	if objectiveConstant := objective.Constant(); objectiveConstant != 0 {
+		lines = append(lines, "    "+rhsSetName+"  "+objectiveRowName+"  "+formatFloat(-objectiveConstant))
+	}
+	for ii, rhs := range rowRHS {
+		if rhs != 0 {
+			lines = append(lines, fmt.Sprintf("    %v  c%v  %v", rhsSetName, ii, formatFloat(rhs)))
+		}
+	}
+
+	// Write the ranges
+	if len(rowRanges) > 0 {
+		lines = append(lines, "RANGES")
+		for ii := range rows {
+			if rangeII, hasRange := rowRanges[ii]; hasRange {
+				lines = append(lines, fmt.Sprintf("    %v  c%v  %v", rangeSetName, ii, formatFloat(rangeII)))
+			}
+		}
+	}
+
+	// Write the bounds
+	lines = append(lines, "BOUNDS")
+	for _, v := range variables {
+		lines = append(lines, boundLines(v)...)
+	}
+
+	lines = append(lines, "ENDATA")
+
+	for _, line := range lines {
+		_, err = bw.WriteString(line + "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// collectVariables Returns all of the unique variables in the objective and the rows,
+// sorted by their IDs.
+func collectVariables(objective symbolic.ScalarExpression, rows []symbolic.Constraint) []symbolic.Variable {
+	// Setup
+	varsMap := make(map[symbolic.Variable]bool)
+	var variables []symbolic.Variable
+
+	// Algorithm
+	allVariables := objective.Variables()
+	for _, row := range rows {
+		allVariables = append(allVariables, symbolic.VariablesInThisConstraint(row)...)
+	}
+
+	for _, v := range allVariables {
+		if !varsMap[v] {
+			varsMap[v] = true
+			variables = append(variables, v)
+		}
+	}
+
+	sort.SliceStable(variables, func(ii, jj int) bool {
+		return variables[ii].ID < variables[jj].ID
+	})
+
+	return variables
+}
+
+// checkVariableNames Returns an error if any of the variables has a name that can not be
+// used in a free MPS file (i.e., an empty name or one containing whitespace) or if two
+// different variables share the same name.
+func checkVariableNames(variables []symbolic.Variable) error {
+	namesMap := make(map[string]symbolic.Variable)
+	for _, v := range variables {
+		if len(v.Name) == 0 || strings.IndexFunc(v.Name, unicode.IsSpace) != -1 {
+			return fmt.Errorf(
+				"mps: the variable with ID %v has name \"%v\", which is not a valid MPS name",
+				v.ID,
+				v.Name,
+			)
+		}
+
+		if other, found := namesMap[v.Name]; found {
+			return fmt.Errorf(
+				"mps: the variables with IDs %v and %v share the name \"%v\"",
+				other.ID,
+				v.ID,
+				v.Name,
+			)
+		}
+		namesMap[v.Name] = v
+	}
+
+	return nil
+}
+
+// boundLines Returns the lines of the BOUNDS section that describe the bounds of v
+// (a single FX bound when the lower and upper bounds of v are equal).
+func boundLines(v symbolic.Variable) []string {
+	prefix := func(boundType string) string {
+		return " " + boundType + " " + boundSetName + "  " + v.Name
+	}
+
+	if v.Type == symbolic.Binary {
+		return []string{prefix("BV")}
+	}
+
+	if v.Lower == v.Upper {
+		return []string{prefix("FX") + "  " + formatFloat(v.Lower)}
+	}
+
+	lowerIsInfinite := v.Lower <= -float64(symbolic.Infinity)
+	upperIsInfinite := v.Upper >= float64(symbolic.Infinity)
+
+	var lines []string
+	switch {
+	case lowerIsInfinite && upperIsInfinite:
+		return []string{prefix("FR")}
+	case lowerIsInfinite:
+		lines = append(lines, prefix("MI"))
+	default:
+		lines = append(lines, prefix("LO")+"  "+formatFloat(v.Lower))
+	}
+
+	if !upperIsInfinite {
+		lines = append(lines, prefix("UP")+"  "+formatFloat(v.Upper))
+	}
+
+	return lines
+}
+
+// formatFloat Returns the shortest representation of f that can be parsed back into f.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
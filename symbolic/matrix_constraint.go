@@ -1,10 +1,6 @@
 package symbolic
 
-import (
-	"fmt"
-
-	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
-)
+import "github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 
 // MatrixConstraint is an object that defines mathematical constraints between two matrices.
 // This should implement the Constraint interface.
@@ -51,11 +47,11 @@ func (mc MatrixConstraint) Check() error {
 
 	// Check that the dimensions of the left and right hand sides are the same.
 	if len(leftDims) != len(rightDims) {
-		return fmt.Errorf("left and right hand sides have different dimensions")
+		return smErrors.Errorf("left and right hand sides have different dimensions")
 	}
 
 	if leftDims[0] != rightDims[0] {
-		return fmt.Errorf(
+		return smErrors.Errorf(
 			"there are a different number of rows in the left (%v) and right (%v) sides of the constraint!",
 			leftDims[0],
 			rightDims[0],
@@ -63,7 +59,7 @@ func (mc MatrixConstraint) Check() error {
 	}
 
 	if leftDims[1] != rightDims[1] {
-		return fmt.Errorf(
+		return smErrors.Errorf(
 			"there are a different number of columns in the left (%v) and right (%v) sides of the constraint!",
 			leftDims[1],
 			rightDims[1],
@@ -120,6 +116,19 @@ func (mc MatrixConstraint) IsLinear() bool {
 	return IsLinear(mc.RightHandSide) && IsLinear(mc.LeftHandSide)
 }
 
+// IsConvex Describes whether the set of points that satisfy the given matrix
+// constraint is convex (according to the curvature of its left hand side minus its right hand side).
+func (mc MatrixConstraint) IsConvex() bool {
+	// Input Processing
+	err := mc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return constraintIsConvex(mc)
+}
+
 // Substitute Substitutes the variable vIn with the scalar expression seIn
 func (mc MatrixConstraint) Substitute(vIn Variable, seIn ScalarExpression) Constraint {
 	// Check that the constraint is well formed.
@@ -207,13 +216,16 @@ func (mc MatrixConstraint) ImpliesThisIsAlsoSatisfied(other Constraint) bool {
 				}
 			}
 		}
-	case VectorConstraint, MatrixConstraint:
+	case ScalarRangeConstraint:
+		// Both halves of the range constraint must be implied.
+		return impliesAllOf(mc, otherC.AsScalarConstraints())
+	case VectorConstraint, MatrixConstraint, VectorRangeConstraint, SecondOrderConeConstraint:
 		// TODO: Implement more advanced implication checks.
 		return false
 	default:
 		// Other types of constraints are not currently supported.
 		panic(
-			fmt.Errorf("implication checking between MatrixConstraint and %T is not currently supported", other),
+			smErrors.Errorf("implication checking between MatrixConstraint and %T is not currently supported", other),
 		)
 	}
 
@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -78,6 +76,20 @@ type MatrixExpression interface {
 
 	// Simplify simplifies the expression and returns the simplified version
 	AsSimplifiedExpression() Expression
+
+	// Equals returns true if the current expression and other represent the same
+	// expression (the coefficients of matching terms may differ by at most tol)
+	Equals(other Expression, tol float64) bool
+
+	// Canonicalize returns the expression in canonical form (see canonical.go)
+	Canonicalize() Expression
+
+	// Evaluate returns the value of the expression when each variable takes the value given in values
+	Evaluate(values map[Variable]float64) mat.Dense
+
+	// EvaluateAt returns the value of the expression at the point x, where the (ii)th element of x
+	// is the value of the (ii)th variable in wrt
+	EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.Dense
 }
 
 // IsMatrixExpression Determines whether or not an input object is a valid "VectorExpression" according to SymbolicMath.go.
@@ -96,6 +108,8 @@ func IsMatrixExpression(e interface{}) bool {
 		return true
 	case PolynomialMatrix:
 		return true
+	case FunctionExpressionMatrix:
+		return true
 	default:
 		return false
 
@@ -106,7 +120,7 @@ func IsMatrixExpression(e interface{}) bool {
 func ToMatrixExpression(e interface{}) (MatrixExpression, error) {
 	// Input Processing
 	if !IsMatrixExpression(e) {
-		return DenseToKMatrix(ZerosMatrix(1, 1)), fmt.Errorf(
+		return DenseToKMatrix(ZerosMatrix(1, 1)), smErrors.Errorf(
 			"the input interface is of type %T, which is not recognized as a MatrixExpression.",
 			e,
 		)
@@ -126,8 +140,10 @@ func ToMatrixExpression(e interface{}) (MatrixExpression, error) {
 		return e2, nil
 	case PolynomialMatrix:
 		return e2, nil
+	case FunctionExpressionMatrix:
+		return e2, nil
 	default:
-		return DenseToKMatrix(ZerosMatrix(1, 1)), fmt.Errorf(
+		return DenseToKMatrix(ZerosMatrix(1, 1)), smErrors.Errorf(
 			"unexpected matrix expression conversion requested for object \"%v\" of type %T!",
 			e,
 			e,
@@ -151,7 +167,7 @@ func MatrixPowerTemplate(me MatrixExpression, exponent int) MatrixExpression {
 
 	// Check if the matrix is square
 	if !IsSquare(me) {
-		panic(fmt.Errorf("matrix is not square; cannot raise to power"))
+		panic(smErrors.Errorf("matrix is not square; cannot raise to power"))
 	}
 
 	// Check if the power is non-negative
@@ -203,14 +219,19 @@ func MatrixMultiplyTemplate(left MatrixExpression, right MatrixExpression) Expre
 		var tempRow []ScalarExpression
 		for jj := 0; jj < rightDims[1]; jj++ {
 			// Compute the (ii,jj) element of the product
-			var sum Expression = K(0.0)
+			var terms []ScalarExpression
 			for kk := 0; kk < leftDims[1]; kk++ {
-				sum = sum.Plus(left.At(ii, kk).Multiply(right.At(kk, jj)))
+				term, err := ToScalarExpression(left.At(ii, kk).Multiply(right.At(kk, jj)))
+				if err != nil {
+					panic(err)
+				}
+				terms = append(terms, term)
 			}
-			sumAsSE, tf := sum.AsSimplifiedExpression().(ScalarExpression)
+			sum := sumOfScalarExpressions(terms)
+			sumAsSE, tf := sum.(ScalarExpression)
 			if !tf {
 				panic(
-					fmt.Errorf(
+					smErrors.Errorf(
 						"unexpected expression type in MatrixMultiplyTemplate at entry [%v,%v]: %T",
 						ii, jj,
 						sum,
@@ -306,7 +327,7 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 	// - Check that the input slice is not empty
 	if len(sliceIn) == 0 {
 		panic(
-			fmt.Errorf(
+			smErrors.Errorf(
 				"the input slice is empty, which is not recognized as a VectorExpression.",
 			),
 		)
@@ -317,7 +338,7 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 	for ii, row := range sliceIn {
 		if len(row) != numCols {
 			panic(
-				fmt.Errorf(
+				smErrors.Errorf(
 					"all rows in the input slice must have the same number of columns, but row %v has %v columns (expected %v).",
 					ii,
 					len(row),
@@ -335,6 +356,7 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 		containsVariable   bool = false
 		containsMonomial   bool = false
 		containsPolynomial bool = false
+		containsFunction   bool = false
 	)
 
 	for ii, row := range sliceIn {
@@ -353,9 +375,11 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 				containsMonomial = true
 			case Polynomial:
 				containsPolynomial = true
+			case RationalExpression, FunctionExpression:
+				containsFunction = true
 			default:
 				panic(
-					fmt.Errorf(
+					smErrors.Errorf(
 						"unexpected expression type in matrix expression at [%v,%v]: %T",
 						ii, jj,
 						elt,
@@ -367,6 +391,17 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 
 	// Convert
 	switch {
+	case containsFunction:
+		// Keep the elements as they are in a function expression matrix
+		var out FunctionExpressionMatrix
+		for _, row_ii := range sliceIn {
+			tempRow := make([]ScalarExpression, len(row_ii))
+			copy(tempRow, row_ii)
+			out = append(out, tempRow)
+		}
+
+		return out
+
 	case containsPolynomial:
 		// Convert to a polynomial vector
 		var out PolynomialMatrix
@@ -455,7 +490,7 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 				eltAsK, tf := elt.(K)
 				if !tf {
 					panic(
-						fmt.Errorf(
+						smErrors.Errorf(
 							"unexpected expression type in vector expression at entry [%v,%v]: %T",
 							ii, jj,
 							elt,
@@ -472,7 +507,7 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 
 	default:
 		panic(
-			fmt.Errorf(
+			smErrors.Errorf(
 				"unrecognized vector expression type in ConcretizeMatrixExpression.\n"+
 					"containsConstant = %v\n"+
 					"isAllVariables = %v\n"+
@@ -486,3 +521,27 @@ func ConcretizeMatrixExpression(sliceIn [][]ScalarExpression) MatrixExpression {
 		)
 	}
 }
+
+// MatrixEvaluateTemplate Defines the template for the matrix evaluation operation.
+// Each element of the matrix expression is evaluated using the values in the map.
+// If one of the variables of me is not in the map, then this panics with a MissingVariableValueError.
+func MatrixEvaluateTemplate(me MatrixExpression, values map[Variable]float64) mat.Dense {
+	// Input Processing
+	err := me.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	checkValuesForEvaluate(me, values)
+
+	// Algorithm
+	nRows, nCols := me.Dims()[0], me.Dims()[1]
+	out := ZerosMatrix(nRows, nCols)
+	for ii := 0; ii < nRows; ii++ {
+		for jj := 0; jj < nCols; jj++ {
+			out.Set(ii, jj, me.At(ii, jj).Evaluate(values))
+		}
+	}
+
+	return out
+}
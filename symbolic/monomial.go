@@ -2,6 +2,7 @@ package symbolic
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
@@ -27,7 +28,7 @@ type Monomial struct {
 func (m Monomial) Check() error {
 	// Check that the number of degrees matches the number of variables
 	if len(m.Exponents) != len(m.VariableFactors) {
-		return fmt.Errorf(
+		return smErrors.Errorf(
 			"the number of degrees (%v) does not match the number of variables (%v)",
 			len(m.Exponents),
 			len(m.VariableFactors),
@@ -71,6 +72,7 @@ func (m Monomial) Plus(e interface{}) Expression {
 	}
 
 	// Algorithm
+	// (The monomials that are returned are in canonical form; see isCanonical.)
 	var out Expression
 	switch right := e.(type) {
 	case float64:
@@ -80,31 +82,35 @@ func (m Monomial) Plus(e interface{}) Expression {
 			out = K(m.Coefficient + float64(right))
 		} else {
 			out = Polynomial{
-				Monomials: []Monomial{m.Copy(), right.ToMonomial()},
+				Monomials: []Monomial{m.asCanonical(), right.ToMonomial()},
 			}
 		}
 	case Variable:
 		if m.IsDegreeOneContainingVariable(right) {
-			mCopy := m.Copy()
+			mCopy := m.asCanonical()
 			mCopy.Coefficient += 1.0
 			out = mCopy
 		} else {
 			out = Polynomial{
-				Monomials: []Monomial{m.Copy(), right.ToMonomial()},
+				Monomials: []Monomial{m.asCanonical(), right.ToMonomial()},
 			}
 		}
 	case Monomial:
 		if m.MatchesFormOf(right) {
-			monomialOut := m.Copy()
+			monomialOut := m.asCanonical()
 			monomialOut.Coefficient += right.Coefficient
 			out = monomialOut
 		} else {
 			out = Polynomial{
-				Monomials: []Monomial{m.Copy(), right},
+				Monomials: []Monomial{m.asCanonical(), right.asCanonical()},
 			}
 		}
 	case Polynomial:
-		out = right.Plus(m.Copy())
+		out = right.Plus(m.asCanonical())
+	case RationalExpression:
+		out = right.Plus(m.asCanonical())
+	case FunctionExpression:
+		out = sumOf(m.asCanonical(), right)
 	default:
 		panic(
 			smErrors.UnsupportedInputError{
@@ -182,57 +188,69 @@ func (m Monomial) Multiply(e interface{}) Expression {
 		return m.Multiply(K(float64(right)))
 	case K:
 		rightAsFloat64 := float64(right)
-		monomialOut := m.Copy()
+		monomialOut := m.asCanonical()
 		monomialOut.Coefficient *= rightAsFloat64
 		return monomialOut
 	case Variable:
-		monomialOut := m.Copy()
-
-		if foundIndex, _ := FindInSlice(right, m.VariableFactors); foundIndex == -1 {
-			monomialOut.VariableFactors = append(monomialOut.VariableFactors, right)
-			monomialOut.Exponents = append(monomialOut.Exponents, 1)
-		} else {
-			monomialOut.Exponents[foundIndex] += 1
-		}
-		return monomialOut
+		return multiplyMonomials(m, right.ToMonomial())
 	case Monomial:
+		return multiplyMonomials(m, right)
+	case Polynomial:
+		return right.Multiply(m) // Commutative
+	case RationalExpression:
+		return right.Multiply(m.Copy())
+	case FunctionExpression:
+		return productOf(m.Copy(), right)
+	}
 
-		// Collect all variables in both monomials
-		variables := append(m.VariableFactors, right.VariableFactors...)
-		variables = UniqueVars(variables)
+	// Unrecornized response is a panic
+	panic(
+		smErrors.Errorf("Unexpected type of right in the Multiply() method: %T (%v)", e, e),
+	)
+}
 
-		multiDegree := make([]int, len(variables))
+// multiplyMonomials Returns the product of the (well-defined) monomials left and right
+// in canonical form (see isCanonical). The factors of both monomials are merged in a
+// single pass, as they are sorted by variable ID.
+func multiplyMonomials(left, right Monomial) Monomial {
+	if !left.isCanonical() {
+		left = left.asCanonical()
+	}
 
-		// Iterate through each variable in monomial one
-		for ii, variable := range m.VariableFactors {
-			// Find the index of the variable in the slice variables
-			foundIndex, _ := FindInSlice(variable, variables)
-			// And modify the multidegree
-			multiDegree[foundIndex] += m.Exponents[ii]
-		}
+	if !right.isCanonical() {
+		right = right.asCanonical()
+	}
 
-		// Iterate through each variable in monomial two
-		for ii, variable := range right.VariableFactors {
-			// Find the index of the variable in the slice variables
-			foundIndex, _ := FindInSlice(variable, variables)
-			// And modify the multidegree
-			multiDegree[foundIndex] += right.Exponents[ii]
-		}
+	nFactors := len(left.VariableFactors) + len(right.VariableFactors)
+	product := Monomial{
+		Coefficient:     left.Coefficient * right.Coefficient,
+		VariableFactors: make([]Variable, 0, nFactors),
+		Exponents:       make([]int, 0, nFactors),
+	}
 
-		// Create monomialOut
-		return Monomial{
-			Coefficient:     m.Coefficient * right.Coefficient,
-			Exponents:       multiDegree,
-			VariableFactors: variables,
+	ii, jj := 0, 0
+	for ii < len(left.VariableFactors) || jj < len(right.VariableFactors) {
+		switch {
+		case jj == len(right.VariableFactors) ||
+			(ii < len(left.VariableFactors) && left.VariableFactors[ii].ID < right.VariableFactors[jj].ID):
+			product.VariableFactors = append(product.VariableFactors, left.VariableFactors[ii])
+			product.Exponents = append(product.Exponents, left.Exponents[ii])
+			ii++
+		case ii == len(left.VariableFactors) || right.VariableFactors[jj].ID < left.VariableFactors[ii].ID:
+			product.VariableFactors = append(product.VariableFactors, right.VariableFactors[jj])
+			product.Exponents = append(product.Exponents, right.Exponents[jj])
+			jj++
+		default:
+			if exponent := left.Exponents[ii] + right.Exponents[jj]; exponent != 0 {
+				product.VariableFactors = append(product.VariableFactors, left.VariableFactors[ii])
+				product.Exponents = append(product.Exponents, exponent)
+			}
+			ii++
+			jj++
 		}
-	case Polynomial:
-		return right.Multiply(m) // Commutative
 	}
 
-	// Unrecornized response is a panic
-	panic(
-		fmt.Errorf("Unexpected type of right in the Multiply() method: %T (%v)", e, e),
-	)
+	return product
 }
 
 // Transpose Transposes the scalar monomial and returns it. (This is the same as simply copying the monomial.)
@@ -265,6 +283,11 @@ func (m Monomial) Eq(rightIn interface{}) Constraint {
 	return m.Comparison(rightIn, SenseEqual)
 }
 
+// Between returns the range constraint lower <= m <= upper.
+func (m Monomial) Between(lower, upper interface{}) Constraint {
+	return newScalarRangeConstraint(m, lower, upper)
+}
+
 // Comparison returns the "comparison constraint" between the input monomial and another expression.
 // The type of comparison (for example, GreaterThanOrEqual) is provided.
 // Usually, we recommend using the convenience methods instead (for example, GreaterEq(), Eq(), etc.) instead of this one.
@@ -305,6 +328,10 @@ func (m Monomial) Comparison(rhsIn interface{}, sense ConstrSense) Constraint {
 		return ScalarConstraint{m, right, sense}
 	case Polynomial:
 		return ScalarConstraint{m, right, sense}
+	case RationalExpression:
+		return ScalarConstraint{m, right, sense}
+	case FunctionExpression:
+		return ScalarConstraint{m, right, sense}
 	}
 
 	panic(
@@ -342,7 +369,7 @@ func (m Monomial) LinearCoeff(wrt ...[]Variable) mat.VecDense {
 		wrtVars = wrt[0]
 	default:
 		panic(
-			fmt.Errorf("Too many inputs provided to Monomial.LinearCoeff() method. Expected 0 or 1 input."),
+			smErrors.Errorf("Too many inputs provided to Monomial.LinearCoeff() method. Expected 0 or 1 input."),
 		)
 	}
 
@@ -366,6 +393,27 @@ func (m Monomial) LinearCoeff(wrt ...[]Variable) mat.VecDense {
 	return linearCoeffs
 }
 
+// QuadraticRepresentation Returns the matrix Q, the vector c and the constant r
+// such that the monomial m can be written as 0.5 * x^T Q x + c^T x + r,
+// where the (ii)th element of x is the (ii)th variable in wrt (or m.Variables() if wrt is not provided).
+func (m Monomial) QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64) {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if !IsQuadratic(m) {
+		panic(smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticRepresentation",
+			Expression: m,
+		})
+	}
+
+	// Algorithm
+	return m.ToPolynomial().QuadraticRepresentation(wrt...)
+}
+
 // IsConstant Returns true if the monomial defines a constant.
 func (m Monomial) IsConstant() bool {
 	// Input Checking
@@ -440,7 +488,8 @@ func (m Monomial) IsVariable(v Variable) bool {
 }
 
 // MatchesFormOf Returns true if the monomial matches the form of the input monomial.
-// (in other words if the input monomial has the same variables and degrees as the input monomial.)
+// (in other words if the input monomial has the same variables and degrees as the input monomial,
+// in any order.)
 func (m Monomial) MatchesFormOf(mIn Monomial) bool {
 	// Input Checking
 	err := m.Check()
@@ -454,26 +503,7 @@ func (m Monomial) MatchesFormOf(mIn Monomial) bool {
 	}
 
 	// Algorithm
-	if len(m.VariableFactors) != len(mIn.VariableFactors) {
-		return false
-	}
-
-	for ii, v := range m.VariableFactors {
-		foundIndex, _ := FindInSlice(v, mIn.VariableFactors)
-		if foundIndex == -1 {
-			// If v was not in mIn, then these two monomials are not the same
-			return false
-		} else {
-			// If v was in mIn, but not of the right degree, then these two are not the same
-			if m.Exponents[ii] != mIn.Exponents[ii] {
-				return false
-			}
-		}
-
-	}
-
-	// If all checks pass, then return true!
-	return true
+	return m.Key() == mIn.Key()
 }
 
 // DerivativeWrt This function returns the derivative of the monomial with respect to the input
@@ -517,7 +547,7 @@ func (m Monomial) DerivativeWrt(vIn Variable) Expression {
 				}
 			}
 		default:
-			monomialOut = m
+			monomialOut = m.Copy()
 			monomialOut.Coefficient = m.Coefficient * float64(m.Exponents[foundIndex])
 			monomialOut.Exponents[foundIndex] -= 1
 		}
@@ -567,14 +597,14 @@ func (m Monomial) ToVariable() Variable {
 
 	// Check that VariableFactors is not empty
 	if len(m.VariableFactors) == 0 {
-		panic(fmt.Errorf("Cannot convert monomial to variable: VariableFactors is empty"))
+		panic(smErrors.Errorf("Cannot convert monomial to variable: VariableFactors is empty"))
 	}
 	// Algorithm
 	if m.IsDegreeOneContainingVariable(m.VariableFactors[0]) {
 		return m.VariableFactors[0]
 	} else {
 		panic(
-			fmt.Errorf("Can not convert monomial to variable. The monomial is not a variable."),
+			smErrors.Errorf("Can not convert monomial to variable. The monomial is not a variable."),
 		)
 	}
 }
@@ -599,8 +629,11 @@ func (m Monomial) String() string {
 		}
 	}
 
-	// Add variables
+	// Add variables (separated by spaces, so that the string can be parsed back)
 	for ii, variable := range m.VariableFactors {
+		if ii > 0 {
+			monomialString += " "
+		}
 		monomialString += fmt.Sprintf("%v", variable)
 		if m.Exponents[ii] != 1 {
 			monomialString += fmt.Sprintf("^%v", m.Exponents[ii])
@@ -693,10 +726,20 @@ func (m Monomial) SubstituteAccordingTo(subMap map[Variable]Expression) Expressi
 }
 
 // Power Computes the power of the monomial.
+// Negative exponents produce a Laurent monomial, which is represented as a
+// RationalExpression (e.g., (2 x)^-1 = 0.5/(x)).
 func (m Monomial) Power(exponent int) Expression {
+	if exponent < 0 {
+		return scalarReciprocalPower(m, exponent)
+	}
 	return ScalarPowerTemplate(m, exponent)
 }
 
+// Divide Computes the ratio of the monomial and another scalar expression.
+func (m Monomial) Divide(rightIn interface{}) Expression {
+	return ScalarDivideTemplate(m, rightIn)
+}
+
 // At Returns the value at the given row and column index.
 // Because a Monomial is a scalar, there is only one element the (0,0)-th element.
 func (m Monomial) At(ii, jj int) ScalarExpression {
@@ -762,3 +805,47 @@ func (m Monomial) AsSimplifiedExpression() Expression {
 
 	return m
 }
+
+// Evaluate Returns the value of the monomial when each variable takes the value given in the values map.
+// If one of the monomial's variables is not in the map, then this panics with a MissingVariableValueError.
+func (m Monomial) Evaluate(values map[Variable]float64) float64 {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	checkValuesForEvaluate(m, values)
+
+	// Algorithm
+	out := m.Coefficient
+	for ii, variable := range m.VariableFactors {
+		out *= math.Pow(values[variable], float64(m.Exponents[ii]))
+	}
+
+	return out
+}
+
+// EvaluateAt Returns the value of the monomial at the point x.
+func (m Monomial) EvaluateAt(x mat.VecDense, wrt ...[]Variable) float64 {
+	return m.Evaluate(valueMapForEvaluateAt(m, x, wrt))
+}
+
+// Equals Returns true if the monomial and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (m Monomial) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(m, other, tol)
+}
+
+// Canonicalize Returns a copy of the monomial with its factors sorted by variable ID,
+// repeated factors merged and zero exponents removed.
+func (m Monomial) Canonicalize() Expression {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return canonicalMonomial(m)
+}
@@ -0,0 +1,122 @@
+package symbolic
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+/*
+monomial_key.go
+Description:
+	Defines the MonomialKey, a hashable signature of the variables and exponents
+	of a monomial. It is used to find matching terms of polynomials with a map
+	(instead of comparing every pair of monomials).
+
+	The operations of the package (e.g., Plus and Multiply) build monomials in
+	canonical form: their factors are sorted by variable ID, each variable appears
+	once and no exponent is zero. The key of a canonical monomial is a direct
+	encoding of its factors; other monomials (e.g., written as struct literals)
+	are sorted first.
+*/
+
+// MonomialKey is a hashable signature of the form of a monomial (i.e., of its variables
+// and their exponents; the coefficient is ignored). It lists the (variable ID, exponent)
+// pairs of the monomial sorted by ID, with repeated factors merged and zero exponents
+// removed, so two monomials have the same key if and only if they represent the same
+// product of variables (e.g., x_0 x_1^2 and x_1^2 x_0).
+type MonomialKey string
+
+// monomialFactor is a (variable, exponent) pair of a monomial.
+type monomialFactor struct {
+	Variable Variable
+	Exponent int
+}
+
+// Key Returns the MonomialKey of the monomial.
+func (m Monomial) Key() MonomialKey {
+	// Input Processing
+	err := m.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	if !m.isCanonical() {
+		m = m.asCanonical()
+	}
+	return encodeMonomialFactors(m.VariableFactors, m.Exponents)
+}
+
+// isCanonical Returns true if the factors of the (well-defined) monomial are sorted by
+// strictly increasing variable ID and none of its exponents is zero.
+func (m Monomial) isCanonical() bool {
+	for ii, exponent := range m.Exponents {
+		if exponent == 0 {
+			return false
+		}
+
+		if ii > 0 && m.VariableFactors[ii-1].ID >= m.VariableFactors[ii].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// asCanonical Returns a copy of the (well-defined) monomial in canonical form
+// (see isCanonical).
+func (m Monomial) asCanonical() Monomial {
+	if m.isCanonical() {
+		return m.Copy()
+	}
+
+	return canonicalMonomial(m)
+}
+
+// sortedFactors Returns the factors of the (well-defined) monomial sorted by variable ID,
+// with repeated factors merged and zero exponents removed.
+func (m Monomial) sortedFactors() []monomialFactor {
+	// Sort the factors by ID
+	factors := make([]monomialFactor, len(m.VariableFactors))
+	for ii, v := range m.VariableFactors {
+		factors[ii] = monomialFactor{v, m.Exponents[ii]}
+	}
+	if len(factors) > 1 {
+		sort.Slice(factors, func(i, j int) bool {
+			return factors[i].Variable.ID < factors[j].Variable.ID
+		})
+	}
+
+	// Merge repeated factors and remove zero exponents
+	merged := factors[:0]
+	for _, factor := range factors {
+		if len(merged) > 0 && merged[len(merged)-1].Variable.ID == factor.Variable.ID {
+			merged[len(merged)-1].Exponent += factor.Exponent
+			continue
+		}
+		merged = append(merged, factor)
+	}
+
+	nonZero := merged[:0]
+	for _, factor := range merged {
+		if factor.Exponent != 0 {
+			nonZero = append(nonZero, factor)
+		}
+	}
+
+	return nonZero
+}
+
+// encodeMonomialFactors Writes the factors of a canonical monomial (given by its
+// variables and exponents) as a compact string of varints.
+func encodeMonomialFactors(variables []Variable, exponents []int) MonomialKey {
+	if len(variables) == 0 {
+		return ""
+	}
+
+	buffer := make([]byte, 0, 4*len(variables))
+	for ii, v := range variables {
+		buffer = binary.AppendUvarint(buffer, v.ID)
+		buffer = binary.AppendVarint(buffer, int64(exponents[ii]))
+	}
+	return MonomialKey(buffer)
+}
@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -53,7 +51,7 @@ func (mm MonomialMatrix) Check() error {
 		for jj, monomial := range row {
 			err := monomial.Check()
 			if err != nil {
-				return fmt.Errorf("error in monomial %v,%v: %v", ii, jj, err)
+				return smErrors.Errorf("error in monomial %v,%v: %v", ii, jj, err)
 			}
 		}
 	}
@@ -105,7 +103,7 @@ func (mm MonomialMatrix) Plus(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Plus: %v", err))
+			panic(smErrors.Errorf("error in second argument to Plus: %v", err))
 		}
 
 		err := smErrors.CheckDimensionsInAddition(mm, eAsE)
@@ -217,7 +215,7 @@ func (mm MonomialMatrix) Minus(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Plus: %v", err))
+			panic(smErrors.Errorf("error in second argument to Plus: %v", err))
 		}
 
 		err := smErrors.CheckDimensionsInSubtraction(mm, eAsE)
@@ -253,7 +251,7 @@ func (mm MonomialMatrix) Multiply(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Multiply: %v", err))
+			panic(smErrors.Errorf("error in second argument to Multiply: %v", err))
 		}
 
 		err := smErrors.CheckDimensionsInMultiplication(mm, eAsE)
@@ -280,6 +278,14 @@ func (mm MonomialMatrix) Multiply(e interface{}) Expression {
 			product = append(product, productRow)
 		}
 		return product
+	case RationalExpression:
+		return right.Multiply(mm)
+	case FunctionExpression:
+		return right.Multiply(mm)
+	case FunctionExpressionVector:
+		return MatrixMultiplyTemplate(mm, right.Transpose().Transpose().(FunctionExpressionMatrix))
+	case FunctionExpressionMatrix:
+		return MatrixMultiplyTemplate(mm, right)
 	case VariableVector:
 		if nRows == 1 {
 			// Output will be a scalar expression
@@ -304,7 +310,7 @@ func (mm MonomialMatrix) Multiply(e interface{}) Expression {
 				product_iiAsSE, tf := product_ii.(ScalarExpression)
 				if !tf {
 					panic(
-						fmt.Errorf(
+						smErrors.Errorf(
 							"error converting product row to ScalarExpression; got type %T",
 							product_ii,
 						),
@@ -369,7 +375,7 @@ func (mm MonomialMatrix) Comparison(rightIn interface{}, sense ConstrSense) Cons
 		rightAsE, _ := ToExpression(rightIn)
 		err = rightAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Comparison: %v", err))
+			panic(smErrors.Errorf("error in second argument to Comparison: %v", err))
 		}
 
 		err := CheckDimensionsInComparison(mm, rightAsE, sense)
@@ -614,7 +620,7 @@ func (mm MonomialMatrix) AsSimplifiedExpression() Expression {
 			simplified := mm[ii][jj].AsSimplifiedExpression()
 			simplifiedAsSE, tf := simplified.(ScalarExpression)
 			if !tf {
-				panic(fmt.Errorf("error simplifying monomial matrix entry %v,%v", ii, jj))
+				panic(smErrors.Errorf("error simplifying monomial matrix entry %v,%v", ii, jj))
 			}
 			// Save the converted simplified expression
 			simplifiedRow[jj] = simplifiedAsSE
@@ -625,3 +631,59 @@ func (mm MonomialMatrix) AsSimplifiedExpression() Expression {
 	// Return the simplified matrix
 	return ConcretizeMatrixExpression(simplifiedMM)
 }
+
+// Evaluate Returns the value of the monomial matrix when each variable takes the value given in the values map.
+func (mm MonomialMatrix) Evaluate(values map[Variable]float64) mat.Dense {
+	return MatrixEvaluateTemplate(mm, values)
+}
+
+// EvaluateAt Returns the value of the monomial matrix at the point x.
+func (mm MonomialMatrix) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.Dense {
+	return mm.Evaluate(valueMapForEvaluateAt(mm, x, wrt))
+}
+
+// Equals Returns true if the matrix and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (mm MonomialMatrix) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(mm, other, tol)
+}
+
+// Canonicalize Returns a copy of the matrix in which each monomial is in canonical form.
+func (mm MonomialMatrix) Canonicalize() Expression {
+	// Input Processing
+	err := mm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var mmOut MonomialMatrix
+	for _, row := range mm {
+		var rowOut []Monomial
+		for _, monomial := range row {
+			rowOut = append(rowOut, canonicalMonomial(monomial))
+		}
+		mmOut = append(mmOut, rowOut)
+	}
+	return mmOut
+}
+
+// ToPolynomialMatrix Converts the monomial matrix to a polynomial matrix.
+func (mm MonomialMatrix) ToPolynomialMatrix() PolynomialMatrix {
+	// Input Processing
+	err := mm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pmOut PolynomialMatrix
+	for _, row := range mm {
+		var pmRow []Polynomial
+		for _, monomial := range row {
+			pmRow = append(pmRow, monomial.ToPolynomial())
+		}
+		pmOut = append(pmOut, pmRow)
+	}
+	return pmOut
+}
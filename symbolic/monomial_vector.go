@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -34,7 +32,7 @@ func (mv MonomialVector) Check() error {
 	for ii, monomial := range mv {
 		err := monomial.Check()
 		if err != nil {
-			return fmt.Errorf("error in monomial %v: %v", ii, err)
+			return smErrors.Errorf("error in monomial %v: %v", ii, err)
 		}
 	}
 
@@ -263,6 +261,12 @@ func (mv MonomialVector) Eq(rightIn interface{}) Constraint {
 	return mv.Comparison(rightIn, SenseEqual)
 }
 
+// Between returns the elementwise range constraint lower <= mv <= upper.
+// Each bound is either a constant or a constant vector with the same length as mv.
+func (mv MonomialVector) Between(lower, upper interface{}) Constraint {
+	return newVectorRangeConstraint(mv, lower, upper)
+}
+
 // Comparison returns a constraint which compares the monomial vector to the input expression in the sense provided by sense.
 func (mv MonomialVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
 	// Input Processing
@@ -360,7 +364,7 @@ func (mv MonomialVector) DerivativeWrt(v Variable) Expression {
 				mv = append(mv, e.ToMonomial())
 			default:
 				panic(
-					fmt.Errorf(
+					smErrors.Errorf(
 						"Unexpected type of element in the DerivativeWrt() method: %T (%v)",
 						element, element,
 					),
@@ -551,7 +555,7 @@ func (mv MonomialVector) AsSimplifiedExpression() Expression {
 		simplified := monomial.AsSimplifiedExpression()
 		simplifiedAsSE, tf := simplified.(ScalarExpression)
 		if !tf {
-			panic(fmt.Errorf("error simplifying monomial vector entry %v", ii))
+			panic(smErrors.Errorf("error simplifying monomial vector entry %v", ii))
 		}
 		// Add the simplified version of the monomial to the output
 		out = append(out, simplifiedAsSE)
@@ -568,3 +572,35 @@ func (mv MonomialVector) ToScalarExpressions() []ScalarExpression {
 	}
 	return out
 }
+
+// Evaluate Returns the value of the monomial vector when each variable takes the value given in the values map.
+func (mv MonomialVector) Evaluate(values map[Variable]float64) mat.VecDense {
+	return VectorEvaluateTemplate(mv, values)
+}
+
+// EvaluateAt Returns the value of the monomial vector at the point x.
+func (mv MonomialVector) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.VecDense {
+	return mv.Evaluate(valueMapForEvaluateAt(mv, x, wrt))
+}
+
+// Equals Returns true if the vector and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (mv MonomialVector) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(mv, other, tol)
+}
+
+// Canonicalize Returns a copy of the vector in which each monomial is in canonical form.
+func (mv MonomialVector) Canonicalize() Expression {
+	// Input Processing
+	err := mv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var mvOut MonomialVector
+	for _, monomial := range mv {
+		mvOut = append(mvOut, canonicalMonomial(monomial))
+	}
+	return mvOut
+}
@@ -0,0 +1,29 @@
+package symbolic
+
+import "github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+
+// ObjSense represents whether an objective function should be minimized or maximized.
+type ObjSense string
+
+// Different objective senses.
+const (
+	SenseMinimize ObjSense = "Minimize"
+	SenseMaximize ObjSense = "Maximize"
+)
+
+// String returns a string representation of the objective sense (e.g., "Minimize").
+func (os ObjSense) String() string {
+	return string(os)
+}
+
+// Check This method checks if the receiver is one of the allowed types of sense.
+func (os ObjSense) Check() error {
+	switch os {
+	case SenseMinimize:
+		return nil
+	case SenseMaximize:
+		return nil
+	default:
+		return smErrors.Errorf("unexpected objective sense: %v!", string(os))
+	}
+}
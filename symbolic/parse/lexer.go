@@ -0,0 +1,115 @@
+package parse
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+)
+
+/*
+lexer.go
+Description:
+
+	Defines the lexer which splits an infix math string into the tokens
+	(numbers, identifiers, operators and comparison operators) used by the parser.
+*/
+
+// tokenKind describes the kind of a token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdentifier
+	tokenOperator
+	tokenComparison
+)
+
+// token is a single lexical element of the input string.
+type token struct {
+	Kind     tokenKind
+	Text     string
+	Position int
+}
+
+// String returns a description of the token that is used in error messages.
+func (t token) String() string {
+	if t.Kind == tokenEOF {
+		return "end of input"
+	}
+	return fmt.Sprintf("\"%v\"", t.Text)
+}
+
+// lex Splits src into tokens. The last token is always of kind tokenEOF.
+func lex(src string) ([]token, error) {
+	// Setup
+	var tokens []token
+	runes := []rune(src)
+
+	// Algorithm
+	for ii := 0; ii < len(runes); {
+		r := runes[ii]
+		switch {
+		case unicode.IsSpace(r):
+			ii++
+
+		case unicode.IsDigit(r) || (r == '.' && ii+1 < len(runes) && unicode.IsDigit(runes[ii+1])):
+			start := ii
+			for ii < len(runes) && (unicode.IsDigit(runes[ii]) || runes[ii] == '.') {
+				ii++
+			}
+			// Scientific notation (e.g., 1e-5)
+			if ii < len(runes) && (runes[ii] == 'e' || runes[ii] == 'E') {
+				jj := ii + 1
+				if jj < len(runes) && (runes[jj] == '+' || runes[jj] == '-') {
+					jj++
+				}
+				if jj < len(runes) && unicode.IsDigit(runes[jj]) {
+					ii = jj
+					for ii < len(runes) && unicode.IsDigit(runes[ii]) {
+						ii++
+					}
+				}
+			}
+			tokens = append(tokens, token{Kind: tokenNumber, Text: string(runes[start:ii]), Position: start})
+
+		case unicode.IsLetter(r) || r == '_':
+			start := ii
+			for ii < len(runes) && (unicode.IsLetter(runes[ii]) || unicode.IsDigit(runes[ii]) || runes[ii] == '_') {
+				ii++
+			}
+			tokens = append(tokens, token{Kind: tokenIdentifier, Text: string(runes[start:ii]), Position: start})
+
+		case r == '<' || r == '>' || r == '=':
+			if ii+1 < len(runes) && runes[ii+1] == '=' {
+				tokens = append(tokens, token{Kind: tokenComparison, Text: string(runes[ii : ii+2]), Position: ii})
+				ii += 2
+			} else if r == '=' {
+				tokens = append(tokens, token{Kind: tokenComparison, Text: "==", Position: ii})
+				ii++
+			} else {
+				return nil, smErrors.ParseError{
+					Input:    src,
+					Position: ii,
+					Message:  fmt.Sprintf("expected \"%v=\"", string(r)),
+				}
+			}
+
+		case r == '+' || r == '-' || r == '*' || r == '^' || r == '(' || r == ')' ||
+			r == '[' || r == ']' || r == ',' || r == ';':
+			tokens = append(tokens, token{Kind: tokenOperator, Text: string(r), Position: ii})
+			ii++
+
+		default:
+			return nil, smErrors.ParseError{
+				Input:    src,
+				Position: ii,
+				Message:  fmt.Sprintf("unexpected character \"%v\"", string(r)),
+			}
+		}
+	}
+
+	tokens = append(tokens, token{Kind: tokenEOF, Position: len(runes)})
+	return tokens, nil
+}
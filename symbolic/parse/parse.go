@@ -0,0 +1,417 @@
+package parse
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"strconv"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+parse.go
+Description:
+
+	Defines the ParseExpression and ParseConstraint functions, which build
+	expressions and constraints from infix math strings like "3 x_0^2 + x_1 <= 4".
+
+	The grammar that is supported is:
+
+		constraint := expression ( "<=" | ">=" | "==" ) expression
+		expression := term { ( "+" | "-" ) term }
+		term       := unary { [ "*" ] unary }
+		unary      := ( "-" | "+" ) unary | power
+		power      := primary [ "^" unary ]
+		primary    := number | identifier | "(" expression ")" | "[" row { ";" row } "]"
+		row        := expression { "," expression }
+
+	Two factors written next to each other (e.g., "3 x" or "2(x + 1)") are multiplied.
+*/
+
+// MaxExponent is the largest exponent accepted after "^". The powers of expressions
+// are computed by repeated multiplication, so larger exponents are rejected with a
+// ParseError instead of taking (practically) forever.
+const MaxExponent = 1000
+
+// ParseExpression Parses the infix math string src into an Expression.
+// Identifiers are matched with the Name of the variables tracked by env; identifiers
+// that do not match any variable create a new (unbounded, continuous) variable in env.
+// Bracketed literals with a single column (e.g., "[a; b]") become vector expressions
+// and all other bracketed literals (e.g., "[a, b; c, d]") become matrix expressions.
+// Strings that contain a comparison operator are rejected; use ParseConstraint for them.
+func ParseExpression(src string, env symbolic.Environment) (symbolic.Expression, error) {
+	// Input Processing
+	p, err := newParser(src, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	var e symbolic.Expression
+	err = p.run(func() error {
+		var err error
+		e, err = p.parseExpression()
+		if err != nil {
+			return err
+		}
+
+		if p.peek().Kind == tokenComparison {
+			return p.errorAt(p.peek(), "expected end of input (use ParseConstraint to parse constraints)")
+		}
+		return p.expectEOF()
+	})
+
+	return e, err
+}
+
+// ParseConstraint Parses the infix math string src (which must contain one of the
+// comparison operators "<=", ">=" or "==") into a Constraint.
+// Identifiers are handled as in ParseExpression.
+func ParseConstraint(src string, env symbolic.Environment) (symbolic.Constraint, error) {
+	// Input Processing
+	p, err := newParser(src, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Algorithm
+	var c symbolic.Constraint
+	err = p.run(func() error {
+		var err error
+		c, err = p.parseConstraint()
+		if err != nil {
+			return err
+		}
+		return p.expectEOF()
+	})
+
+	return c, err
+}
+
+// parser holds the state of a recursive descent parser.
+type parser struct {
+	src    string
+	tokens []token
+	pos    int
+	env    symbolic.Environment
+}
+
+// newParser Creates a parser for src (using the default environment when env is nil).
+func newParser(src string, env symbolic.Environment) (*parser, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if env == nil {
+		env = symbolic.DefaultEnvironment
+	}
+
+	return &parser{src: src, tokens: tokens, env: env}, nil
+}
+
+// run Calls parse and converts any panic raised by the symbolic package
+// (e.g., a dimension mismatch) into a ParseError. Runtime errors (e.g., a nil
+// dereference caused by a bug) are raised again.
+func (p *parser) run(parse func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isRuntimeError := r.(runtime.Error); isRuntimeError {
+				panic(r)
+			}
+
+			err = smErrors.ParseError{
+				Input:    p.src,
+				Position: p.peek().Position,
+				Message:  fmt.Sprintf("%v", r),
+			}
+		}
+	}()
+
+	return parse()
+}
+
+// peek Returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next Consumes and returns the current token.
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.Kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// isOperator Returns true if the current token is the operator op.
+func (p *parser) isOperator(op string) bool {
+	t := p.peek()
+	return t.Kind == tokenOperator && t.Text == op
+}
+
+// errorAt Creates a ParseError located at the token t.
+func (p *parser) errorAt(t token, message string) error {
+	return smErrors.ParseError{
+		Input:    p.src,
+		Position: t.Position,
+		Message:  fmt.Sprintf("%v; found %v", message, t),
+	}
+}
+
+// expectOperator Consumes the operator op or returns an error.
+func (p *parser) expectOperator(op string) error {
+	if !p.isOperator(op) {
+		return p.errorAt(p.peek(), fmt.Sprintf("expected \"%v\"", op))
+	}
+	p.next()
+	return nil
+}
+
+// expectEOF Returns an error if there are tokens left in the input.
+func (p *parser) expectEOF() error {
+	if p.peek().Kind != tokenEOF {
+		return p.errorAt(p.peek(), "expected end of input")
+	}
+	return nil
+}
+
+// parseConstraint Parses: expression ( "<=" | ">=" | "==" ) expression
+func (p *parser) parseConstraint() (symbolic.Constraint, error) {
+	lhs, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := p.next()
+	if comparison.Kind != tokenComparison {
+		return nil, p.errorAt(comparison, "expected one of \"<=\", \">=\" or \"==\"")
+	}
+
+	rhs, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	switch comparison.Text {
+	case "<=":
+		return lhs.Comparison(rhs, symbolic.SenseLessThanEqual), nil
+	case ">=":
+		return lhs.Comparison(rhs, symbolic.SenseGreaterThanEqual), nil
+	default:
+		return lhs.Comparison(rhs, symbolic.SenseEqual), nil
+	}
+}
+
+// parseExpression Parses: term { ( "+" | "-" ) term }
+func (p *parser) parseExpression() (symbolic.Expression, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("+") || p.isOperator("-") {
+		op := p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+
+		if op.Text == "+" {
+			left = left.Plus(right)
+		} else {
+			left = left.Minus(right)
+		}
+	}
+
+	return left, nil
+}
+
+// parseTerm Parses: unary { [ "*" ] unary }
+func (p *parser) parseTerm() (symbolic.Expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		// Explicit multiplication
+		if p.isOperator("*") {
+			p.next()
+		} else if t := p.peek(); !(t.Kind == tokenNumber || t.Kind == tokenIdentifier || p.isOperator("(")) {
+			// The next token can not start an implicitly multiplied factor
+			return left, nil
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = left.Multiply(right)
+	}
+}
+
+// parseUnary Parses: ( "-" | "+" ) unary | power
+func (p *parser) parseUnary() (symbolic.Expression, error) {
+	if p.isOperator("-") || p.isOperator("+") {
+		op := p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		if op.Text == "-" {
+			return operand.Multiply(-1.0), nil
+		}
+		return operand, nil
+	}
+
+	return p.parsePower()
+}
+
+// parsePower Parses: primary [ "^" unary ]
+// The exponent must be a constant, non-negative integer that is at most MaxExponent.
+func (p *parser) parsePower() (symbolic.Expression, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.isOperator("^") {
+		return base, nil
+	}
+	p.next()
+
+	exponentToken := p.peek()
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	exponentAsK, isK := exponent.(symbolic.K)
+	if !isK || float64(exponentAsK) != math.Trunc(float64(exponentAsK)) || exponentAsK < 0 {
+		return nil, p.errorAt(exponentToken, "expected a non-negative integer exponent")
+	}
+
+	if exponentAsK > MaxExponent {
+		return nil, p.errorAt(
+			exponentToken,
+			fmt.Sprintf("expected an exponent of at most %v; received %v", MaxExponent, float64(exponentAsK)),
+		)
+	}
+
+	switch concreteBase := base.(type) {
+	case symbolic.ScalarExpression:
+		return concreteBase.Power(int(exponentAsK)), nil
+	case symbolic.VectorExpression:
+		return concreteBase.Power(int(exponentAsK)), nil
+	case symbolic.MatrixExpression:
+		return concreteBase.Power(int(exponentAsK)), nil
+	default:
+		return nil, p.errorAt(exponentToken, fmt.Sprintf("can not raise an expression of type %T to a power", base))
+	}
+}
+
+// parsePrimary Parses: number | identifier | "(" expression ")" | "[" row { ";" row } "]"
+func (p *parser) parsePrimary() (symbolic.Expression, error) {
+	t := p.next()
+	switch {
+	case t.Kind == tokenNumber:
+		value, err := strconv.ParseFloat(t.Text, 64)
+		if err != nil {
+			return nil, p.errorAt(t, "invalid number")
+		}
+		return symbolic.K(value), nil
+
+	case t.Kind == tokenIdentifier:
+		return p.variableNamed(t.Text), nil
+
+	case t.Kind == tokenOperator && t.Text == "(":
+		e, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expectOperator(")")
+
+	case t.Kind == tokenOperator && t.Text == "[":
+		return p.parseBracketedLiteral(t)
+
+	default:
+		return nil, p.errorAt(t, "expected a number, a variable, \"(\" or \"[\"")
+	}
+}
+
+// parseBracketedLiteral Parses the rows of a vector or matrix literal
+// (the opening "[" has already been consumed).
+func (p *parser) parseBracketedLiteral(open token) (symbolic.Expression, error) {
+	var rows [][]symbolic.ScalarExpression
+	for {
+		var row []symbolic.ScalarExpression
+		for {
+			elementToken := p.peek()
+			element, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+
+			elementAsSE, isScalar := element.(symbolic.ScalarExpression)
+			if !isScalar {
+				return nil, p.errorAt(elementToken, "expected a scalar element")
+			}
+			row = append(row, elementAsSE)
+
+			if !p.isOperator(",") {
+				break
+			}
+			p.next()
+		}
+
+		if len(rows) > 0 && len(row) != len(rows[0]) {
+			return nil, p.errorAt(
+				p.peek(),
+				fmt.Sprintf("expected %v elements in row %v; received %v", len(rows[0]), len(rows), len(row)),
+			)
+		}
+		rows = append(rows, row)
+
+		if !p.isOperator(";") {
+			break
+		}
+		p.next()
+	}
+
+	err := p.expectOperator("]")
+	if err != nil {
+		return nil, err
+	}
+
+	// A single column becomes a vector
+	if len(rows[0]) == 1 {
+		var elements []symbolic.ScalarExpression
+		for _, row := range rows {
+			elements = append(elements, row[0])
+		}
+		return symbolic.ConcretizeVectorExpression(elements), nil
+	}
+
+	return symbolic.ConcretizeMatrixExpression(rows), nil
+}
+
+// variableNamed Returns the variable in the parser's environment with the given name,
+// creating a new continuous variable if no such variable exists.
+func (p *parser) variableNamed(name string) symbolic.Variable {
+	if v, found := p.env.VariableByName(name); found {
+		return v
+	}
+
+	return symbolic.NewCustomVariable(
+		symbolic.Continuous,
+		-float64(symbolic.Infinity),
+		float64(symbolic.Infinity),
+		name,
+		p.env,
+	)
+}
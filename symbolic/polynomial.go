@@ -25,14 +25,14 @@ type Polynomial struct {
 func (p Polynomial) Check() error {
 	// Check that the polynomial has at least one monomial
 	if len(p.Monomials) == 0 {
-		return fmt.Errorf("polynomial has no monomials")
+		return smErrors.Errorf("polynomial has no monomials")
 	}
 
 	// Check that each of the monomials are well formed
 	for ii, monomial := range p.Monomials {
 		err := monomial.Check()
 		if err != nil {
-			return fmt.Errorf("error in monomial %v: %v", ii, err)
+			return smErrors.Errorf("error in monomial %v: %v", ii, err)
 		}
 	}
 
@@ -137,7 +137,11 @@ func (p Polynomial) Plus(e interface{}) Expression {
 		pCopy.Monomials = append(pCopy.Monomials, right.Monomials...)
 
 		out = pCopy.AsSimplifiedExpression()
-	case KVector, VariableVector, MonomialVector, PolynomialVector:
+	case RationalExpression:
+		out = right.Plus(p.Copy())
+	case FunctionExpression:
+		out = sumOf(p.Copy(), right)
+	case KVector, VariableVector, MonomialVector, PolynomialVector, FunctionExpressionVector:
 		ve, _ := ToVectorExpression(right)
 		if ve.Len() == 1 {
 			out = p.Plus(ve.AtVec(0)) // Reuse scalar case
@@ -150,7 +154,7 @@ func (p Polynomial) Plus(e interface{}) Expression {
 			out = ConcretizeExpression(vecExpression)
 		}
 
-	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix:
+	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix, FunctionExpressionMatrix:
 		// Setup
 
 		// Convert right to as ME
@@ -280,8 +284,9 @@ func (p Polynomial) MonomialIndex(mIn Monomial) int {
 	}
 
 	// Algorithm
+	key := mIn.Key()
 	for ii, monomial := range p.Monomials {
-		if monomial.MatchesFormOf(mIn) {
+		if monomial.Key() == key {
 			return ii
 		}
 	}
@@ -341,23 +346,28 @@ func (p Polynomial) Multiply(e interface{}) Expression {
 		}
 		out = prod
 	case Polynomial:
-		pCopy := p.Copy()
-
-		// Multiply each monomial of the polynomial by the polynomial
-		var productOut Expression = K(0.0)
-		for ii := 0; ii < len(right.Monomials); ii++ {
-			// fmt.Println(fmt.Sprintf("pCopy.Multiply(right.Monomials[ii]): %v", pCopy.Multiply(right.Monomials[ii])))
-			productOut = productOut.Plus(
-				pCopy.Multiply(right.Monomials[ii]),
-			)
+		// Multiply each monomial of the polynomial by each monomial of right
+		// and combine the matching terms once at the end.
+		var productOut Polynomial
+		for _, rightMonomial := range right.Monomials {
+			for _, leftMonomial := range p.Monomials {
+				productOut.Monomials = append(
+					productOut.Monomials,
+					multiplyMonomials(leftMonomial, rightMonomial),
+				)
+			}
 		}
 
 		out = productOut
-	case KVector, VariableVector, MonomialVector, PolynomialVector:
+	case RationalExpression:
+		out = right.Multiply(p.Copy())
+	case FunctionExpression:
+		out = productOf(p.Copy(), right)
+	case KVector, VariableVector, MonomialVector, PolynomialVector, FunctionExpressionVector:
 		// Right must be a vector of length 1
 		ve, _ := ToVectorExpression(right)
 		out = ve.Multiply(p) // Reuse scalar case
-	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix:
+	case KMatrix, VariableMatrix, MonomialMatrix, PolynomialMatrix, FunctionExpressionMatrix:
 		// Right must be a matrix of size [1,1]
 		me, _ := ToMatrixExpression(right)
 		out = me.Multiply(p) // Reuse scalar case
@@ -424,6 +434,10 @@ func (p Polynomial) Comparison(rightIn interface{}, sense ConstrSense) Constrain
 		return ScalarConstraint{p, right, sense}
 	case Polynomial:
 		return ScalarConstraint{p, right, sense}
+	case RationalExpression:
+		return ScalarConstraint{p, right, sense}
+	case FunctionExpression:
+		return ScalarConstraint{p, right, sense}
 	}
 
 	panic(
@@ -449,6 +463,11 @@ func (p Polynomial) Eq(rightIn interface{}) Constraint {
 	return p.Comparison(rightIn, SenseEqual)
 }
 
+// Between returns the range constraint lower <= p <= upper.
+func (p Polynomial) Between(lower, upper interface{}) Constraint {
+	return newScalarRangeConstraint(p, lower, upper)
+}
+
 // Constant Retrieves the constant component of the polynomial if there is one.
 func (p Polynomial) Constant() float64 {
 	// Input Processing
@@ -478,32 +497,35 @@ func (p Polynomial) Simplify() Polynomial {
 
 	// Create containers for constant monomials and non-constant monomials
 	// and then combine them.
+	// The non-constant monomials are indexed by their key, so that each monomial
+	// is combined with the matching terms in constant time. The monomials of the
+	// output are in canonical form (see isCanonical).
 	constantMonomials := Monomial{
 		Coefficient: 0.0,
 	}
 	nonConstantMonomials := []Monomial{}
+	indexOfKey := make(map[MonomialKey]int, len(p.Monomials))
 	for _, monomial := range p.Monomials {
-		if monomial.IsConstant() {
+		if !monomial.isCanonical() {
+			monomial = monomial.asCanonical()
+		}
+
+		key := monomial.Key()
+		if key == "" {
+			// The monomial is a constant (possibly written with zero exponents)
 			constantMonomials.Coefficient += monomial.Coefficient
-		} else {
-			// Check to see if the monomial is already in the slice of non-constant monomials
-			monomialIndex := -1
-			for ii, existingMonomial := range nonConstantMonomials {
-				if existingMonomial.MatchesFormOf(monomial) {
-					monomialIndex = ii
-					break
-				}
-			}
-			// If the monomial is already in the slice, then add to its coefficient
-			if monomialIndex != -1 {
-				existingMonomial := nonConstantMonomials[monomialIndex]
-				existingMonomial.Coefficient += monomial.Coefficient
-				nonConstantMonomials[monomialIndex] = existingMonomial
-				continue
-			}
-			// Otherwise, add the monomial to the slice of non-constant monomials
-			nonConstantMonomials = append(nonConstantMonomials, monomial)
+			continue
+		}
+
+		// If the monomial is already in the slice, then add to its coefficient
+		if monomialIndex, found := indexOfKey[key]; found {
+			nonConstantMonomials[monomialIndex].Coefficient += monomial.Coefficient
+			continue
 		}
+
+		// Otherwise, add the monomial to the slice of non-constant monomials
+		indexOfKey[key] = len(nonConstantMonomials)
+		nonConstantMonomials = append(nonConstantMonomials, monomial)
 	}
 	// If the constant monomial is not zero and there are no other monomials,
 	// then return just the constant monomial as a constant
@@ -529,6 +551,37 @@ func (p Polynomial) Simplify() Polynomial {
 
 }
 
+// sumOfScalarExpressions Returns the (simplified) sum of the given scalar expressions.
+// When all of the terms are polynomial-like, their monomials are collected into a single
+// polynomial that is simplified once (instead of adding the terms one at a time).
+func sumOfScalarExpressions(terms []ScalarExpression) Expression {
+	var sum Polynomial
+	for _, term := range terms {
+		switch concrete := term.(type) {
+		case K:
+			sum.Monomials = append(sum.Monomials, concrete.ToMonomial())
+		case Variable:
+			sum.Monomials = append(sum.Monomials, concrete.ToMonomial())
+		case Monomial:
+			sum.Monomials = append(sum.Monomials, concrete)
+		case Polynomial:
+			sum.Monomials = append(sum.Monomials, concrete.Monomials...)
+		default:
+			// Fall back to adding the terms one at a time
+			var out Expression = K(0.0)
+			for _, t := range terms {
+				out = out.Plus(t)
+			}
+			return out.AsSimplifiedExpression()
+		}
+	}
+
+	if len(sum.Monomials) == 0 {
+		return K(0.0)
+	}
+	return sum.AsSimplifiedExpression()
+}
+
 // AsSimplifiedExpression returns the simplest form of the polynomial,
 // reducing it to a lower-degree expression type when possible.
 func (p Polynomial) AsSimplifiedExpression() Expression {
@@ -589,7 +642,7 @@ func (p Polynomial) DerivativeWrt(vIn Variable) Expression {
 			}
 			derivative.Monomials = append(derivative.Monomials, component.ToMonomial())
 		default:
-			panic(fmt.Errorf("Unexpected type in Polynomial.Derivative: %T", component))
+			panic(smErrors.Errorf("Unexpected type in Polynomial.Derivative: %T", component))
 		}
 	}
 
@@ -638,7 +691,7 @@ func (p Polynomial) LinearCoeff(wrt ...[]Variable) mat.VecDense {
 	case 1:
 		wrtVars = wrt[0]
 	default:
-		panic(fmt.Errorf("Too many inputs provided to LinearCoeff() method."))
+		panic(smErrors.Errorf("Too many inputs provided to LinearCoeff() method."))
 	}
 
 	// Constants
@@ -661,6 +714,82 @@ func (p Polynomial) LinearCoeff(wrt ...[]Variable) mat.VecDense {
 	return coeffOut
 }
 
+// QuadraticRepresentation This function returns the matrix Q, the vector c and the constant r
+// such that the polynomial p can be written as:
+//
+//	p = 0.5 * x^T Q x + c^T x + r
+//
+// where the (ii)th element of x is the (ii)th variable in wrt (or p.Variables() if wrt is not provided).
+func (p Polynomial) QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64) {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if !IsQuadratic(p) {
+		panic(smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticRepresentation",
+			Expression: p,
+		})
+	}
+
+	// Check to see if the user provided a slice of variables
+	var wrtVars []Variable
+	switch len(wrt) {
+	case 0:
+		wrtVars = p.Variables()
+	case 1:
+		wrtVars = wrt[0]
+	default:
+		panic(smErrors.Errorf("Too many inputs provided to QuadraticRepresentation() method."))
+	}
+
+	if len(wrtVars) == 0 {
+		panic(smErrors.CanNotGetLinearCoeffOfConstantError{Expression: p})
+	}
+
+	// Algorithm
+	Q := mat.NewSymDense(len(wrtVars), nil)
+	c := ZerosVector(len(wrtVars))
+	r := 0.0
+	for _, monomial := range p.Monomials {
+		// Collect the index of each variable factor (with repetition)
+		var indices []int
+		for jj, factor := range monomial.VariableFactors {
+			idx, _ := FindInSlice(factor, wrtVars)
+			if idx == -1 {
+				panic(
+					smErrors.Errorf(
+						"QuadraticRepresentation: variable %v appears in the expression, but not in the given wrt slice",
+						factor,
+					),
+				)
+			}
+			for kk := 0; kk < monomial.Exponents[jj]; kk++ {
+				indices = append(indices, idx)
+			}
+		}
+
+		// Add the monomial's contribution to Q, c or r
+		switch len(indices) {
+		case 0:
+			r += monomial.Coefficient
+		case 1:
+			c.SetVec(indices[0], c.AtVec(indices[0])+monomial.Coefficient)
+		case 2:
+			ii, jj := indices[0], indices[1]
+			if ii == jj {
+				Q.SetSym(ii, ii, Q.At(ii, ii)+2*monomial.Coefficient)
+			} else {
+				Q.SetSym(ii, jj, Q.At(ii, jj)+monomial.Coefficient)
+			}
+		}
+	}
+
+	return *Q, c, r
+}
+
 // IsConstant This method returns true if and only if the polynomial
 // represented by pv is a constant (i.e., it contains no variables).
 func (p Polynomial) IsConstant() bool {
@@ -766,10 +895,19 @@ func (p Polynomial) SubstituteAccordingTo(subMap map[Variable]Expression) Expres
 }
 
 // Power Computes the power of the constant.
+// Negative exponents produce a RationalExpression (e.g., (1 + x)^-1 = 1/(1 + x)).
 func (p Polynomial) Power(exponent int) Expression {
+	if exponent < 0 {
+		return scalarReciprocalPower(p, exponent)
+	}
 	return ScalarPowerTemplate(p, exponent)
 }
 
+// Divide Computes the ratio of the polynomial and another scalar expression.
+func (p Polynomial) Divide(rightIn interface{}) Expression {
+	return ScalarDivideTemplate(p, rightIn)
+}
+
 // At Returns the value at the given row and column index.
 // Note:
 //
@@ -790,3 +928,48 @@ func (p Polynomial) At(ii, jj int) ScalarExpression {
 	// Algorithm
 	return p
 }
+
+// Evaluate Returns the value of the polynomial when each variable takes the value given in the values map.
+// If one of the polynomial's variables is not in the map, then this panics with a MissingVariableValueError.
+func (p Polynomial) Evaluate(values map[Variable]float64) float64 {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	checkValuesForEvaluate(p, values)
+
+	// Algorithm
+	out := 0.0
+	for _, monomial := range p.Monomials {
+		out += monomial.Evaluate(values)
+	}
+
+	return out
+}
+
+// EvaluateAt Returns the value of the polynomial at the point x.
+func (p Polynomial) EvaluateAt(x mat.VecDense, wrt ...[]Variable) float64 {
+	return p.Evaluate(valueMapForEvaluateAt(p, x, wrt))
+}
+
+// Equals Returns true if the polynomial and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (p Polynomial) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(p, other, tol)
+}
+
+// Canonicalize Returns a copy of the polynomial with its matching terms combined,
+// the factors of each term sorted by variable ID and the terms sorted in graded
+// lexicographic order (see canonical.go).
+func (p Polynomial) Canonicalize() Expression {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return canonicalPolynomial(p)
+}
@@ -1,8 +1,7 @@
 package symbolic
 
 import (
-	"fmt"
-
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -104,7 +103,7 @@ func IsPolynomialLikeMatrix(e interface{}) bool {
 func ToPolynomialLikeMatrix(e interface{}) (PolynomialLikeMatrix, error) {
 	// Input Processing
 	if !IsPolynomialLikeMatrix(e) {
-		return DenseToKMatrix(ZerosMatrix(1, 1)), fmt.Errorf(
+		return DenseToKMatrix(ZerosMatrix(1, 1)), smErrors.Errorf(
 			"the input interface is of type %T, which is not recognized as a PolynomialLikeMatrix.",
 			e,
 		)
@@ -123,7 +122,7 @@ func ToPolynomialLikeMatrix(e interface{}) (PolynomialLikeMatrix, error) {
 	case PolynomialMatrix:
 		return e2, nil
 	default:
-		return DenseToKMatrix(ZerosMatrix(1, 1)), fmt.Errorf(
+		return DenseToKMatrix(ZerosMatrix(1, 1)), smErrors.Errorf(
 			"unexpected vector expression conversion requested for type %T!",
 			e,
 		)
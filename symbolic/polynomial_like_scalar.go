@@ -1,8 +1,7 @@
 package symbolic
 
 import (
-	"fmt"
-
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -23,6 +22,10 @@ type PolynomialLikeScalar interface {
 	// LinearCoeff returns the coefficient of the linear terms in the expression
 	LinearCoeff(wrt ...[]Variable) mat.VecDense
 
+	// QuadraticRepresentation returns the matrix Q, vector c and constant r such that
+	// the expression equals 0.5 * x^T Q x + c^T x + r
+	QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64)
+
 	// Plus adds the current expression to another and returns the resulting
 	// expression
 	Plus(rightIn interface{}) Expression
@@ -109,7 +112,7 @@ func IsPolynomialLikeScalar(e interface{}) bool {
 func ToPolynomialLikeScalar(e interface{}) (PolynomialLikeScalar, error) {
 	// Input Processing
 	if !IsPolynomialLikeScalar(e) {
-		return K(1.0), fmt.Errorf(
+		return K(1.0), smErrors.Errorf(
 			"the input interface is of type %T, which is not recognized as a PolynomialLikeScalar.",
 			e,
 		)
@@ -128,7 +131,7 @@ func ToPolynomialLikeScalar(e interface{}) (PolynomialLikeScalar, error) {
 	case Polynomial:
 		return e2, nil
 	default:
-		return K(1.0), fmt.Errorf(
+		return K(1.0), smErrors.Errorf(
 			"unexpected scalar expression conversion requested for type %T!",
 			e,
 		)
@@ -7,8 +7,6 @@ Description:
 */
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -121,7 +119,7 @@ func IsPolynomialLikeVector(e interface{}) bool {
 func ToPolynomialLikeVector(e interface{}) (PolynomialLikeVector, error) {
 	// Input Processing
 	if !IsPolynomialLikeVector(e) {
-		return VecDenseToKVector(OnesVector(1)), fmt.Errorf(
+		return VecDenseToKVector(OnesVector(1)), smErrors.Errorf(
 			"the input interface is of type %T, which is not recognized as a PolynomialLikeVector.",
 			e,
 		)
@@ -140,7 +138,7 @@ func ToPolynomialLikeVector(e interface{}) (PolynomialLikeVector, error) {
 	case PolynomialVector:
 		return e2, nil
 	default:
-		return VecDenseToKVector(OnesVector(1)), fmt.Errorf(
+		return VecDenseToKVector(OnesVector(1)), smErrors.Errorf(
 			"unexpected vector expression conversion requested for type %T!",
 			e,
 		)
@@ -168,7 +166,7 @@ func PolynomialLikeVector_SharedLinearCoeffCalc(plv PolynomialLikeVector, wrt ..
 	case 1:
 		wrtVars = wrt[0]
 	default:
-		panic(fmt.Errorf("Too many inputs provided to LinearCoeff() method."))
+		panic(smErrors.Errorf("Too many inputs provided to LinearCoeff() method."))
 	}
 
 	// Check the wrtVars
@@ -53,7 +53,7 @@ func (pm PolynomialMatrix) Check() error {
 		for jj, polynomial := range row {
 			err := polynomial.Check()
 			if err != nil {
-				return fmt.Errorf("error in polynomial %v,%v: %v", ii, jj, err)
+				return smErrors.Errorf("error in polynomial %v,%v: %v", ii, jj, err)
 			}
 		}
 	}
@@ -106,7 +106,7 @@ func (pm PolynomialMatrix) Plus(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Plus: %v", err))
+			panic(smErrors.Errorf("error in second argument to Plus: %v", err))
 		}
 
 		err = smErrors.CheckDimensionsInAddition(pm, eAsE)
@@ -204,7 +204,7 @@ func (pm PolynomialMatrix) Minus(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Minus: %v", err))
+			panic(smErrors.Errorf("error in second argument to Minus: %v", err))
 		}
 
 		err = smErrors.CheckDimensionsInSubtraction(pm, eAsE)
@@ -242,7 +242,7 @@ func (pm PolynomialMatrix) Multiply(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Multiply: %v", err))
+			panic(smErrors.Errorf("error in second argument to Multiply: %v", err))
 		}
 
 		err = smErrors.CheckDimensionsInMultiplication(pm, eAsE)
@@ -270,6 +270,14 @@ func (pm PolynomialMatrix) Multiply(e interface{}) Expression {
 			product = append(product, productRow)
 		}
 		out = ConcretizeExpression(product)
+	case RationalExpression:
+		out = right.Multiply(pm)
+	case FunctionExpression:
+		out = right.Multiply(pm)
+	case FunctionExpressionVector:
+		out = MatrixMultiplyTemplate(pm, right.Transpose().Transpose().(FunctionExpressionMatrix))
+	case FunctionExpressionMatrix:
+		out = MatrixMultiplyTemplate(pm, right)
 	case VariableVector:
 		// Identify output dimensions
 		nResultRows := pm.Dims()[0]
@@ -356,7 +364,7 @@ func (pm PolynomialMatrix) Comparison(e interface{}, sense ConstrSense) Constrai
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Comparison: %v", err))
+			panic(smErrors.Errorf("error in second argument to Comparison: %v", err))
 		}
 
 		err = CheckDimensionsInComparison(pm, eAsE, sense)
@@ -436,7 +444,14 @@ func (pm PolynomialMatrix) DerivativeWrt(vIn Variable) Expression {
 	for _, row := range pm {
 		var dpmRow []Polynomial
 		for _, polynomial := range row {
-			dpmRow = append(dpmRow, polynomial.DerivativeWrt(vIn).(Polynomial))
+			switch dPolynomial := polynomial.DerivativeWrt(vIn).(type) {
+			case Polynomial:
+				dpmRow = append(dpmRow, dPolynomial)
+			case K:
+				dpmRow = append(dpmRow, dPolynomial.ToPolynomial())
+			default:
+				panic(smErrors.Errorf("Unexpected type in PolynomialMatrix.DerivativeWrt: %T", dPolynomial))
+			}
 		}
 		dpm = append(dpm, dpmRow)
 	}
@@ -500,7 +515,7 @@ func (pm PolynomialMatrix) Simplify() MatrixExpression {
 			entry := pm[rowIndex][colIndex]
 			simplifiedAsSE, tf := entry.AsSimplifiedExpression().(ScalarExpression)
 			if !tf {
-				panic(fmt.Errorf("error simplifying polynomial matrix entry %v,%v", rowIndex, colIndex))
+				panic(smErrors.Errorf("error simplifying polynomial matrix entry %v,%v", rowIndex, colIndex))
 			}
 			tempRow[colIndex] = simplifiedAsSE
 		}
@@ -602,3 +617,39 @@ func (pm PolynomialMatrix) SubstituteAccordingTo(subMap map[Variable]Expression)
 func (pm PolynomialMatrix) Power(exponent int) Expression {
 	return MatrixPowerTemplate(pm, exponent)
 }
+
+// Evaluate Returns the value of the polynomial matrix when each variable takes the value given in the values map.
+func (pm PolynomialMatrix) Evaluate(values map[Variable]float64) mat.Dense {
+	return MatrixEvaluateTemplate(pm, values)
+}
+
+// EvaluateAt Returns the value of the polynomial matrix at the point x.
+func (pm PolynomialMatrix) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.Dense {
+	return pm.Evaluate(valueMapForEvaluateAt(pm, x, wrt))
+}
+
+// Equals Returns true if the matrix and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (pm PolynomialMatrix) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(pm, other, tol)
+}
+
+// Canonicalize Returns a copy of the matrix in which each polynomial is in canonical form.
+func (pm PolynomialMatrix) Canonicalize() Expression {
+	// Input Processing
+	err := pm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pmOut PolynomialMatrix
+	for _, row := range pm {
+		var rowOut []Polynomial
+		for _, polynomial := range row {
+			rowOut = append(rowOut, canonicalPolynomial(polynomial))
+		}
+		pmOut = append(pmOut, rowOut)
+	}
+	return pmOut
+}
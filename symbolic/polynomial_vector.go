@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -18,14 +16,14 @@ type PolynomialVector []Polynomial
 func (pv PolynomialVector) Check() error {
 	// Check that the polynomial has at least one monomial
 	if len(pv) == 0 {
-		return fmt.Errorf("polynomial vector has no polynomials")
+		return smErrors.Errorf("polynomial vector has no polynomials")
 	}
 
 	// Check that each of the monomials are well formed
 	for ii, polynomial := range pv {
 		err := polynomial.Check()
 		if err != nil {
-			return fmt.Errorf("error in polynomial %v: %v", ii, err)
+			return smErrors.Errorf("error in polynomial %v: %v", ii, err)
 		}
 	}
 
@@ -131,7 +129,7 @@ func (pv PolynomialVector) Plus(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Plus: %v", err))
+			panic(smErrors.Errorf("error in second argument to Plus: %v", err))
 		}
 
 		err := smErrors.CheckDimensionsInAddition(pv, eAsE)
@@ -183,7 +181,7 @@ func (pv PolynomialVector) Minus(e interface{}) Expression {
 		eAsE, _ := ToExpression(e)
 		err = eAsE.Check()
 		if err != nil {
-			panic(fmt.Errorf("error in second argument to Minus: %v", err))
+			panic(smErrors.Errorf("error in second argument to Minus: %v", err))
 		}
 
 		err = smErrors.CheckDimensionsInSubtraction(pv, eAsE)
@@ -245,6 +243,10 @@ func (pv PolynomialVector) Multiply(rightIn interface{}) Expression {
 			product = append(product, polynomial.Multiply(right).(ScalarExpression))
 		}
 		out = ConcretizeExpression(product)
+	case RationalExpression:
+		out = right.Multiply(pv)
+	case FunctionExpression:
+		out = right.Multiply(pv)
 	case PolynomialVector:
 		// This should only be true if the polynomial vector is actually a polynomial.
 		// Convert it to a polynomial and do the multiplication as if it was with just the scalar.
@@ -379,14 +381,27 @@ func (pv PolynomialVector) Eq(e interface{}) Constraint {
 	return pv.Comparison(e, SenseEqual)
 }
 
+// Between returns the elementwise range constraint lower <= pv <= upper.
+// Each bound is either a constant or a constant vector with the same length as pv.
+func (pv PolynomialVector) Between(lower, upper interface{}) Constraint {
+	return newVectorRangeConstraint(pv, lower, upper)
+}
+
 // DerivativeWrt Returns the derivative of the polynomial vector with respect to the input variable.
 func (pv PolynomialVector) DerivativeWrt(vIn Variable) Expression {
 	// Constants
-	var derivative PolynomialVector = pv
+	var derivative PolynomialVector
 
 	// Algorithm
-	for ii, polynomial := range pv {
-		derivative[ii] = polynomial.DerivativeWrt(vIn).(Polynomial)
+	for _, polynomial := range pv {
+		switch dPolynomial := polynomial.DerivativeWrt(vIn).(type) {
+		case Polynomial:
+			derivative = append(derivative, dPolynomial)
+		case K:
+			derivative = append(derivative, dPolynomial.ToPolynomial())
+		default:
+			panic(smErrors.Errorf("Unexpected type in PolynomialVector.DerivativeWrt: %T", dPolynomial))
+		}
 	}
 
 	return derivative
@@ -423,7 +438,7 @@ func (pv PolynomialVector) Simplify() VectorExpression {
 		entryAsSE, ok := simplifiedEntryII.(ScalarExpression)
 		if !ok {
 			panic(
-				fmt.Errorf(
+				smErrors.Errorf(
 					"error converting polynomial vector entry %v to a scalar expression during simplification",
 					ii,
 				),
@@ -523,3 +538,35 @@ func (pv PolynomialVector) ToScalarExpressions() []ScalarExpression {
 	}
 	return out
 }
+
+// Evaluate Returns the value of the polynomial vector when each variable takes the value given in the values map.
+func (pv PolynomialVector) Evaluate(values map[Variable]float64) mat.VecDense {
+	return VectorEvaluateTemplate(pv, values)
+}
+
+// EvaluateAt Returns the value of the polynomial vector at the point x.
+func (pv PolynomialVector) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.VecDense {
+	return pv.Evaluate(valueMapForEvaluateAt(pv, x, wrt))
+}
+
+// Equals Returns true if the vector and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (pv PolynomialVector) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(pv, other, tol)
+}
+
+// Canonicalize Returns a copy of the vector in which each polynomial is in canonical form.
+func (pv PolynomialVector) Canonicalize() Expression {
+	// Input Processing
+	err := pv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var pvOut PolynomialVector
+	for _, polynomial := range pv {
+		pvOut = append(pvOut, canonicalPolynomial(polynomial))
+	}
+	return pvOut
+}
@@ -0,0 +1,306 @@
+package symbolic
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+problem.go
+Description:
+	Defines the Problem, a container for an optimization problem
+	(an objective with a sense, decision variables and constraints)
+	together with its classification (LP, QP, MILP) and its matrix representation.
+*/
+
+// Problem is the optimization problem
+//
+//	Sense      Objective
+//	subject to Constraints
+//
+// whose variables are created in (and tracked by) the Environment. The decision
+// variables are stored in the order in which they were added to the problem; this is
+// the order of the columns of the matrix representations of the problem.
+type Problem struct {
+	Name              string
+	Environment       Environment
+	DecisionVariables []VariableVector
+	Objective         Expression
+	Sense             ObjSense
+	Constraints       []Constraint
+}
+
+// NewProblem Creates an empty problem (minimize 0) with the given name. The variables of
+// the problem are created in the given environment (or in the DefaultEnvironment, if none
+// is given).
+func NewProblem(name string, envs ...Environment) Problem {
+	// Input Processing
+	var currentEnv Environment = DefaultEnvironment
+	switch len(envs) {
+	case 1:
+		currentEnv = envs[0]
+	}
+
+	// Algorithm
+	return Problem{
+		Name:        name,
+		Environment: currentEnv,
+		Objective:   K(0.0),
+		Sense:       SenseMinimize,
+	}
+}
+
+// AddVariableVector Creates a vector of n continuous variables in the environment of the
+// problem, adds it to the decision variables and returns it.
+func (p *Problem) AddVariableVector(n int) VariableVector {
+	vv := NewVariableVector(n, p.Environment)
+	p.DecisionVariables = append(p.DecisionVariables, vv)
+	return vv
+}
+
+// AddBinaryVariableVector Creates a vector of n binary variables in the environment of the
+// problem, adds it to the decision variables and returns it.
+func (p *Problem) AddBinaryVariableVector(n int) VariableVector {
+	var vv VariableVector
+	for ii := 0; ii < n; ii++ {
+		vv = append(vv, NewBinaryVariable(p.Environment))
+	}
+	p.DecisionVariables = append(p.DecisionVariables, vv)
+	return vv
+}
+
+// SetObjective Sets the objective of the problem and its sense.
+func (p *Problem) SetObjective(e Expression, sense ObjSense) {
+	p.Objective = e
+	p.Sense = sense
+}
+
+// AddConstraints Adds the given constraints to the problem.
+func (p *Problem) AddConstraints(constraints ...Constraint) {
+	p.Constraints = append(p.Constraints, constraints...)
+}
+
+// Check Checks that the problem is well defined: it has an environment, its objective is
+// a valid scalar expression with a valid sense and its variables and constraints are valid.
+func (p Problem) Check() error {
+	// Check the environment
+	if p.Environment == nil {
+		return smErrors.Errorf("problem \"%v\" has no environment", p.Name)
+	}
+
+	// Check the objective
+	if p.Objective == nil {
+		return smErrors.Errorf("problem \"%v\" has no objective", p.Name)
+	}
+
+	err := p.Objective.Check()
+	if err != nil {
+		return smErrors.Errorf("error in the objective of problem \"%v\": %v", p.Name, err)
+	}
+
+	if !IsScalarExpression(p.Objective) {
+		return smErrors.Errorf("the objective of problem \"%v\" is not a scalar expression (dimensions %v)", p.Name, p.Objective.Dims())
+	}
+
+	err = p.Sense.Check()
+	if err != nil {
+		return err
+	}
+
+	// Check the decision variables
+	for ii, vv := range p.DecisionVariables {
+		err = vv.Check()
+		if err != nil {
+			return smErrors.Errorf("error in decision variable vector %v: %v", ii, err)
+		}
+	}
+
+	// Check the constraints
+	for ii, constraint := range p.Constraints {
+		err = constraint.Check()
+		if err != nil {
+			return smErrors.Errorf("error in constraint %v: %v", ii, err)
+		}
+	}
+
+	// All checks passed
+	return nil
+}
+
+// Variables Returns the unique variables of the problem: the decision variables (in the
+// order in which they were added), followed by any other variable that appears in the
+// objective or in the constraints.
+func (p Problem) Variables() []Variable {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var variables []Variable
+	for _, vv := range p.DecisionVariables {
+		variables = append(variables, vv...)
+	}
+
+	variables = append(variables, p.Objective.Variables()...)
+	for _, constraint := range p.Constraints {
+		variables = append(variables, constraint.Variables()...)
+	}
+
+	return UniqueVars(variables)
+}
+
+// hasLinearConstraints Returns true if all of the constraints of the problem are linear.
+func (p Problem) hasLinearConstraints() bool {
+	for _, constraint := range p.Constraints {
+		if !constraint.IsLinear() {
+			return false
+		}
+	}
+	return true
+}
+
+// hasIntegerVariables Returns true if any of the variables of the problem is binary or integer.
+func (p Problem) hasIntegerVariables() bool {
+	for _, v := range p.Variables() {
+		if v.Type == Binary || v.Type == Integer {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLP Returns true if the problem is a linear program: its objective and constraints
+// are linear and all of its variables are continuous.
+func (p Problem) IsLP() bool {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return IsLinear(p.Objective) && p.hasLinearConstraints() && !p.hasIntegerVariables()
+}
+
+// IsQP Returns true if the problem is a quadratic program: its objective is (at most)
+// quadratic, its constraints are linear and all of its variables are continuous.
+// Every linear program is also a quadratic program.
+func (p Problem) IsQP() bool {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return IsQuadratic(p.Objective) && p.hasLinearConstraints() && !p.hasIntegerVariables()
+}
+
+// IsMILP Returns true if the problem is a mixed-integer linear program: its objective and
+// constraints are linear and at least one of its variables is binary or integer.
+func (p Problem) IsMILP() bool {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return IsLinear(p.Objective) && p.hasLinearConstraints() && p.hasIntegerVariables()
+}
+
+// LinearRepresentation Returns the matrix representation of the linear problem
+//
+//	minimize   c^T x
+//	subject to A x <= b
+//	           C x = d
+//
+// where x contains the variables of the problem (in the order given by Variables()).
+// The objective of a maximization problem is negated (so that it is minimized) and the
+// constant of the objective is dropped. The finite bounds of the variables are included
+// in A x <= b, after the inequality constraints: each finite upper bound u of x_i adds
+// the row x_i <= u and each finite lower bound l adds the row -x_i <= -l. A and C are
+// empty when there are no such rows (respectively, no equality constraints).
+// Returns an error if the problem is not well-defined, if it is not a linear program
+// (see IsLP) or if it does not contain any variables.
+func (p Problem) LinearRepresentation() (c mat.VecDense, A mat.Dense, b mat.VecDense, C mat.Dense, d mat.VecDense, err error) {
+	// Input Processing
+	err = p.Check()
+	if err != nil {
+		return c, A, b, C, d, err
+	}
+
+	if !p.IsLP() {
+		if !IsLinear(p.Objective) {
+			return c, A, b, C, d, smErrors.LinearExpressionRequiredError{
+				Operation:  "LinearRepresentation",
+				Expression: p.Objective,
+			}
+		}
+
+		return c, A, b, C, d, smErrors.Errorf(
+			"LinearRepresentation requires a linear program (linear constraints and continuous variables); problem %v is not one",
+			p.Name,
+		)
+	}
+
+	variables := p.Variables()
+	if len(variables) == 0 {
+		return c, A, b, C, d, smErrors.Errorf(
+			"LinearRepresentation requires at least one variable; problem %v does not contain any",
+			p.Name,
+		)
+	}
+
+	// Algorithm
+
+	c = p.Objective.(ScalarExpression).LinearCoeff(variables)
+	if p.Sense == SenseMaximize {
+		c.ScaleVec(-1, &c)
+	}
+
+	var inequalityRows, equalityRows []float64
+	var inequalityBounds, equalityBounds []float64
+	for _, constraint := range CompileConstraintsIntoScalarConstraints(p.Constraints) {
+		if constraint.Sense == SenseEqual {
+			row, bound := constraint.LinearEqualityConstraintRepresentation(variables)
+			equalityRows = append(equalityRows, mat.Col(nil, 0, &row)...)
+			equalityBounds = append(equalityBounds, bound)
+			continue
+		}
+
+		row, bound := constraint.LinearInequalityConstraintRepresentation(variables)
+		inequalityRows = append(inequalityRows, mat.Col(nil, 0, &row)...)
+		inequalityBounds = append(inequalityBounds, bound)
+	}
+
+	for ii, v := range variables {
+		if v.Upper < float64(+Infinity) {
+			row := make([]float64, len(variables))
+			row[ii] = 1.0
+			inequalityRows = append(inequalityRows, row...)
+			inequalityBounds = append(inequalityBounds, v.Upper)
+		}
+
+		if v.Lower > float64(-Infinity) {
+			row := make([]float64, len(variables))
+			row[ii] = -1.0
+			inequalityRows = append(inequalityRows, row...)
+			inequalityBounds = append(inequalityBounds, -v.Lower)
+		}
+	}
+
+	if len(inequalityBounds) > 0 {
+		A = *mat.NewDense(len(inequalityBounds), len(variables), inequalityRows)
+		b = *mat.NewVecDense(len(inequalityBounds), inequalityBounds)
+	}
+
+	if len(equalityBounds) > 0 {
+		C = *mat.NewDense(len(equalityBounds), len(variables), equalityRows)
+		d = *mat.NewVecDense(len(equalityBounds), equalityBounds)
+	}
+
+	return c, A, b, C, d, nil
+}
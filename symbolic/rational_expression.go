@@ -0,0 +1,742 @@
+package symbolic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+rational_expression.go
+Description:
+	Defines the RationalExpression, the ratio of two polynomials (e.g., 1/(1 + x_0)
+	or x_0/x_1), and the Divide operation on scalar expressions.
+
+	Negative powers of monomials (Laurent monomials such as x_0^-2) are represented
+	as RationalExpressions whose denominator is a single monomial (e.g., 1/(x_0^2)).
+	Like FunctionExpressions, RationalExpressions are not polynomial-like.
+*/
+
+// RationalExpression Type Definition
+type RationalExpression struct {
+	Numerator   Polynomial
+	Denominator Polynomial
+}
+
+// =========
+// Functions
+// =========
+
+// ScalarDivideTemplate Defines the template for the division of a scalar expression
+// by another scalar expression: numerator * (1/rightIn).
+func ScalarDivideTemplate(numerator ScalarExpression, rightIn interface{}) Expression {
+	// Input Processing
+	err := numerator.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	var denominator ScalarExpression
+	switch right := rightIn.(type) {
+	case float64:
+		denominator = K(right)
+	case int:
+		denominator = K(float64(right))
+	case ScalarExpression:
+		denominator = right
+	default:
+		panic(
+			smErrors.UnsupportedInputError{
+				FunctionName: "ScalarDivideTemplate",
+				Input:        rightIn,
+			},
+		)
+	}
+
+	err = denominator.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if isConstantWithValue(denominator, 0.0) {
+		panic(
+			smErrors.DivisionByZeroError{
+				Operation: "Divide",
+				Numerator: numerator,
+			},
+		)
+	}
+
+	// Algorithm
+	return numerator.Multiply(scalarReciprocal(denominator))
+}
+
+// scalarReciprocalPower Returns (1/se)^(-exponent) for a negative exponent.
+// It panics if -exponent overflows (i.e., exponent is math.MinInt), since the power
+// would otherwise recurse with the same negative exponent forever.
+func scalarReciprocalPower(se ScalarExpression, exponent int) Expression {
+	if exponent == math.MinInt {
+		panic(smErrors.ExponentOverflowError{Exponent: exponent})
+	}
+
+	return scalarReciprocal(se).Power(-exponent)
+}
+
+// scalarReciprocal Returns 1/se.
+func scalarReciprocal(se ScalarExpression) ScalarExpression {
+	if isConstantWithValue(se, 0.0) {
+		panic(
+			smErrors.DivisionByZeroError{
+				Operation: "Reciprocal",
+				Numerator: K(1.0),
+			},
+		)
+	}
+
+	switch concrete := se.(type) {
+	case K:
+		return K(1.0 / float64(concrete))
+	case RationalExpression:
+		return newRationalExpression(concrete.Denominator, concrete.Numerator)
+	case FunctionExpression:
+		if concrete.Function == FunctionReciprocal {
+			return concrete.Arguments[0]
+		}
+		return applyFunction(FunctionReciprocal, concrete)
+	default:
+		return newRationalExpression(K(1.0), se)
+	}
+}
+
+// newRationalExpression Returns the (simplified) ratio of the polynomial-like scalars
+// numerator and denominator.
+func newRationalExpression(numerator, denominator ScalarExpression) ScalarExpression {
+	re := RationalExpression{
+		Numerator:   toPolynomial(numerator),
+		Denominator: toPolynomial(denominator),
+	}
+	return re.AsSimplifiedExpression().(ScalarExpression)
+}
+
+// toPolynomial Converts the polynomial-like scalar se into a (copied) Polynomial.
+func toPolynomial(se ScalarExpression) Polynomial {
+	switch concrete := se.(type) {
+	case K:
+		return concrete.ToPolynomial()
+	case Variable:
+		return concrete.ToPolynomial()
+	case Monomial:
+		return concrete.ToPolynomial()
+	case Polynomial:
+		return concrete.Copy()
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "toPolynomial",
+			Input:        se,
+		},
+	)
+}
+
+// commonMonomialFactor Returns the monomial (with coefficient 1) of highest degree that
+// divides each of the canonical monomials in the slice (e.g., x_0 for x_0^2 x_1 and x_0 x_2).
+func commonMonomialFactor(monomials []Monomial) Monomial {
+	exponents := make(map[uint64]int)
+	variables := make(map[uint64]Variable)
+	for ii, monomial := range monomials {
+		exponentsII := make(map[uint64]int)
+		for jj, v := range monomial.VariableFactors {
+			exponentsII[v.ID] = monomial.Exponents[jj]
+			variables[v.ID] = v
+		}
+
+		if ii == 0 {
+			exponents = exponentsII
+			continue
+		}
+
+		for id, exponent := range exponents {
+			exponentII, found := exponentsII[id]
+			switch {
+			case !found:
+				delete(exponents, id)
+			case exponentII < exponent:
+				exponents[id] = exponentII
+			}
+		}
+	}
+
+	// Create the monomial (with its factors sorted by ID)
+	var ids []uint64
+	for id := range exponents {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	common := Monomial{Coefficient: 1.0, VariableFactors: []Variable{}, Exponents: []int{}}
+	for _, id := range ids {
+		common.VariableFactors = append(common.VariableFactors, variables[id])
+		common.Exponents = append(common.Exponents, exponents[id])
+	}
+	return common
+}
+
+// divideByMonomial Returns the canonical polynomial p divided by the monomial divisor
+// (with coefficient 1), which must divide each of the monomials of p.
+func divideByMonomial(p Polynomial, divisor Monomial) Polynomial {
+	exponentOf := make(map[uint64]int)
+	for ii, v := range divisor.VariableFactors {
+		exponentOf[v.ID] = divisor.Exponents[ii]
+	}
+
+	var quotient Polynomial
+	for _, monomial := range p.Monomials {
+		monomialOut := Monomial{Coefficient: monomial.Coefficient, VariableFactors: []Variable{}, Exponents: []int{}}
+		for ii, v := range monomial.VariableFactors {
+			exponent := monomial.Exponents[ii] - exponentOf[v.ID]
+			if exponent != 0 {
+				monomialOut.VariableFactors = append(monomialOut.VariableFactors, v)
+				monomialOut.Exponents = append(monomialOut.Exponents, exponent)
+			}
+		}
+		quotient.Monomials = append(quotient.Monomials, monomialOut)
+	}
+
+	return canonicalPolynomial(quotient)
+}
+
+// scalePolynomial Returns a copy of the polynomial p with each coefficient multiplied by scale.
+func scalePolynomial(p Polynomial, scale float64) Polynomial {
+	pOut := p.Copy()
+	for ii := range pOut.Monomials {
+		pOut.Monomials[ii].Coefficient *= scale
+	}
+	return pOut
+}
+
+// proportionalityConstant Returns c and true if the canonical polynomial numerator
+// is equal to c * denominator (up to rounding errors).
+func proportionalityConstant(numerator, denominator Polynomial) (float64, bool) {
+	if len(numerator.Monomials) != len(denominator.Monomials) {
+		return 0.0, false
+	}
+
+	coefficientOf := make(map[MonomialKey]float64)
+	for _, monomial := range numerator.Monomials {
+		coefficientOf[monomial.Key()] = monomial.Coefficient
+	}
+
+	lead := denominator.Monomials[0]
+	ratio := coefficientOf[lead.Key()] / lead.Coefficient
+	for _, monomial := range denominator.Monomials {
+		coefficient, found := coefficientOf[monomial.Key()]
+		if !found || math.Abs(coefficient-ratio*monomial.Coefficient) > 1e-12*math.Max(1.0, math.Abs(coefficient)) {
+			return 0.0, false
+		}
+	}
+	return ratio, true
+}
+
+// ==============
+// Member Methods
+// ==============
+
+// Check Verifies that the numerator and denominator are well formed and that the
+// denominator is not zero.
+func (re RationalExpression) Check() error {
+	// Check the numerator and the denominator
+	err := re.Numerator.Check()
+	if err != nil {
+		return smErrors.Errorf("error in the numerator of the rational expression: %v", err)
+	}
+
+	err = re.Denominator.Check()
+	if err != nil {
+		return smErrors.Errorf("error in the denominator of the rational expression: %v", err)
+	}
+
+	// Check that the denominator is not zero
+	if isConstantWithValue(re.Denominator, 0.0) {
+		return smErrors.DivisionByZeroError{
+			Operation: "RationalExpression",
+			Numerator: re.Numerator,
+		}
+	}
+
+	// All checks passed
+	return nil
+}
+
+// Variables Returns the unique variables in the numerator and the denominator.
+func (re RationalExpression) Variables() []Variable {
+	return UniqueVars(
+		append(re.Numerator.Variables(), re.Denominator.Variables()...),
+	)
+}
+
+// Dims The scalar rational expression should have dimensions [1,1].
+func (re RationalExpression) Dims() []int {
+	return []int{1, 1}
+}
+
+// Constant Returns the constant additive value in the expression.
+// A rational expression is not expanded into a sum, so this is always 0.
+func (re RationalExpression) Constant() float64 {
+	return 0.0
+}
+
+// LinearCoeff Panics, because a rational expression is not linear.
+func (re RationalExpression) LinearCoeff(wrt ...[]Variable) mat.VecDense {
+	panic(
+		smErrors.LinearExpressionRequiredError{
+			Operation:  "LinearCoeff",
+			Expression: re,
+		},
+	)
+}
+
+// QuadraticRepresentation Panics, because a rational expression is not quadratic.
+func (re RationalExpression) QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64) {
+	panic(
+		smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticRepresentation",
+			Expression: re,
+		},
+	)
+}
+
+// Plus Defines an addition between the rational expression and another expression.
+func (re RationalExpression) Plus(rightIn interface{}) Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case float64:
+		return re.Plus(K(right))
+	case int:
+		return re.Plus(K(float64(right)))
+	case K, Variable, Monomial, Polynomial:
+		// n/d + p = (n + p d)/d
+		numerator := re.Numerator.Plus(right.(ScalarExpression).Multiply(re.Denominator))
+		return newRationalExpression(numerator.(ScalarExpression), re.Denominator)
+	case RationalExpression:
+		if scalarsAreEqual(re.Denominator, right.Denominator, 0.0) {
+			numerator := re.Numerator.Plus(right.Numerator)
+			return newRationalExpression(numerator.(ScalarExpression), re.Denominator)
+		}
+
+		// n1/d1 + n2/d2 = (n1 d2 + n2 d1)/(d1 d2)
+		numerator := re.Numerator.Multiply(right.Denominator).Plus(right.Numerator.Multiply(re.Denominator))
+		denominator := re.Denominator.Multiply(right.Denominator)
+		return newRationalExpression(numerator.(ScalarExpression), denominator.(ScalarExpression))
+	case FunctionExpression:
+		return sumOf(re, right)
+	case mat.VecDense:
+		return re.Plus(VecDenseToKVector(right))
+	case *mat.VecDense:
+		return re.Plus(VecDenseToKVector(*right))
+	case mat.Dense:
+		return re.Plus(DenseToKMatrix(right))
+	case *mat.Dense:
+		return re.Plus(DenseToKMatrix(*right))
+	case VectorExpression, MatrixExpression:
+		return elementwise(right.(Expression), func(se ScalarExpression) ScalarExpression {
+			return re.Plus(se).(ScalarExpression)
+		})
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "RationalExpression.Plus",
+			Input:        rightIn,
+		},
+	)
+}
+
+// Minus Defines a subtraction between the rational expression and another expression.
+func (re RationalExpression) Minus(rightIn interface{}) Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+
+		// Use Expression's Minus() method
+		return Minus(re, rightAsE)
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case int:
+		return re.Minus(K(float64(right)))
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "RationalExpression.Minus",
+			Input:        rightIn,
+		},
+	)
+}
+
+// Multiply Defines a multiplication between the rational expression and another expression.
+func (re RationalExpression) Multiply(rightIn interface{}) Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case float64:
+		return re.Multiply(K(right))
+	case int:
+		return re.Multiply(K(float64(right)))
+	case K, Variable, Monomial, Polynomial:
+		numerator := re.Numerator.Multiply(right)
+		return newRationalExpression(numerator.(ScalarExpression), re.Denominator)
+	case RationalExpression:
+		numerator := re.Numerator.Multiply(right.Numerator)
+		denominator := re.Denominator.Multiply(right.Denominator)
+		return newRationalExpression(numerator.(ScalarExpression), denominator.(ScalarExpression))
+	case FunctionExpression:
+		return productOf(re, right)
+	case mat.VecDense:
+		return re.Multiply(VecDenseToKVector(right))
+	case *mat.VecDense:
+		return re.Multiply(VecDenseToKVector(*right))
+	case mat.Dense:
+		return re.Multiply(DenseToKMatrix(right))
+	case *mat.Dense:
+		return re.Multiply(DenseToKMatrix(*right))
+	case VectorExpression, MatrixExpression:
+		return elementwise(right.(Expression), func(se ScalarExpression) ScalarExpression {
+			return re.Multiply(se).(ScalarExpression)
+		})
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "RationalExpression.Multiply",
+			Input:        rightIn,
+		},
+	)
+}
+
+// Divide Computes the ratio of the rational expression and another scalar expression.
+func (re RationalExpression) Divide(rightIn interface{}) Expression {
+	return ScalarDivideTemplate(re, rightIn)
+}
+
+// Transpose The transpose of a scalar is the same scalar.
+func (re RationalExpression) Transpose() Expression {
+	return re
+}
+
+// LessEq Creates a less than equal constraint between the rational expression and another expression.
+func (re RationalExpression) LessEq(rightIn interface{}) Constraint {
+	return re.Comparison(rightIn, SenseLessThanEqual)
+}
+
+// GreaterEq Creates a greater than equal constraint between the rational expression and another expression.
+func (re RationalExpression) GreaterEq(rightIn interface{}) Constraint {
+	return re.Comparison(rightIn, SenseGreaterThanEqual)
+}
+
+// Eq Creates an equality constraint between the rational expression and another expression.
+func (re RationalExpression) Eq(rightIn interface{}) Constraint {
+	return re.Comparison(rightIn, SenseEqual)
+}
+
+// Between returns the range constraint lower <= re <= upper.
+func (re RationalExpression) Between(lower, upper interface{}) Constraint {
+	return newScalarRangeConstraint(re, lower, upper)
+}
+
+// Comparison Creates a constraint between the rational expression and another scalar expression
+// of the sense provided in sense.
+func (re RationalExpression) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if IsExpression(rightIn) {
+		rightAsE, _ := ToExpression(rightIn)
+		err = rightAsE.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// Algorithm
+	switch right := rightIn.(type) {
+	case float64:
+		return re.Comparison(K(right), sense)
+	case int:
+		return re.Comparison(K(float64(right)), sense)
+	case ScalarExpression:
+		return ScalarConstraint{re, right, sense}
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "RationalExpression.Comparison (" + sense.String() + ")",
+			Input:        rightIn,
+		},
+	)
+}
+
+// DerivativeWrt Computes the derivative of the rational expression with respect to vIn
+// using the quotient rule: (n/d)' = (n' d - n d')/d^2.
+func (re RationalExpression) DerivativeWrt(vIn Variable) Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = vIn.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	if foundIndex, _ := FindInSlice(vIn, re.Variables()); foundIndex == -1 {
+		return K(0.0)
+	}
+
+	numeratorDerivative := re.Numerator.DerivativeWrt(vIn)
+	denominatorDerivative := re.Denominator.DerivativeWrt(vIn)
+
+	numerator := numeratorDerivative.Multiply(re.Denominator).Minus(
+		re.Numerator.Multiply(denominatorDerivative),
+	)
+	denominator := re.Denominator.Multiply(re.Denominator)
+
+	return newRationalExpression(numerator.(ScalarExpression), denominator.(ScalarExpression))
+}
+
+// String Returns a string representation of the rational expression (e.g., "x_0/(1 + x_1)").
+func (re RationalExpression) String() string {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return fmt.Sprintf("%v/(%v)", stringAsFactor(re.Numerator), re.Denominator)
+}
+
+// Substitute Replaces the variable vIn with the expression seIn in the numerator
+// and the denominator.
+func (re RationalExpression) Substitute(vIn Variable, seIn ScalarExpression) Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = vIn.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = seIn.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return ScalarDivideTemplate(
+		re.Numerator.Substitute(vIn, seIn).(ScalarExpression),
+		re.Denominator.Substitute(vIn, seIn),
+	)
+}
+
+// SubstituteAccordingTo Replaces the variables in the map with the corresponding expressions
+// in the numerator and the denominator.
+func (re RationalExpression) SubstituteAccordingTo(subMap map[Variable]Expression) Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = CheckSubstitutionMap(subMap)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return ScalarDivideTemplate(
+		re.Numerator.SubstituteAccordingTo(subMap).(ScalarExpression),
+		re.Denominator.SubstituteAccordingTo(subMap),
+	)
+}
+
+// Power Computes the power of the rational expression.
+// Negative exponents invert the rational expression (e.g., (n/d)^-2 = d^2/n^2).
+func (re RationalExpression) Power(exponent int) Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	if exponent < 0 {
+		return scalarReciprocalPower(re, exponent)
+	}
+
+	return newRationalExpression(
+		re.Numerator.Power(exponent).(ScalarExpression),
+		re.Denominator.Power(exponent).(ScalarExpression),
+	)
+}
+
+// At Returns the value at the given row and column index.
+// Note:
+//
+// For a scalar, the indices should always be 0.
+func (re RationalExpression) At(ii, jj int) ScalarExpression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check to see whether or not the index is valid.
+	err = smErrors.CheckIndexOnMatrix(ii, jj, re)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return re
+}
+
+// AsSimplifiedExpression Simplifies the rational expression by:
+// - Cancelling the monomial factors that are common to the numerator and denominator
+// (e.g., (x_0^2 x_1)/(x_0 + x_0^2) = (x_0 x_1)/(1 + x_0)),
+// - Scaling the numerator and denominator so that the leading coefficient of the
+// denominator is 1, and
+// - Returning a polynomial-like expression when the denominator is a constant or when
+// the numerator is a constant multiple of the denominator.
+func (re RationalExpression) AsSimplifiedExpression() Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	numerator := canonicalPolynomial(re.Numerator)
+	denominator := canonicalPolynomial(re.Denominator)
+	if isConstantWithValue(numerator, 0.0) {
+		return K(0.0)
+	}
+
+	// Cancel the common monomial factors
+	common := commonMonomialFactor(
+		append(append([]Monomial{}, numerator.Monomials...), denominator.Monomials...),
+	)
+	numerator = divideByMonomial(numerator, common)
+	denominator = divideByMonomial(denominator, common)
+
+	// Normalize the denominator
+	lead := denominator.Monomials[0].Coefficient
+	numerator = scalePolynomial(numerator, 1.0/lead)
+	denominator = scalePolynomial(denominator, 1.0/lead)
+
+	if denominator.IsConstant() {
+		return numerator.AsSimplifiedExpression()
+	}
+
+	if ratio, isProportional := proportionalityConstant(numerator, denominator); isProportional {
+		return K(ratio)
+	}
+
+	return RationalExpression{Numerator: numerator, Denominator: denominator}
+}
+
+// Evaluate Returns the value of the rational expression when each variable takes the value given in values.
+// If one of the expression's variables is not in the map, then this panics with a MissingVariableValueError.
+func (re RationalExpression) Evaluate(values map[Variable]float64) float64 {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	checkValuesForEvaluate(re, values)
+
+	// Algorithm
+	return re.Numerator.Evaluate(values) / re.Denominator.Evaluate(values)
+}
+
+// EvaluateAt Returns the value of the rational expression at the point x.
+func (re RationalExpression) EvaluateAt(x mat.VecDense, wrt ...[]Variable) float64 {
+	return re.Evaluate(valueMapForEvaluateAt(re, x, wrt))
+}
+
+// Equals Returns true if the rational expression and other represent the same expression,
+// i.e., if n1 d2 = n2 d1 (where the coefficients of matching terms may differ by at most tol).
+func (re RationalExpression) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(re, other, tol)
+}
+
+// Canonicalize Returns the simplified rational expression with its numerator and
+// denominator in canonical form.
+func (re RationalExpression) Canonicalize() Expression {
+	// Input Processing
+	err := re.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	simplified, isRE := re.AsSimplifiedExpression().(RationalExpression)
+	if !isRE {
+		return re.AsSimplifiedExpression().Canonicalize()
+	}
+
+	return RationalExpression{
+		Numerator:   canonicalPolynomial(simplified.Numerator),
+		Denominator: canonicalPolynomial(simplified.Denominator),
+	}
+}
@@ -0,0 +1,353 @@
+package render
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+latex.go
+Description:
+
+	Defines the LaTeX function, which typesets expressions and constraints.
+	For example, the polynomial 3 x_0^2 x_1 - x_1 + 2 is rendered as
+	"3 x_{0}^{2} x_{1} - x_{1} + 2", vectors and matrices are rendered with the
+	bmatrix environment and constraints use \le, \ge and = (range constraints are
+	rendered as lower \le expression \le upper, with infinite bounds shown as
+	\infty, and second-order cone constraints as \left\| vector \right\|_{2} \le bound).
+*/
+
+// Options controls how expressions are rendered by LaTeX.
+// The zero value renders coefficients with the shortest representation that
+// represents them exactly and uses the Name of each variable.
+type Options struct {
+	// Precision is the number of significant digits used for coefficients
+	// (0 means the shortest exact representation).
+	Precision int
+
+	// UseIDs renders each variable as x_{ID} instead of using its Name.
+	UseIDs bool
+}
+
+// LaTeX Returns the LaTeX representation of e, which must be one of the
+// expressions of the symbolic package (scalar, vector or matrix) or a constraint.
+// At most one Options value may be given.
+func LaTeX(e interface{}, options ...Options) string {
+	// Input Processing
+	var opts Options
+	switch len(options) {
+	case 0:
+		// Use the default options
+	case 1:
+		opts = options[0]
+	default:
+		panic(fmt.Errorf("render.LaTeX: expected at most one Options value; received %v", len(options)))
+	}
+
+	if opts.Precision < 0 {
+		panic(fmt.Errorf("render.LaTeX: the precision must be non-negative; received %v", opts.Precision))
+	}
+
+	// Algorithm
+	switch concrete := e.(type) {
+	case symbolic.ScalarRangeConstraint:
+		err := concrete.Check()
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf(
+			`%v \le %v \le %v`,
+			opts.bound(concrete.Lower()),
+			opts.expression(concrete.Expression),
+			opts.bound(concrete.Upper()),
+		)
+	case symbolic.VectorRangeConstraint:
+		err := concrete.Check()
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf(
+			`%v \le %v \le %v`,
+			opts.bound(concrete.Lower()),
+			opts.expression(concrete.Expression),
+			opts.bound(concrete.Upper()),
+		)
+	case symbolic.SecondOrderConeConstraint:
+		err := concrete.Check()
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf(
+			`\left\| %v \right\|_{2} \le %v`,
+			opts.expression(concrete.Vector),
+			opts.expression(concrete.Bound),
+		)
+	case symbolic.Constraint:
+		err := concrete.Check()
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf(
+			"%v %v %v",
+			opts.expression(concrete.Left()),
+			senseLaTeX(concrete.ConstrSense()),
+			opts.expression(concrete.Right()),
+		)
+	case symbolic.Expression:
+		err := concrete.Check()
+		if err != nil {
+			panic(err)
+		}
+		return opts.expression(concrete)
+	default:
+		panic(smErrors.UnsupportedInputError{FunctionName: "render.LaTeX", Input: e})
+	}
+}
+
+// senseLaTeX Returns the LaTeX relation symbol of the constraint sense.
+func senseLaTeX(sense symbolic.ConstrSense) string {
+	switch sense {
+	case symbolic.SenseLessThanEqual:
+		return `\le`
+	case symbolic.SenseGreaterThanEqual:
+		return `\ge`
+	default:
+		return "="
+	}
+}
+
+// bound Renders a bound of a range constraint, where the values at or beyond
+// symbolic.Infinity (the sides that are not constrained) are rendered as \infty.
+func (opts Options) bound(b symbolic.Expression) string {
+	element := func(value symbolic.K) string {
+		switch {
+		case value >= symbolic.Infinity:
+			return `\infty`
+		case value <= -symbolic.Infinity:
+			return `-\infty`
+		default:
+			return opts.number(float64(value))
+		}
+	}
+
+	switch concrete := b.(type) {
+	case symbolic.K:
+		return element(concrete)
+	case symbolic.KVector:
+		var rows []string
+		for _, value := range concrete {
+			rows = append(rows, element(value))
+		}
+		return bmatrix(rows)
+	default:
+		return opts.expression(b)
+	}
+}
+
+// expression Renders a scalar, vector or matrix expression.
+func (opts Options) expression(e symbolic.Expression) string {
+	switch concrete := e.(type) {
+	case symbolic.ScalarExpression:
+		return opts.scalar(concrete)
+	case symbolic.VectorExpression:
+		var rows []string
+		for ii := 0; ii < concrete.Len(); ii++ {
+			rows = append(rows, opts.scalar(concrete.AtVec(ii)))
+		}
+		return bmatrix(rows)
+	case symbolic.MatrixExpression:
+		dims := concrete.Dims()
+		var rows []string
+		for ii := 0; ii < dims[0]; ii++ {
+			var row []string
+			for jj := 0; jj < dims[1]; jj++ {
+				row = append(row, opts.scalar(concrete.At(ii, jj)))
+			}
+			rows = append(rows, strings.Join(row, " & "))
+		}
+		return bmatrix(rows)
+	default:
+		panic(smErrors.UnsupportedInputError{FunctionName: "render.LaTeX", Input: e})
+	}
+}
+
+// bmatrix Wraps the (already rendered) rows in a bmatrix environment.
+func bmatrix(rows []string) string {
+	return `\begin{bmatrix} ` + strings.Join(rows, ` \\ `) + ` \end{bmatrix}`
+}
+
+// scalar Renders a scalar expression as a sum of monomials.
+func (opts Options) scalar(se symbolic.ScalarExpression) string {
+	var monomials []symbolic.Monomial
+	switch concrete := se.(type) {
+	case symbolic.K:
+		return opts.number(float64(concrete))
+	case symbolic.Variable:
+		return opts.variable(concrete)
+	case symbolic.Monomial:
+		monomials = []symbolic.Monomial{concrete}
+	case symbolic.Polynomial:
+		monomials = concrete.Monomials
+	case symbolic.RationalExpression:
+		return `\frac{` + opts.scalar(concrete.Numerator) + `}{` + opts.scalar(concrete.Denominator) + `}`
+	case symbolic.FunctionExpression:
+		return opts.function(concrete)
+	default:
+		panic(smErrors.UnsupportedInputError{FunctionName: "render.LaTeX", Input: se})
+	}
+
+	out := ""
+	for ii, m := range monomials {
+		switch {
+		case ii == 0 && m.Coefficient < 0:
+			out += "-"
+		case ii > 0 && m.Coefficient < 0:
+			out += " - "
+		case ii > 0:
+			out += " + "
+		}
+
+		absM := m.Copy()
+		absM.Coefficient = math.Abs(absM.Coefficient)
+		out += opts.monomial(absM)
+	}
+
+	return out
+}
+
+// function Renders a function expression, e.g., "x_{0} \sin\left(x_{1}\right)".
+func (opts Options) function(fe symbolic.FunctionExpression) string {
+	switch fe.Function {
+	case symbolic.FunctionSum:
+		out := ""
+		for ii, argument := range fe.Arguments {
+			term := opts.scalar(argument)
+			switch {
+			case ii > 0 && strings.HasPrefix(term, "-"):
+				out += " - " + strings.TrimPrefix(term, "-")
+			case ii > 0:
+				out += " + " + term
+			default:
+				out += term
+			}
+		}
+		return out
+	case symbolic.FunctionProduct:
+		var (
+			sign    string
+			factors []string
+		)
+		for ii, argument := range fe.Arguments {
+			if coefficient, tf := argument.(symbolic.K); ii == 0 && tf && coefficient == -1 {
+				sign = "-"
+				continue
+			}
+
+			factor := opts.scalar(argument)
+			if isSum(argument) {
+				factor = `\left(` + factor + `\right)`
+			}
+			factors = append(factors, factor)
+		}
+		return sign + strings.Join(factors, " ")
+	case symbolic.FunctionReciprocal:
+		return `\frac{1}{` + opts.scalar(fe.Arguments[0]) + `}`
+	case symbolic.FunctionSqrt:
+		return `\sqrt{` + opts.scalar(fe.Arguments[0]) + `}`
+	case symbolic.FunctionAbs:
+		return `\left|` + opts.scalar(fe.Arguments[0]) + `\right|`
+	case symbolic.FunctionSign:
+		return `\operatorname{sign}\left(` + opts.scalar(fe.Arguments[0]) + `\right)`
+	default:
+		return `\` + string(fe.Function) + `\left(` + opts.scalar(fe.Arguments[0]) + `\right)`
+	}
+}
+
+// isSum Returns true if the scalar expression has more than one term
+// (and so must be wrapped in parentheses when it is used as a factor).
+func isSum(se symbolic.ScalarExpression) bool {
+	switch concrete := se.(type) {
+	case symbolic.Polynomial:
+		return len(concrete.Monomials) > 1
+	case symbolic.FunctionExpression:
+		return concrete.Function == symbolic.FunctionSum
+	}
+	return false
+}
+
+// monomial Renders a monomial with a non-negative coefficient,
+// e.g., "3 x_{0}^{2} x_{1}".
+func (opts Options) monomial(m symbolic.Monomial) string {
+	var parts []string
+	if m.Coefficient != 1 || len(m.VariableFactors) == 0 {
+		parts = append(parts, opts.number(m.Coefficient))
+	}
+
+	for ii, v := range m.VariableFactors {
+		factor := opts.variable(v)
+		if m.Exponents[ii] != 1 {
+			factor += fmt.Sprintf("^{%v}", m.Exponents[ii])
+		}
+		parts = append(parts, factor)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// latexEscaper Escapes the characters that have a special meaning in LaTeX.
+var latexEscaper = strings.NewReplacer(
+	`\`, `\backslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`_`, `\_`,
+	`%`, `\%`,
+	`&`, `\&`,
+	`#`, `\#`,
+	`$`, `\$`,
+	`^`, `\text{\textasciicircum}`,
+	`~`, `\text{\textasciitilde}`,
+)
+
+// variable Renders a variable. Names like "x_12" are rendered with a subscript
+// ("x_{12}") and multi-letter names are rendered upright. The special characters
+// of LaTeX in the name and the subscript (e.g., "%" or "{") are escaped.
+func (opts Options) variable(v symbolic.Variable) string {
+	if opts.UseIDs || v.Name == "" {
+		return fmt.Sprintf("x_{%v}", v.ID)
+	}
+
+	base, subscript, hasSubscript := strings.Cut(v.Name, "_")
+	isMultiLetter := len([]rune(base)) > 1
+	base = latexEscaper.Replace(base)
+	if isMultiLetter {
+		base = `\mathrm{` + base + `}`
+	}
+
+	if !hasSubscript {
+		return base
+	}
+	return base + "_{" + latexEscaper.Replace(subscript) + "}"
+}
+
+// number Renders a number with the requested precision, using
+// "a \times 10^{b}" instead of the exponent notation of Go.
+func (opts Options) number(value float64) string {
+	precision := opts.Precision
+	if precision == 0 {
+		precision = -1
+	}
+
+	s := strconv.FormatFloat(value, 'g', precision, 64)
+	mantissa, exponent, hasExponent := strings.Cut(s, "e")
+	if !hasExponent {
+		return s
+	}
+
+	exponentValue, _ := strconv.Atoi(exponent)
+	return fmt.Sprintf(`%v \times 10^{%v}`, mantissa, exponentValue)
+}
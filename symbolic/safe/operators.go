@@ -0,0 +1,190 @@
+package safe
+
+import (
+	"errors"
+	"runtime"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+operators.go
+Description:
+
+	Defines non-panicking versions of the operators of the symbolic package.
+	Each function calls the corresponding method of the symbolic package and,
+	instead of panicking, returns the error that the method would have panicked
+	with (e.g., a smErrors.MatrixDimensionError or a smErrors.NegativeExponentError).
+	This is meant for long-running programs that build expressions from
+	user input. Only the errors that the symbolic package panics with on purpose
+	(the smErrors types, including smErrors.SymbolicError) and the mat.Error
+	dimension errors of gonum are returned; any other panic (e.g., a runtime.Error
+	raised by a bug or an error raised by another package) is propagated.
+*/
+
+// Plus Returns left + right (see symbolic.Expression.Plus).
+func Plus(left symbolic.Expression, right interface{}) (symbolic.Expression, error) {
+	return call("Plus", left, func() symbolic.Expression {
+		return left.Plus(right)
+	})
+}
+
+// Minus Returns left - right (see symbolic.Expression.Minus).
+func Minus(left symbolic.Expression, right interface{}) (symbolic.Expression, error) {
+	return call("Minus", left, func() symbolic.Expression {
+		return left.Minus(right)
+	})
+}
+
+// Multiply Returns left * right (see symbolic.Expression.Multiply).
+func Multiply(left symbolic.Expression, right interface{}) (symbolic.Expression, error) {
+	return call("Multiply", left, func() symbolic.Expression {
+		return left.Multiply(right)
+	})
+}
+
+// Power Returns e raised to the given exponent (see symbolic.Expression.Power).
+func Power(e symbolic.Expression, exponent int) (symbolic.Expression, error) {
+	return call("Power", e, func() symbolic.Expression {
+		return e.Power(exponent)
+	})
+}
+
+// Transpose Returns the transpose of e (see symbolic.Expression.Transpose).
+func Transpose(e symbolic.Expression) (symbolic.Expression, error) {
+	return call("Transpose", e, func() symbolic.Expression {
+		return e.Transpose()
+	})
+}
+
+// Substitute Returns e with the variable v replaced by replacement
+// (see symbolic.Expression.Substitute).
+func Substitute(e symbolic.Expression, v symbolic.Variable, replacement symbolic.ScalarExpression) (symbolic.Expression, error) {
+	return call("Substitute", e, func() symbolic.Expression {
+		return e.Substitute(v, replacement)
+	})
+}
+
+// SubstituteAccordingTo Returns e with each variable of subMap replaced by the
+// corresponding expression (see symbolic.Expression.SubstituteAccordingTo).
+func SubstituteAccordingTo(e symbolic.Expression, subMap map[symbolic.Variable]symbolic.Expression) (symbolic.Expression, error) {
+	err := symbolic.CheckSubstitutionMap(subMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return call("SubstituteAccordingTo", e, func() symbolic.Expression {
+		return e.SubstituteAccordingTo(subMap)
+	})
+}
+
+// DerivativeWrt Returns the derivative of e with respect to v
+// (see symbolic.Expression.DerivativeWrt).
+func DerivativeWrt(e symbolic.Expression, v symbolic.Variable) (symbolic.Expression, error) {
+	return call("DerivativeWrt", e, func() symbolic.Expression {
+		return e.DerivativeWrt(v)
+	})
+}
+
+// Comparison Returns the constraint "left sense right" (see symbolic.Expression.Comparison).
+func Comparison(left symbolic.Expression, right interface{}, sense symbolic.ConstrSense) (symbolic.Constraint, error) {
+	err := sense.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	return call("Comparison", left, func() symbolic.Constraint {
+		return left.Comparison(right, sense)
+	})
+}
+
+// LessEq Returns the constraint left <= right.
+func LessEq(left symbolic.Expression, right interface{}) (symbolic.Constraint, error) {
+	return Comparison(left, right, symbolic.SenseLessThanEqual)
+}
+
+// GreaterEq Returns the constraint left >= right.
+func GreaterEq(left symbolic.Expression, right interface{}) (symbolic.Constraint, error) {
+	return Comparison(left, right, symbolic.SenseGreaterThanEqual)
+}
+
+// Eq Returns the constraint left == right.
+func Eq(left symbolic.Expression, right interface{}) (symbolic.Constraint, error) {
+	return Comparison(left, right, symbolic.SenseEqual)
+}
+
+// call Checks the receiver e and then runs op, converting a panic raised by op
+// into an error. Errors raised by the symbolic package are returned unchanged so
+// that their type (e.g., smErrors.MatrixDimensionError) can be inspected; all other
+// panics (e.g., a runtime.Error) are raised again.
+func call[T any](operation string, e symbolic.Expression, op func() T) (out T, err error) {
+	// Input Processing
+	if e == nil {
+		return out, smErrors.UnsupportedInputError{FunctionName: "safe." + operation, Input: e}
+	}
+
+	err = e.Check()
+	if err != nil {
+		return out, err
+	}
+
+	// Algorithm
+	defer func() {
+		if r := recover(); r != nil {
+			rAsError, isError := r.(error)
+			if !isError || !isSymbolicError(rAsError) {
+				panic(r)
+			}
+
+			var zero T
+			out = zero
+			err = rAsError
+		}
+	}()
+
+	return op(), nil
+}
+
+// isSymbolicError Returns true if err is one of the errors that the symbolic package
+// panics with on purpose: an error type of the smErrors package (the errors without a
+// more specific type are wrapped in a smErrors.SymbolicError) or a mat.Error, which
+// gonum panics with when the dimensions of the matrices of an operation do not match.
+// Runtime errors (even when wrapped) are never symbolic errors.
+func isSymbolicError(err error) bool {
+	var runtimeErr runtime.Error
+	if errors.As(err, &runtimeErr) {
+		return false
+	}
+
+	switch err.(type) {
+	case smErrors.SymbolicError,
+		smErrors.CanNotGetLinearCoeffOfConstantError,
+		smErrors.DivisionByZeroError,
+		smErrors.DomainError,
+		smErrors.DuplicateVariableError,
+		smErrors.EmptyLinearCoeffsError,
+		smErrors.EmptyMatrixError,
+		smErrors.EmptyVectorError,
+		smErrors.EqualityConstraintRequiredError,
+		smErrors.ExponentOverflowError,
+		smErrors.InequalityConstraintRequiredError,
+		smErrors.InvalidMatrixIndexError,
+		smErrors.InvalidVectorIndexError,
+		smErrors.LinearExpressionRequiredError,
+		smErrors.MatrixColumnMismatchError,
+		smErrors.MatrixDimensionError,
+		smErrors.MissingVariableValueError,
+		smErrors.NegativeExponentError,
+		smErrors.ParseError,
+		smErrors.QuadraticExpressionRequiredError,
+		smErrors.UnivariatePolynomialRequiredError,
+		smErrors.UnsupportedConstraintError,
+		smErrors.UnsupportedInputError,
+		smErrors.VectorDimensionError,
+		mat.Error:
+		return true
+	}
+	return false
+}
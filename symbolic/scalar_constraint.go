@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -32,6 +30,19 @@ func (sc ScalarConstraint) IsLinear() bool {
 	return IsLinear(sc.RightHandSide) && IsLinear(sc.LeftHandSide)
 }
 
+// IsConvex Describes whether the set of points that satisfy the given scalar
+// constraint is convex (according to the curvature of its left hand side minus its right hand side).
+func (sc ScalarConstraint) IsConvex() bool {
+	// Input Processing
+	err := sc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return constraintIsConvex(sc)
+}
+
 // Simplify Moves all of the variables of the ScalarConstraint to its
 // left hand side.
 func (sc ScalarConstraint) Simplify() ScalarConstraint {
@@ -183,6 +194,48 @@ func (sc ScalarConstraint) LinearEqualityConstraintRepresentation(wrt ...[]Varia
 	return C, d
 }
 
+// QuadraticConstraintRepresentation Returns the quadratic representation of the scalar constraint.
+// Returns a tuple of the form (Q, c, b) where Q is a symmetric matrix, c is a vector and b is a constant such that:
+// 0.5 * x^T Q x + c^T x <= b (or == b, if the constraint is an equality constraint)
+func (sc ScalarConstraint) QuadraticConstraintRepresentation(wrt ...[]Variable) (Q mat.SymDense, c mat.VecDense, b float64) {
+	// Check that the constraint is well formed.
+	err := sc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check that the constraint is quadratic.
+	if !IsQuadratic(sc.LeftHandSide) {
+		panic(smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticConstraintRepresentation",
+			Expression: sc.LeftHandSide,
+		})
+	}
+
+	if !IsQuadratic(sc.RightHandSide) {
+		panic(smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticConstraintRepresentation",
+			Expression: sc.RightHandSide,
+		})
+	}
+
+	// Create Q, c and b from the difference of the two sides
+	newLHS := sc.Left().(ScalarExpression)
+	newLHS = newLHS.Minus(sc.Right()).(ScalarExpression)
+
+	Q, c, r := newLHS.QuadraticRepresentation(wrt...)
+	b = -r
+
+	if sc.Sense == SenseGreaterThanEqual {
+		Q.ScaleSym(-1, &Q)
+		c.ScaleVec(-1, &c)
+		b = -b
+	}
+
+	// Return the tuple
+	return Q, c, b
+}
+
 // Substitute Substitutes the variable vIn with the scalar expression seIn in the
 // given scalar constraint.
 func (sc ScalarConstraint) Substitute(vIn Variable, seIn ScalarExpression) Constraint {
@@ -379,13 +432,16 @@ func (sc ScalarConstraint) ImpliesThisIsAlsoSatisfied(other Constraint) bool {
 				panic("unreachable code reached in ScalarConstraint.ImpliesThisIsAlsoSatisfied")
 			}
 		}
-	case VectorConstraint, MatrixConstraint:
+	case ScalarRangeConstraint:
+		// Both halves of the range constraint must be implied.
+		return impliesAllOf(sc, otherC.AsScalarConstraints())
+	case VectorConstraint, MatrixConstraint, VectorRangeConstraint, SecondOrderConeConstraint:
 		// TODO: Implement more advanced implication checks.
 		return false
 	default:
 		// Other types of constraints are not currently supported.
 		panic(
-			fmt.Errorf("implication checking between ScalarConstraint and %T is not currently supported", other),
+			smErrors.Errorf("implication checking between ScalarConstraint and %T is not currently supported", other),
 		)
 	}
 
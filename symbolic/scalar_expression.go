@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -24,6 +22,10 @@ type ScalarExpression interface {
 	// LinearCoeff returns the coefficient of the linear terms in the expression
 	LinearCoeff(wrt ...[]Variable) mat.VecDense
 
+	// QuadraticRepresentation returns the matrix Q, vector c and constant r such that
+	// the expression equals 0.5 * x^T Q x + c^T x + r
+	QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64)
+
 	// Plus adds the current expression to another and returns the resulting
 	// expression
 	Plus(rightIn interface{}) Expression
@@ -48,6 +50,10 @@ type ScalarExpression interface {
 	// Compares the receiver expression rhs with the expression rhs in the sense of sense.
 	Comparison(rhsIn interface{}, sense ConstrSense) Constraint
 
+	// Between returns the range constraint lower <= expression <= upper
+	// (a ScalarRangeConstraint)
+	Between(lower, upper interface{}) Constraint
+
 	//Multiply
 	// Multiplies the given scalar expression with another expression
 	Multiply(rightIn interface{}) Expression
@@ -81,6 +87,20 @@ type ScalarExpression interface {
 	// AsSimplifiedExpression
 	// Simplifies the expression and returns the simplified version
 	AsSimplifiedExpression() Expression
+
+	// Equals returns true if the current expression and other represent the same
+	// expression (the coefficients of matching terms may differ by at most tol)
+	Equals(other Expression, tol float64) bool
+
+	// Canonicalize returns the expression in canonical form (see canonical.go)
+	Canonicalize() Expression
+
+	// Evaluate returns the value of the expression when each variable takes the value given in values
+	Evaluate(values map[Variable]float64) float64
+
+	// EvaluateAt returns the value of the expression at the point x, where the (ii)th element of x
+	// is the value of the (ii)th variable in wrt
+	EvaluateAt(x mat.VecDense, wrt ...[]Variable) float64
 }
 
 // NewExpr returns a new expression with a single additive constant value, c,
@@ -106,6 +126,10 @@ func IsScalarExpression(e interface{}) bool {
 		return true
 	case Polynomial:
 		return true
+	case RationalExpression:
+		return true
+	case FunctionExpression:
+		return true
 	default:
 		return false
 
@@ -117,7 +141,7 @@ func IsScalarExpression(e interface{}) bool {
 func ToScalarExpression(e interface{}) (ScalarExpression, error) {
 	// Input Processing
 	if !IsScalarExpression(e) {
-		return K(1.0), fmt.Errorf(
+		return K(1.0), smErrors.Errorf(
 			"the input interface is of type %T, which is not recognized as a ScalarExpression.",
 			e,
 		)
@@ -135,8 +159,12 @@ func ToScalarExpression(e interface{}) (ScalarExpression, error) {
 		return e2, nil
 	case Polynomial:
 		return e2, nil
+	case RationalExpression:
+		return e2, nil
+	case FunctionExpression:
+		return e2, nil
 	default:
-		return K(1.0), fmt.Errorf(
+		return K(1.0), smErrors.Errorf(
 			"unexpected scalar expression conversion requested for type %T!",
 			e,
 		)
@@ -0,0 +1,327 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ScalarRangeConstraint represents the two-sided constraint
+//
+//	LowerBound <= Expression <= UpperBound
+//
+// as a single constraint. A side can be left unbounded by setting its bound to
+// -Infinity (respectively, +Infinity).
+//
+// A range constraint does not have a single right hand side, so the functions that
+// handle constraints in general (e.g., VariablesInThisConstraint, ToScalarConstraints
+// and ToScalarRows) treat range constraints separately, using Lower and Upper.
+type ScalarRangeConstraint struct {
+	Expression ScalarExpression
+	LowerBound K
+	UpperBound K
+}
+
+// newScalarRangeConstraint Creates the range constraint lower <= se <= upper.
+// The bounds must be constants (float64, int or K).
+func newScalarRangeConstraint(se ScalarExpression, lower, upper interface{}) ScalarRangeConstraint {
+	// Input Processing
+	err := se.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	src := ScalarRangeConstraint{
+		Expression: se,
+		LowerBound: toRangeBound(lower),
+		UpperBound: toRangeBound(upper),
+	}
+
+	err = src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return src
+}
+
+// toRangeBound Converts the bound of a range constraint into a constant.
+func toRangeBound(bound interface{}) K {
+	switch concrete := bound.(type) {
+	case float64:
+		return K(concrete)
+	case int:
+		return K(float64(concrete))
+	case K:
+		return concrete
+	default:
+		panic(smErrors.UnsupportedInputError{FunctionName: "Between", Input: bound})
+	}
+}
+
+// shiftRangeBound Returns the bound of a range constraint shifted by the given amount.
+// Infinite bounds remain infinite.
+func shiftRangeBound(bound K, shift float64) K {
+	if bound <= -Infinity || bound >= Infinity {
+		return bound
+	}
+	return bound + K(shift)
+}
+
+// Left Returns the constrained expression of the range constraint.
+func (src ScalarRangeConstraint) Left() Expression {
+	return src.Expression
+}
+
+// Right Returns the bounds of the range constraint as the vector (LowerBound, UpperBound).
+// This is not a right hand side that can be compared with Left (e.g., Left().Minus(Right())
+// is meaningless); use Lower and Upper instead.
+func (src ScalarRangeConstraint) Right() Expression {
+	return KVector{src.LowerBound, src.UpperBound}
+}
+
+// Lower Returns the lower bound of the range constraint (-Infinity if it is unbounded below).
+func (src ScalarRangeConstraint) Lower() K {
+	return src.LowerBound
+}
+
+// Upper Returns the upper bound of the range constraint (+Infinity if it is unbounded above).
+func (src ScalarRangeConstraint) Upper() K {
+	return src.UpperBound
+}
+
+// ConstrSense Returns SenseRange. Use AsScalarConstraints to obtain the range constraint
+// as comparisons.
+func (src ScalarRangeConstraint) ConstrSense() ConstrSense {
+	return SenseRange
+}
+
+// Check Checks that the ScalarRangeConstraint is valid: its expression is well formed and
+// its lower bound is not greater than its upper bound.
+func (src ScalarRangeConstraint) Check() error {
+	// Check the expression
+	if src.Expression == nil {
+		return smErrors.Errorf("the expression of the range constraint is nil")
+	}
+
+	err := src.Expression.Check()
+	if err != nil {
+		return err
+	}
+
+	// Check the bounds
+	if src.LowerBound > src.UpperBound {
+		return smErrors.Errorf(
+			"the lower bound of the range constraint (%v) is greater than its upper bound (%v)",
+			src.LowerBound, src.UpperBound,
+		)
+	}
+
+	// All Checks Passed!
+	return nil
+}
+
+// IsLinear Describes whether the expression of the range constraint is linear or not.
+func (src ScalarRangeConstraint) IsLinear() bool {
+	return IsLinear(src.Expression)
+}
+
+// IsConvex Describes whether the set of points that satisfy the range constraint is
+// convex: each of its finite halves (see AsScalarConstraints) must be convex.
+func (src ScalarRangeConstraint) IsConvex() bool {
+	// Input Processing
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return constraintIsConvex(src)
+}
+
+// AsScalarConstraints Decomposes the range constraint into scalar constraints:
+// Expression >= LowerBound and Expression <= UpperBound (omitting the infinite bounds),
+// or the single constraint Expression = LowerBound when both bounds are equal.
+func (src ScalarRangeConstraint) AsScalarConstraints() []ScalarConstraint {
+	// Input Processing
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	if src.LowerBound == src.UpperBound {
+		return []ScalarConstraint{{src.Expression, src.LowerBound, SenseEqual}}
+	}
+
+	var out []ScalarConstraint
+	if src.LowerBound > -Infinity {
+		out = append(out, ScalarConstraint{src.Expression, src.LowerBound, SenseGreaterThanEqual})
+	}
+	if src.UpperBound < Infinity {
+		out = append(out, ScalarConstraint{src.Expression, src.UpperBound, SenseLessThanEqual})
+	}
+	return out
+}
+
+// LinearRangeRepresentation Returns the linear representation of the range constraint.
+// Returns a tuple of the form (A, lo, hi) where A is a vector and lo and hi are constants such that:
+// lo <= A.Dot(x) <= hi
+func (src ScalarRangeConstraint) LinearRangeRepresentation(wrt ...[]Variable) (A mat.VecDense, lo, hi float64) {
+	// Check that the constraint is well formed.
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check that the constraint is linear.
+	if !src.IsLinear() {
+		panic(smErrors.LinearExpressionRequiredError{
+			Operation:  "LinearRangeRepresentation",
+			Expression: src.Expression,
+		})
+	}
+
+	// Algorithm
+	A = src.Expression.LinearCoeff(wrt...)
+
+	constant := src.Expression.Constant()
+	lo = float64(shiftRangeBound(src.LowerBound, -constant))
+	hi = float64(shiftRangeBound(src.UpperBound, -constant))
+
+	return A, lo, hi
+}
+
+// Substitute Substitutes the variable vIn with the scalar expression seIn in the
+// given range constraint.
+func (src ScalarRangeConstraint) Substitute(vIn Variable, seIn ScalarExpression) Constraint {
+	// Check that the constraint is well formed.
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Return the new constraint
+	return ScalarRangeConstraint{
+		Expression: src.Expression.Substitute(vIn, seIn).(ScalarExpression),
+		LowerBound: src.LowerBound,
+		UpperBound: src.UpperBound,
+	}
+}
+
+// SubstituteAccordingTo Substitutes the variables in the map with the corresponding expressions
+// in the given range constraint.
+func (src ScalarRangeConstraint) SubstituteAccordingTo(subMap map[Variable]Expression) Constraint {
+	// Check that the constraint is well formed.
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Return the new constraint
+	return ScalarRangeConstraint{
+		Expression: src.Expression.SubstituteAccordingTo(subMap).(ScalarExpression),
+		LowerBound: src.LowerBound,
+		UpperBound: src.UpperBound,
+	}
+}
+
+// Simplify Moves the constant of the expression of the range constraint into its bounds.
+func (src ScalarRangeConstraint) Simplify() ScalarRangeConstraint {
+	// Check that the constraint is well formed.
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	constant := src.Expression.Constant()
+	if constant == 0 {
+		return src
+	}
+
+	return ScalarRangeConstraint{
+		Expression: src.Expression.Minus(constant).(ScalarExpression),
+		LowerBound: shiftRangeBound(src.LowerBound, -constant),
+		UpperBound: shiftRangeBound(src.UpperBound, -constant),
+	}
+}
+
+// AsSimplifiedConstraint Simplifies the constraint by moving the constant of its expression into its bounds.
+func (src ScalarRangeConstraint) AsSimplifiedConstraint() Constraint {
+	return src.Simplify()
+}
+
+// Variables returns all variables appearing in the range constraint.
+func (src ScalarRangeConstraint) Variables() []Variable {
+	return VariablesInThisConstraint(src)
+}
+
+// ImpliesThisIsAlsoSatisfied Returns true if this constraint implies that the other constraint is also satisfied.
+func (src ScalarRangeConstraint) ImpliesThisIsAlsoSatisfied(other Constraint) bool {
+	// Input Processing
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = other.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	switch otherC := other.(type) {
+	case ScalarConstraint:
+		// One of the halves of the range constraint must imply the other constraint.
+		for _, half := range src.AsScalarConstraints() {
+			if half.ImpliesThisIsAlsoSatisfied(otherC) {
+				return true
+			}
+		}
+	case ScalarRangeConstraint:
+		// If both constraints bound the same expression, then compare the bounds directly.
+		simplified, otherSimplified := src.Simplify(), otherC.Simplify()
+		difference := simplified.Expression.Minus(otherSimplified.Expression).(ScalarExpression)
+		if len(difference.Variables()) == 0 && difference.Constant() == 0 {
+			return otherSimplified.LowerBound <= simplified.LowerBound && simplified.UpperBound <= otherSimplified.UpperBound
+		}
+
+		// Otherwise, each half of the other constraint must be implied.
+		return impliesAllOf(src, otherC.AsScalarConstraints())
+	case VectorConstraint, MatrixConstraint, VectorRangeConstraint, SecondOrderConeConstraint:
+		// TODO: Implement more advanced implication checks.
+		return false
+	default:
+		// Other types of constraints are not currently supported.
+		panic(
+			smErrors.Errorf("implication checking between ScalarRangeConstraint and %T is not currently supported", other),
+		)
+	}
+
+	return false
+}
+
+// impliesAllOf Returns true if the constraint c implies each of the scalar constraints in others.
+func impliesAllOf(c Constraint, others []ScalarConstraint) bool {
+	for _, other := range others {
+		if !c.ImpliesThisIsAlsoSatisfied(other) {
+			return false
+		}
+	}
+	return true
+}
+
+// String Returns a string representation of the range constraint.
+func (src ScalarRangeConstraint) String() string {
+	// Check that the constraint is well formed.
+	err := src.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the string representation
+	return fmt.Sprintf("%v <= %v <= %v", src.LowerBound, src.Expression, src.UpperBound)
+}
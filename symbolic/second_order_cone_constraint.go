@@ -0,0 +1,285 @@
+package symbolic
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+// SecondOrderConeConstraint represents the second-order cone constraint
+//
+//	||Vector||_2 <= Bound
+//
+// which can not be written with a ConstrSense. When both expressions are linear, this is
+// the constraint ||A x + b||_2 <= c^T x + d (see LinearConeRepresentation).
+type SecondOrderConeConstraint struct {
+	Vector VectorExpression
+	Bound  ScalarExpression
+}
+
+// NewSecondOrderConeConstraint Creates the second-order cone constraint ||ve||_2 <= se.
+func NewSecondOrderConeConstraint(ve VectorExpression, se ScalarExpression) SecondOrderConeConstraint {
+	// Input Processing
+	soc := SecondOrderConeConstraint{Vector: ve, Bound: se}
+
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return soc
+}
+
+// NewRotatedSecondOrderConeConstraint Creates the rotated second-order cone constraint
+//
+//	||ve||_2^2 <= 2 y z,  y >= 0,  z >= 0
+//
+// which is returned as the equivalent (standard) second-order cone constraint
+// ||(sqrt(2) ve, y - z)||_2 <= y + z.
+func NewRotatedSecondOrderConeConstraint(ve VectorExpression, y, z ScalarExpression) SecondOrderConeConstraint {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = y.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = z.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	vector := VStack(ve.Multiply(math.Sqrt2), y.Minus(z)).(VectorExpression)
+	bound := y.Plus(z).(ScalarExpression)
+
+	return NewSecondOrderConeConstraint(vector, bound)
+}
+
+// Left Returns the vector expression whose norm is bounded by the constraint.
+func (soc SecondOrderConeConstraint) Left() Expression {
+	return soc.Vector
+}
+
+// Right Returns the bound of the norm of the vector expression.
+func (soc SecondOrderConeConstraint) Right() Expression {
+	return soc.Bound
+}
+
+// ConstrSense Returns the sense of the constraint (<=). Note that the constraint bounds
+// the norm of Left(), not Left() itself.
+func (soc SecondOrderConeConstraint) ConstrSense() ConstrSense {
+	return SenseLessThanEqual
+}
+
+// Check Checks that the SecondOrderConeConstraint is valid: both of its expressions
+// are given and well formed.
+func (soc SecondOrderConeConstraint) Check() error {
+	// Check the vector expression
+	if soc.Vector == nil {
+		return smErrors.Errorf("the vector expression of the second-order cone constraint is nil")
+	}
+
+	err := soc.Vector.Check()
+	if err != nil {
+		return err
+	}
+
+	// Check the bound
+	if soc.Bound == nil {
+		return smErrors.Errorf("the bound of the second-order cone constraint is nil")
+	}
+
+	err = soc.Bound.Check()
+	if err != nil {
+		return err
+	}
+
+	// All Checks Passed!
+	return nil
+}
+
+// IsLinear Returns false; a second-order cone constraint is not a linear constraint
+// (even when its expressions are linear).
+func (soc SecondOrderConeConstraint) IsLinear() bool {
+	return false
+}
+
+// IsConvex Describes whether the set of points that satisfy the constraint is convex:
+// the vector expression must be affine and its bound must be concave (according to Curvature).
+func (soc SecondOrderConeConstraint) IsConvex() bool {
+	// Input Processing
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return Curvature(soc.Vector) == CurvatureAffine && Curvature(soc.Bound).IsConcave()
+}
+
+// LinearConeRepresentation Returns the canonical representation of the constraint.
+// Returns a tuple of the form (A, b, c, d) where A is a matrix, b and c are vectors and d
+// is a constant such that:
+// ||A.Multiply(x) + b||_2 <= c.Dot(x) + d
+// When wrt is not given, x contains the variables of the constraint (see Variables).
+func (soc SecondOrderConeConstraint) LinearConeRepresentation(wrt ...[]Variable) (A mat.Dense, b mat.VecDense, c mat.VecDense, d float64) {
+	// Check that the constraint is well formed.
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check that the expressions are linear.
+	if !IsLinear(soc.Vector) {
+		panic(smErrors.LinearExpressionRequiredError{
+			Operation:  "LinearConeRepresentation",
+			Expression: soc.Vector,
+		})
+	}
+
+	if !IsLinear(soc.Bound) {
+		panic(smErrors.LinearExpressionRequiredError{
+			Operation:  "LinearConeRepresentation",
+			Expression: soc.Bound,
+		})
+	}
+
+	// Both sides must be written in terms of the same variables
+	if len(wrt) == 0 {
+		wrt = [][]Variable{soc.Variables()}
+	}
+
+	// Algorithm
+	A = soc.Vector.LinearCoeff(wrt...)
+	b = soc.Vector.Constant()
+	c = soc.Bound.LinearCoeff(wrt...)
+	d = soc.Bound.Constant()
+
+	return A, b, c, d
+}
+
+// Residual Returns ||Vector||_2 - Bound when each variable takes the value given in values.
+// The constraint is satisfied when the residual is not positive.
+func (soc SecondOrderConeConstraint) Residual(values map[Variable]float64) float64 {
+	// Input Processing
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	vector := soc.Vector.Evaluate(values)
+	return mat.Norm(&vector, 2) - soc.Bound.Evaluate(values)
+}
+
+// IsSatisfiedBy Returns true if the constraint is satisfied (up to the tolerance tol) when
+// each variable takes the value given in values.
+func (soc SecondOrderConeConstraint) IsSatisfiedBy(values map[Variable]float64, tol float64) bool {
+	return soc.Residual(values) <= tol
+}
+
+// Substitute Substitutes the variable vIn with the scalar expression seIn in the constraint.
+func (soc SecondOrderConeConstraint) Substitute(vIn Variable, seIn ScalarExpression) Constraint {
+	// Check that the constraint is well formed.
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Return the new constraint
+	return SecondOrderConeConstraint{
+		Vector: soc.Vector.Substitute(vIn, seIn).(VectorExpression),
+		Bound:  soc.Bound.Substitute(vIn, seIn).(ScalarExpression),
+	}
+}
+
+// SubstituteAccordingTo Substitutes the variables in the map with the corresponding expressions
+// in the constraint.
+func (soc SecondOrderConeConstraint) SubstituteAccordingTo(subMap map[Variable]Expression) Constraint {
+	// Check that the constraint is well formed.
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Return the new constraint
+	return SecondOrderConeConstraint{
+		Vector: soc.Vector.SubstituteAccordingTo(subMap).(VectorExpression),
+		Bound:  soc.Bound.SubstituteAccordingTo(subMap).(ScalarExpression),
+	}
+}
+
+// AsSimplifiedConstraint Simplifies both expressions of the constraint.
+func (soc SecondOrderConeConstraint) AsSimplifiedConstraint() Constraint {
+	// Check that the constraint is well formed.
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Return the new constraint
+	return SecondOrderConeConstraint{
+		Vector: soc.Vector.AsSimplifiedExpression().(VectorExpression),
+		Bound:  soc.Bound.AsSimplifiedExpression().(ScalarExpression),
+	}
+}
+
+// Variables Returns the unique variables of the constraint: the variables of the vector
+// expression, followed by any other variable of the bound.
+func (soc SecondOrderConeConstraint) Variables() []Variable {
+	// Input Processing
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return UniqueVars(append(soc.Vector.Variables(), soc.Bound.Variables()...))
+}
+
+// ImpliesThisIsAlsoSatisfied Returns true if this constraint implies that the other constraint is also satisfied.
+func (soc SecondOrderConeConstraint) ImpliesThisIsAlsoSatisfied(other Constraint) bool {
+	// Input Processing
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = other.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	switch other.(type) {
+	case ScalarConstraint, VectorConstraint, MatrixConstraint,
+		ScalarRangeConstraint, VectorRangeConstraint, SecondOrderConeConstraint:
+		// TODO: Implement implication checks for second-order cone constraints.
+		return false
+	default:
+		// Other types of constraints are not currently supported.
+		panic(
+			smErrors.Errorf("implication checking between SecondOrderConeConstraint and %T is not currently supported", other),
+		)
+	}
+}
+
+// String Returns a string representation of the constraint.
+func (soc SecondOrderConeConstraint) String() string {
+	// Check that the constraint is well formed.
+	err := soc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the string representation
+	return fmt.Sprintf("||%v||_2 <= %v", soc.Vector, soc.Bound)
+}
@@ -0,0 +1,288 @@
+package symbolic
+
+import (
+	"math"
+	"sort"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+standard_form.go
+Description:
+	Conversion of linear programs into the standard form
+
+		minimize   c^T z + Offset
+		subject to A z = b
+		           z >= 0
+
+	that is expected by many solvers (e.g., simplex methods), and conversion of the
+	solutions of the standard form back to the original variables.
+*/
+
+// StandardForm is a linear program in standard form (see ToStandardForm). The original
+// variables x are recovered from the standard form variables z with the affine map
+//
+//	x = Transformation z + Shift.
+type StandardForm struct {
+	C      mat.VecDense
+	Offset float64
+	A      mat.Dense
+	B      mat.VecDense
+
+	// Variables are the standard form variables z (the columns of A); the nonnegativity
+	// of z is implied by the standard form. SlackVariables are the variables of Variables
+	// that were introduced for inequality constraints and finite upper bounds.
+	Variables      []Variable
+	SlackVariables []Variable
+
+	// OriginalVariables are the variables of the original problem (the rows of Transformation).
+	OriginalVariables []Variable
+	Transformation    mat.Dense
+	Shift             mat.VecDense
+}
+
+// standardFormRow is a row of the constraints of the standard form (before the slack
+// variables are numbered): Coefficients z (+ s, if HasSlack) = Bound.
+type standardFormRow struct {
+	Coefficients []float64
+	HasSlack     bool
+	Bound        float64
+}
+
+// ToStandardForm Converts the linear program
+//
+//	minimize   objective
+//	subject to constraints
+//
+// (and the bounds of its variables, Variable.Lower and Variable.Upper) into standard form.
+// The new variables are created in the environment env:
+//   - each variable with a finite lower bound L is shifted: x = L + z (and a finite upper
+//     bound U becomes the row z + s = U - L),
+//   - each variable with only an upper bound U is mirrored: x = U - z,
+//   - each free variable (Lower == -Infinity and Upper == +Infinity) is split: x = z+ - z-,
+//   - each inequality constraint receives a slack variable s: a^T x + s = b, and
+//   - the nonnegativity constraints (x >= 0) become lower bounds instead of rows.
+//
+// The original variables are ordered by their IDs and the columns of each original
+// variable precede those of the next one (the slack variables come last).
+// The rows are scaled so that b >= 0. The integrality of binary and integer variables is
+// not represented (i.e., the standard form is the LP relaxation of the problem).
+// An error is returned when the objective or one of the constraints is not linear
+// (e.g., a second-order cone constraint), before any constraint is decomposed.
+func ToStandardForm(objective ScalarExpression, constraints []Constraint, env Environment) (StandardForm, error) {
+	// Input Processing
+	err := objective.Check()
+	if err != nil {
+		return StandardForm{}, err
+	}
+
+	if !IsLinear(objective) {
+		return StandardForm{}, smErrors.LinearExpressionRequiredError{
+			Operation:  "ToStandardForm",
+			Expression: objective,
+		}
+	}
+
+	for _, constraint := range constraints {
+		err = constraint.Check()
+		if err != nil {
+			return StandardForm{}, err
+		}
+
+		if !constraint.IsLinear() {
+			return StandardForm{}, smErrors.UnsupportedConstraintError{
+				Operation:  "ToStandardForm",
+				Constraint: constraint,
+			}
+		}
+	}
+
+	scalarConstraints, err := ToScalarConstraints(constraints)
+	if err != nil {
+		return StandardForm{}, err
+	}
+
+	originalVariables := objective.Variables()
+	for _, constraint := range scalarConstraints {
+		originalVariables = append(originalVariables, constraint.Variables()...)
+	}
+	originalVariables = UniqueVars(originalVariables)
+	sort.Slice(originalVariables, func(i, j int) bool {
+		return originalVariables[i].ID < originalVariables[j].ID
+	})
+	if len(originalVariables) == 0 {
+		return StandardForm{}, smErrors.EmptyVectorError{Expression: VariableVector(originalVariables)}
+	}
+
+	// Algorithm
+	// Collect the bounds of the original variables (tightened by the nonnegativity constraints)
+	indexOfID := make(map[uint64]int, len(originalVariables))
+	lower, upper := make([]float64, len(originalVariables)), make([]float64, len(originalVariables))
+	for ii, v := range originalVariables {
+		indexOfID[v.ID] = ii
+		lower[ii], upper[ii] = v.Lower, v.Upper
+	}
+
+	var rowConstraints []ScalarConstraint
+	for _, constraint := range scalarConstraints {
+		if constraint.IsNonnegativityConstraint() {
+			ii := indexOfID[constraint.Variables()[0].ID]
+			lower[ii] = math.Max(lower[ii], 0.0)
+			continue
+		}
+		rowConstraints = append(rowConstraints, constraint)
+	}
+
+	// Express each original variable as x = shift + sum of (+/-) z
+	type column struct {
+		Original int
+		Sign     float64
+	}
+	type upperBound struct {
+		Column int
+		Bound  float64
+	}
+	var columns []column
+	shift := make([]float64, len(originalVariables))
+	var upperBounds []upperBound
+	for ii, v := range originalVariables {
+		switch {
+		case lower[ii] > upper[ii]:
+			return StandardForm{}, smErrors.Errorf(
+				"the bounds of the variable %v are inconsistent: %v > %v", v, lower[ii], upper[ii],
+			)
+		case lower[ii] > float64(-Infinity):
+			shift[ii] = lower[ii]
+			columns = append(columns, column{ii, 1.0})
+			if upper[ii] < float64(+Infinity) {
+				upperBounds = append(upperBounds, upperBound{len(columns) - 1, upper[ii] - lower[ii]})
+			}
+		case upper[ii] < float64(+Infinity):
+			shift[ii] = upper[ii]
+			columns = append(columns, column{ii, -1.0})
+		default:
+			columns = append(columns, column{ii, 1.0}, column{ii, -1.0})
+		}
+	}
+	nColumns := len(columns)
+
+	// Build the rows of the constraints: a^T (shift + T z) (+ s) = b
+	var rows []standardFormRow
+	for _, constraint := range rowConstraints {
+		var a mat.VecDense
+		var b float64
+		if constraint.Sense == SenseEqual {
+			a, b = constraint.LinearEqualityConstraintRepresentation(originalVariables)
+		} else {
+			a, b = constraint.LinearInequalityConstraintRepresentation(originalVariables)
+		}
+
+		row := standardFormRow{
+			Coefficients: make([]float64, nColumns),
+			HasSlack:     constraint.Sense != SenseEqual,
+			Bound:        b - mat.Dot(&a, mat.NewVecDense(len(shift), shift)),
+		}
+		for jj, col := range columns {
+			row.Coefficients[jj] = col.Sign * a.AtVec(col.Original)
+		}
+		rows = append(rows, row)
+	}
+
+	for _, bound := range upperBounds {
+		coefficients := make([]float64, nColumns)
+		coefficients[bound.Column] = 1.0
+		rows = append(rows, standardFormRow{Coefficients: coefficients, HasSlack: true, Bound: bound.Bound})
+	}
+
+	// Create the standard form variables
+	sf := StandardForm{OriginalVariables: originalVariables}
+	for range columns {
+		sf.Variables = append(sf.Variables, NewContinuousVariable(env))
+	}
+	for _, row := range rows {
+		if row.HasSlack {
+			slack := NewContinuousVariable(env)
+			sf.Variables = append(sf.Variables, slack)
+			sf.SlackVariables = append(sf.SlackVariables, slack)
+		}
+	}
+	nVariables := len(sf.Variables)
+
+	// Assemble the matrices
+	sf.Transformation = *mat.NewDense(len(originalVariables), nVariables, nil)
+	for jj, col := range columns {
+		sf.Transformation.Set(col.Original, jj, col.Sign)
+	}
+	sf.Shift = *mat.NewVecDense(len(shift), shift)
+
+	c := objective.LinearCoeff(originalVariables)
+	sf.C = *mat.NewVecDense(nVariables, nil)
+	sf.C.MulVec(sf.Transformation.T(), &c)
+	sf.Offset = objective.Constant() + mat.Dot(&c, &sf.Shift)
+
+	if len(rows) > 0 {
+		sf.A = *mat.NewDense(len(rows), nVariables, nil)
+		sf.B = *mat.NewVecDense(len(rows), nil)
+		slackIndex := nColumns
+		for ii, row := range rows {
+			sign := 1.0
+			if row.Bound < 0 {
+				sign = -1.0
+			}
+
+			for jj, coefficient := range row.Coefficients {
+				sf.A.Set(ii, jj, sign*coefficient)
+			}
+			if row.HasSlack {
+				sf.A.Set(ii, slackIndex, sign)
+				slackIndex++
+			}
+			sf.B.SetVec(ii, sign*row.Bound)
+		}
+	}
+
+	return sf, nil
+}
+
+// OriginalValues Converts a solution z of the standard form into the values of the
+// original variables (x = Transformation z + Shift).
+func (sf StandardForm) OriginalValues(z mat.VecDense) map[Variable]float64 {
+	// Input Processing
+	if z.Len() != len(sf.Variables) {
+		panic(
+			smErrors.VectorDimensionError{
+				Operation: "OriginalValues",
+				Arg1:      VecDenseToKVector(z),
+				Arg2:      VariableVector(sf.Variables),
+			},
+		)
+	}
+
+	// Algorithm
+	x := mat.NewVecDense(len(sf.OriginalVariables), nil)
+	x.MulVec(&sf.Transformation, &z)
+	x.AddVec(x, &sf.Shift)
+
+	return VecDenseToValueMap(*x, sf.OriginalVariables)
+}
+
+// ToStandardForm Converts the linear problem into standard form (see ToStandardForm).
+// The objective of a maximization problem is negated, so that the standard form is
+// always a minimization. The new variables are created in the environment of the problem.
+func (p Problem) ToStandardForm() (StandardForm, error) {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		return StandardForm{}, err
+	}
+
+	// Algorithm
+	objective := p.Objective.(ScalarExpression)
+	if p.Sense == SenseMaximize {
+		objective = objective.Multiply(-1.0).(ScalarExpression)
+	}
+
+	return ToStandardForm(objective, p.Constraints, p.Environment)
+}
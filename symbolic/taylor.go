@@ -0,0 +1,241 @@
+package symbolic
+
+import (
+	"sort"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+taylor.go
+Description:
+	Taylor expansions of expressions around a point (TaylorExpand) and the linearization
+	of dynamics f(x, u) around an operating point (Linearize). TaylorExpand is built on the
+	DerivativeWrt() methods of the expressions and Linearize on Jacobian() and Evaluate().
+*/
+
+// TaylorExpand Returns the Taylor polynomial of the given order of the expression e around
+// the point:
+//
+//	sum over |alpha| <= order of (d^alpha e)(x0) / alpha! * d^alpha,
+//
+// where d = x - x0 is the deviation of the variables of e from the point.
+// By default, the polynomial is written in terms of the variables of e (i.e., each d is
+// replaced by x - x0). If a map from the variables of e to deviation variables is given,
+// then the polynomial is written in terms of those variables instead; no variables are
+// created by TaylorExpand (use TaylorExpandWithDeviations to create new deviation variables).
+// Scalar expressions give a Polynomial, vector expressions a PolynomialVector and matrix
+// expressions a PolynomialMatrix (each element is expanded separately). The point must
+// contain a value for every variable of e.
+func TaylorExpand(e Expression, point map[Variable]float64, order int, deviationVariables ...map[Variable]Variable) Expression {
+	// Input Processing
+	checkTaylorExpansion(e, point, order)
+
+	deviations := make(map[Variable]ScalarExpression)
+	switch len(deviationVariables) {
+	case 0:
+		for _, v := range e.Variables() {
+			deviations[v] = v.Minus(point[v]).(ScalarExpression)
+		}
+	case 1:
+		for _, v := range e.Variables() {
+			d, ok := deviationVariables[0][v]
+			if !ok {
+				panic(
+					smErrors.Errorf("no deviation variable was given for the variable %v of the expression", v),
+				)
+			}
+			deviations[v] = d
+		}
+	default:
+		panic(
+			smErrors.Errorf("TaylorExpand expects at most one map of deviation variables; received %v", len(deviationVariables)),
+		)
+	}
+
+	// Algorithm
+	return taylorExpand(e, point, deviations, order)
+}
+
+// TaylorExpandWithDeviations Returns the Taylor polynomial of the given order of the
+// expression e around the point (see TaylorExpand), written in terms of new deviation
+// variables d = x - x0, together with the map from each variable x of e to its deviation
+// variable. A new (continuous) deviation variable is created for every variable of e, in
+// the environment of that variable; pass the map to TaylorExpand to expand other
+// expressions (or the same expression around another point) in the same variables.
+// Substituting d = x - x0 (e.g., with SubstituteAccordingTo) in the result gives the
+// expansion in terms of the original variables.
+func TaylorExpandWithDeviations(e Expression, point map[Variable]float64, order int) (Expression, map[Variable]Variable) {
+	// Input Processing
+	checkTaylorExpansion(e, point, order)
+
+	// Create the deviation variables (sorted by the ID of the original variables,
+	// so that they are created in a deterministic order)
+	variables := UniqueVars(e.Variables())
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].ID < variables[j].ID
+	})
+
+	deviationVariables := make(map[Variable]Variable, len(variables))
+	deviations := make(map[Variable]ScalarExpression, len(variables))
+	for _, v := range variables {
+		var env Environment = DefaultEnvironment
+		if v.Environment != nil {
+			env = v.Environment
+		}
+		deviationVariables[v] = NewContinuousVariable(env)
+		deviations[v] = deviationVariables[v]
+	}
+
+	// Algorithm
+	return taylorExpand(e, point, deviations, order), deviationVariables
+}
+
+// checkTaylorExpansion Panics if e is not well-defined, if the order is negative or if
+// the point does not contain a value for every variable of e.
+func checkTaylorExpansion(e Expression, point map[Variable]float64, order int) {
+	err := e.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if order < 0 {
+		panic(
+			smErrors.Errorf("the order of a Taylor expansion must be non-negative; received %v", order),
+		)
+	}
+
+	for _, v := range e.Variables() {
+		if _, ok := point[v]; !ok {
+			panic(
+				smErrors.MissingVariableValueError{
+					Variable:   v,
+					Expression: e,
+				},
+			)
+		}
+	}
+}
+
+// taylorExpand Computes the Taylor polynomial of the given order of e around the point
+// (see TaylorExpand), element by element, with the deviation of each variable of e given
+// by deviations.
+func taylorExpand(e Expression, point map[Variable]float64, deviations map[Variable]ScalarExpression, order int) Expression {
+	switch concrete := e.(type) {
+	case ScalarExpression:
+		return taylorExpandScalar(concrete, point, deviations, order)
+	case VectorExpression:
+		var pvOut PolynomialVector
+		for ii := 0; ii < concrete.Len(); ii++ {
+			pvOut = append(pvOut, taylorExpandScalar(concrete.AtVec(ii), point, deviations, order))
+		}
+		return pvOut
+	case MatrixExpression:
+		nRows, nCols := concrete.Dims()[0], concrete.Dims()[1]
+		var pmOut PolynomialMatrix
+		for ii := 0; ii < nRows; ii++ {
+			var row []Polynomial
+			for jj := 0; jj < nCols; jj++ {
+				row = append(row, taylorExpandScalar(concrete.At(ii, jj), point, deviations, order))
+			}
+			pmOut = append(pmOut, row)
+		}
+		return pmOut
+	}
+
+	panic(
+		smErrors.UnsupportedInputError{
+			FunctionName: "TaylorExpand",
+			Input:        e,
+		},
+	)
+}
+
+// taylorExpandScalar Computes the Taylor polynomial of the given order of the scalar
+// expression se around the point (see TaylorExpand). Each mixed partial derivative is
+// computed once, by only differentiating with respect to variables whose index (in the
+// list of variables sorted by ID) is at least that of the previous variable.
+// The deviation of each variable of se (a deviation variable or x - x0) is given by
+// deviationsOf.
+func taylorExpandScalar(se ScalarExpression, point map[Variable]float64, deviationsOf map[Variable]ScalarExpression, order int) Polynomial {
+	variables := se.Variables()
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].ID < variables[j].ID
+	})
+
+	deviations := make([]ScalarExpression, len(variables))
+	for ii, v := range variables {
+		deviations[ii] = deviationsOf[v]
+	}
+
+	var expansion Expression = K(0.0)
+	var expand func(derivative ScalarExpression, alpha []int, factorial float64, degree, first int)
+	expand = func(derivative ScalarExpression, alpha []int, factorial float64, degree, first int) {
+		// Add the term (d^alpha e)(x0) / alpha! * d^alpha
+		if value := derivative.Evaluate(point); value != 0.0 {
+			var term Expression = K(value / factorial)
+			for ii, exponent := range alpha {
+				if exponent > 0 {
+					term = term.Multiply(deviations[ii].Power(exponent))
+				}
+			}
+			expansion = expansion.Plus(term)
+		}
+
+		if degree == order {
+			return
+		}
+
+		for ii := first; ii < len(variables); ii++ {
+			alpha[ii]++
+			expand(scalarDerivativeWrt(derivative, variables[ii]), alpha, factorial*float64(alpha[ii]), degree+1, ii)
+			alpha[ii]--
+		}
+	}
+	expand(se, make([]int, len(variables)), 1.0, 0, 0)
+
+	return toPolynomial(expansion.(ScalarExpression)).Simplify()
+}
+
+// Linearize Linearizes the dynamics f(x, u) around the operating point (x0, u0):
+//
+//	f(x, u) ~ A (x - x0) + B (u - u0) + c,
+//
+// where A and B are the Jacobians of f with respect to x and u evaluated at the operating
+// point and c = f(x0, u0). If u is empty, then B is an empty matrix.
+// A and B are computed by evaluating Jacobian(f, x) and Jacobian(f, u) at the operating
+// point (with Evaluate), and c by evaluating f itself.
+func Linearize(f VectorExpression, x, u VariableVector, x0, u0 mat.VecDense) (A, B mat.Dense, c mat.VecDense) {
+	// Input Processing
+	err := f.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = x.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	if len(u) > 0 {
+		err = u.Check()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	values := VecDenseToValueMap(x0, x)
+	for v, value := range VecDenseToValueMap(u0, u) {
+		values[v] = value
+	}
+
+	// Algorithm
+	A = Jacobian(f, x).Evaluate(values)
+	if len(u) > 0 {
+		B = Jacobian(f, u).Evaluate(values)
+	}
+	c = f.Evaluate(values)
+
+	return A, B, c
+}
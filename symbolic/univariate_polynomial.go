@@ -0,0 +1,431 @@
+package symbolic
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+)
+
+/*
+univariate_polynomial.go
+Description:
+	Algebra on univariate polynomials (i.e., Polynomials whose only variable is a
+	given Variable v): long division, greatest common divisors, square-free
+	factorization and the extraction of rational roots.
+
+	The polynomials are converted to slices of coefficients, where the (ii)th
+	element is the coefficient of v^ii. Coefficients whose magnitude is below
+	UnivariateTolerance (relative to the largest coefficient) are treated as zero
+	when computing greatest common divisors.
+*/
+
+// UnivariateTolerance is the relative tolerance used to decide when a remainder is
+// zero in GCD (and in SquareFreeFactorization).
+const UnivariateTolerance = 1e-9
+
+// PolynomialFactor describes the factor Factor^Multiplicity of a polynomial.
+type PolynomialFactor struct {
+	Factor       Polynomial
+	Multiplicity int
+}
+
+// =========
+// Functions
+// =========
+
+// univariateCoefficients Returns the coefficients of the polynomial p in the variable v,
+// where the (ii)th element is the coefficient of v^ii. This panics with a
+// UnivariatePolynomialRequiredError if p contains any variable other than v.
+func univariateCoefficients(p Polynomial, v Variable, operation string) []float64 {
+	// Input Processing
+	err := p.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	coefficients := []float64{0.0}
+	for _, monomial := range p.Monomials {
+		monomial = canonicalMonomial(monomial)
+		degree := 0
+		for ii, factor := range monomial.VariableFactors {
+			if factor.ID != v.ID {
+				panic(
+					smErrors.UnivariatePolynomialRequiredError{
+						Operation:  operation,
+						Expression: p,
+						Variable:   v,
+					},
+				)
+			}
+			degree += monomial.Exponents[ii]
+		}
+
+		for len(coefficients) <= degree {
+			coefficients = append(coefficients, 0.0)
+		}
+		coefficients[degree] += monomial.Coefficient
+	}
+
+	return trimCoefficients(coefficients, 0.0)
+}
+
+// trimCoefficients Removes the leading coefficients whose magnitude is at most tol
+// (a constant whose magnitude is at most tol is replaced with zero).
+func trimCoefficients(coefficients []float64, tol float64) []float64 {
+	n := len(coefficients)
+	for n > 1 && math.Abs(coefficients[n-1]) <= tol {
+		n--
+	}
+
+	if n == 1 && math.Abs(coefficients[0]) <= tol {
+		return []float64{0.0}
+	}
+	return coefficients[:n]
+}
+
+// isZeroCoefficients Returns true if the coefficients describe the zero polynomial.
+func isZeroCoefficients(coefficients []float64) bool {
+	return len(coefficients) == 1 && coefficients[0] == 0.0
+}
+
+// maxAbsCoefficient Returns the largest magnitude of the coefficients.
+func maxAbsCoefficient(coefficients []float64) float64 {
+	maximum := 0.0
+	for _, coefficient := range coefficients {
+		maximum = math.Max(maximum, math.Abs(coefficient))
+	}
+	return maximum
+}
+
+// polynomialFromCoefficients Returns the polynomial sum_ii coefficients[ii] v^ii,
+// with the terms sorted from the highest degree to the lowest.
+func polynomialFromCoefficients(coefficients []float64, v Variable) Polynomial {
+	var p Polynomial
+	for degree := len(coefficients) - 1; degree >= 0; degree-- {
+		if coefficients[degree] == 0.0 {
+			continue
+		}
+
+		monomial := Monomial{
+			Coefficient:     coefficients[degree],
+			VariableFactors: []Variable{},
+			Exponents:       []int{},
+		}
+		if degree > 0 {
+			monomial.VariableFactors = []Variable{v}
+			monomial.Exponents = []int{degree}
+		}
+		p.Monomials = append(p.Monomials, monomial)
+	}
+
+	if len(p.Monomials) == 0 {
+		return K(0.0).ToPolynomial()
+	}
+	return p
+}
+
+// divideCoefficients Performs the long division of the coefficients of p by the
+// (trimmed, nonzero) coefficients of d and returns the quotient and the remainder.
+func divideCoefficients(p, d []float64) ([]float64, []float64) {
+	remainder := append([]float64{}, p...)
+	if len(p) < len(d) {
+		return []float64{0.0}, remainder
+	}
+
+	quotient := make([]float64, len(p)-len(d)+1)
+	lead := d[len(d)-1]
+	for degree := len(p) - 1; degree >= len(d)-1; degree-- {
+		coefficient := remainder[degree] / lead
+		shift := degree - (len(d) - 1)
+		quotient[shift] = coefficient
+		for ii := range d {
+			remainder[shift+ii] -= coefficient * d[ii]
+		}
+		remainder[degree] = 0.0 // Removed exactly by construction
+	}
+
+	if len(d) == 1 {
+		return trimCoefficients(quotient, 0.0), []float64{0.0}
+	}
+	return trimCoefficients(quotient, 0.0), trimCoefficients(remainder[:len(d)-1], 0.0)
+}
+
+// derivativeCoefficients Returns the coefficients of the derivative of the polynomial.
+func derivativeCoefficients(coefficients []float64) []float64 {
+	if len(coefficients) == 1 {
+		return []float64{0.0}
+	}
+
+	derivative := make([]float64, len(coefficients)-1)
+	for degree := 1; degree < len(coefficients); degree++ {
+		derivative[degree-1] = float64(degree) * coefficients[degree]
+	}
+	return derivative
+}
+
+// monicCoefficients Returns the coefficients divided by the leading coefficient.
+func monicCoefficients(coefficients []float64) []float64 {
+	lead := coefficients[len(coefficients)-1]
+	monic := make([]float64, len(coefficients))
+	for ii, coefficient := range coefficients {
+		monic[ii] = coefficient / lead
+	}
+	return monic
+}
+
+// gcdCoefficients Returns the monic greatest common divisor of the two polynomials
+// (given by their coefficients) using the Euclidean algorithm.
+func gcdCoefficients(a, b []float64) []float64 {
+	switch {
+	case isZeroCoefficients(a) && isZeroCoefficients(b):
+		return []float64{0.0}
+	case isZeroCoefficients(a):
+		return monicCoefficients(b)
+	case isZeroCoefficients(b):
+		return monicCoefficients(a)
+	}
+
+	// Work with monic polynomials, so that the remainders keep the scale of the inputs
+	a, b = monicCoefficients(a), monicCoefficients(b)
+	tol := UnivariateTolerance * math.Max(1.0, math.Max(maxAbsCoefficient(a), maxAbsCoefficient(b)))
+	for {
+		_, remainder := divideCoefficients(a, b)
+		remainder = trimCoefficients(remainder, tol)
+		if isZeroCoefficients(remainder) {
+			return b
+		}
+		a, b = b, monicCoefficients(remainder)
+	}
+}
+
+// DivideWithRemainder Divides the univariate polynomial p by the univariate
+// polynomial d (both in the variable v) and returns the quotient q and the
+// remainder r such that p = q d + r, where the degree of r is less than the degree of d.
+func DivideWithRemainder(p, d Polynomial, v Variable) (q, r Polynomial) {
+	// Input Processing
+	pCoefficients := univariateCoefficients(p, v, "DivideWithRemainder")
+	dCoefficients := univariateCoefficients(d, v, "DivideWithRemainder")
+	if isZeroCoefficients(dCoefficients) {
+		panic(
+			smErrors.DivisionByZeroError{
+				Operation: "DivideWithRemainder",
+				Numerator: p,
+			},
+		)
+	}
+
+	// Algorithm
+	quotient, remainder := divideCoefficients(pCoefficients, dCoefficients)
+	return polynomialFromCoefficients(quotient, v), polynomialFromCoefficients(remainder, v)
+}
+
+// GCD Returns the monic greatest common divisor of the univariate polynomials p1 and p2
+// (both in the variable v). The GCD of two zero polynomials is zero.
+func GCD(p1, p2 Polynomial, v Variable) Polynomial {
+	// Input Processing
+	coefficients1 := univariateCoefficients(p1, v, "GCD")
+	coefficients2 := univariateCoefficients(p2, v, "GCD")
+
+	// Algorithm
+	return polynomialFromCoefficients(gcdCoefficients(coefficients1, coefficients2), v)
+}
+
+// SquareFreeFactorization Computes the square-free factorization of the univariate
+// polynomial p (in the variable v) with Yun's algorithm. It returns the leading
+// coefficient c of p and the monic, square-free and pairwise coprime factors a_i, such that
+//
+//	p = c * a_1^1 * a_2^2 * ... * a_k^k
+//
+// Factors equal to 1 are omitted (so the multiplicities may skip some values).
+func SquareFreeFactorization(p Polynomial, v Variable) (float64, []PolynomialFactor) {
+	// Input Processing
+	coefficients := univariateCoefficients(p, v, "SquareFreeFactorization")
+	if isZeroCoefficients(coefficients) {
+		panic(
+			smErrors.UnivariatePolynomialRequiredError{
+				Operation:  "SquareFreeFactorization (nonzero)",
+				Expression: p,
+				Variable:   v,
+			},
+		)
+	}
+
+	// Algorithm
+	lead := coefficients[len(coefficients)-1]
+	f := monicCoefficients(coefficients)
+	tol := UnivariateTolerance * math.Max(1.0, maxAbsCoefficient(f))
+
+	var factors []PolynomialFactor
+	if len(f) == 1 {
+		return lead, factors
+	}
+
+	fPrime := derivativeCoefficients(f)
+	a := gcdCoefficients(f, fPrime)
+	b, _ := divideCoefficients(f, a)
+	c, _ := divideCoefficients(fPrime, a)
+	d := subtractCoefficients(c, derivativeCoefficients(b), tol)
+	for multiplicity := 1; len(b) > 1 && multiplicity < len(f); multiplicity++ {
+		a = gcdCoefficients(b, d)
+		if len(a) > 1 {
+			factors = append(factors, PolynomialFactor{
+				Factor:       polynomialFromCoefficients(a, v),
+				Multiplicity: multiplicity,
+			})
+		}
+
+		b, _ = divideCoefficients(b, a)
+		c, _ = divideCoefficients(d, a)
+		d = subtractCoefficients(c, derivativeCoefficients(b), tol)
+	}
+
+	return lead, factors
+}
+
+// subtractCoefficients Returns the coefficients of the difference of the two
+// polynomials (with the leading coefficients below tol removed).
+func subtractCoefficients(a, b []float64, tol float64) []float64 {
+	difference := make([]float64, int(math.Max(float64(len(a)), float64(len(b)))))
+	for ii := range a {
+		difference[ii] += a[ii]
+	}
+	for ii := range b {
+		difference[ii] -= b[ii]
+	}
+	return trimCoefficients(difference, tol)
+}
+
+// RationalRoots Returns the rational roots of the univariate polynomial p (in the
+// variable v), sorted in increasing order and repeated according to their multiplicity.
+//
+// The coefficients of p are treated as the exact (binary) rational numbers that they
+// represent, so the rational root theorem is applied to p scaled to have integer coefficients.
+// Each candidate root is checked with exact arithmetic.
+func RationalRoots(p Polynomial, v Variable) []float64 {
+	// Input Processing
+	coefficients := univariateCoefficients(p, v, "RationalRoots")
+	if isZeroCoefficients(coefficients) {
+		panic(
+			smErrors.UnivariatePolynomialRequiredError{
+				Operation:  "RationalRoots (nonzero)",
+				Expression: p,
+				Variable:   v,
+			},
+		)
+	}
+
+	// Algorithm
+	integerCoefficients := integerCoefficientsOf(coefficients)
+
+	// Extract the roots at zero
+	var roots []float64
+	for len(integerCoefficients) > 1 && integerCoefficients[0].Sign() == 0 {
+		roots = append(roots, 0.0)
+		integerCoefficients = integerCoefficients[1:]
+	}
+
+	if len(integerCoefficients) > 1 {
+		constantDivisors := divisorsOf(integerCoefficients[0])
+		leadDivisors := divisorsOf(integerCoefficients[len(integerCoefficients)-1])
+		for _, numerator := range constantDivisors {
+			for _, denominator := range leadDivisors {
+				if new(big.Int).GCD(nil, nil, numerator, denominator).Cmp(big.NewInt(1)) != 0 {
+					continue // Only consider the candidates in lowest terms
+				}
+
+				for _, sign := range []int64{1, -1} {
+					s := new(big.Int).Mul(numerator, big.NewInt(sign))
+					for len(integerCoefficients) > 1 && isIntegerRoot(integerCoefficients, s, denominator) {
+						root, _ := new(big.Rat).SetFrac(s, denominator).Float64()
+						roots = append(roots, root)
+						integerCoefficients = divideByLinearFactor(integerCoefficients, s, denominator)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Float64s(roots)
+	return roots
+}
+
+// integerCoefficientsOf Returns the coefficients multiplied by the least common multiple
+// of their (power of two) denominators, so that they are all integers, and then divided
+// by the greatest common divisor of the resulting integers.
+func integerCoefficientsOf(coefficients []float64) []*big.Int {
+	rationals := make([]*big.Rat, len(coefficients))
+	scale := big.NewInt(1)
+	for ii, coefficient := range coefficients {
+		rationals[ii] = new(big.Rat).SetFloat64(coefficient)
+		denominator := rationals[ii].Denom()
+		gcd := new(big.Int).GCD(nil, nil, scale, denominator)
+		scale.Mul(scale, new(big.Int).Quo(denominator, gcd))
+	}
+
+	integers := make([]*big.Int, len(coefficients))
+	content := big.NewInt(0)
+	for ii, rational := range rationals {
+		scaled := new(big.Rat).Mul(rational, new(big.Rat).SetInt(scale))
+		integers[ii] = new(big.Int).Set(scaled.Num())
+		content.GCD(nil, nil, content, new(big.Int).Abs(integers[ii]))
+	}
+
+	for _, integer := range integers {
+		integer.Quo(integer, content)
+	}
+	return integers
+}
+
+// divisorsOf Returns the positive divisors of the nonzero integer n.
+func divisorsOf(n *big.Int) []*big.Int {
+	nAbs := new(big.Int).Abs(n)
+	var divisors []*big.Int
+	one := big.NewInt(1)
+	for ii := big.NewInt(1); new(big.Int).Mul(ii, ii).Cmp(nAbs) <= 0; ii = new(big.Int).Add(ii, one) {
+		quotient, remainder := new(big.Int).QuoRem(nAbs, ii, new(big.Int))
+		if remainder.Sign() != 0 {
+			continue
+		}
+
+		divisors = append(divisors, ii)
+		if quotient.Cmp(ii) != 0 {
+			divisors = append(divisors, quotient)
+		}
+	}
+	return divisors
+}
+
+// isIntegerRoot Returns true if s/t is a root of the polynomial with the integer
+// coefficients, i.e., if sum_ii coefficients[ii] s^ii t^(n-ii) = 0.
+func isIntegerRoot(coefficients []*big.Int, s, t *big.Int) bool {
+	// Horner's method on the homogenized polynomial
+	value := new(big.Int).Set(coefficients[len(coefficients)-1])
+	tPower := big.NewInt(1)
+	for ii := len(coefficients) - 2; ii >= 0; ii-- {
+		tPower.Mul(tPower, t)
+		value.Mul(value, s)
+		value.Add(value, new(big.Int).Mul(coefficients[ii], tPower))
+	}
+	return value.Sign() == 0
+}
+
+// divideByLinearFactor Returns the coefficients of the quotient of the polynomial
+// (with integer coefficients) by (t x - s), where s/t is a root of the polynomial.
+func divideByLinearFactor(coefficients []*big.Int, s, t *big.Int) []*big.Int {
+	n := len(coefficients) - 1
+	quotient := make([]*big.Int, n)
+	quotient[n-1] = new(big.Int).Quo(coefficients[n], t)
+	for ii := n - 1; ii >= 1; ii-- {
+		numerator := new(big.Int).Add(coefficients[ii], new(big.Int).Mul(s, quotient[ii]))
+		quotient[ii-1] = new(big.Int).Quo(numerator, t)
+	}
+	return quotient
+}
@@ -0,0 +1,320 @@
+package symbolic
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+univariate_roots.go
+Description:
+	Numerical root finding for univariate polynomials (see univariate_polynomial.go):
+	- Roots computes all of the (complex) roots as the eigenvalues of the companion matrix, and
+	- RealRootsInInterval uses Sturm sequences to count (and then isolate) the distinct
+	real roots in an interval.
+*/
+
+// =========
+// Functions
+// =========
+
+// nonzeroUnivariateCoefficients Returns the coefficients of the univariate polynomial p
+// (see univariateCoefficients) and panics if p is the zero polynomial.
+func nonzeroUnivariateCoefficients(p Polynomial, v Variable, operation string) []float64 {
+	coefficients := univariateCoefficients(p, v, operation)
+	if isZeroCoefficients(coefficients) {
+		panic(
+			smErrors.UnivariatePolynomialRequiredError{
+				Operation:  operation + " (nonzero)",
+				Expression: p,
+				Variable:   v,
+			},
+		)
+	}
+	return coefficients
+}
+
+// CompanionMatrix Returns the companion matrix of the univariate polynomial p (in the
+// variable v), whose eigenvalues are the roots of p. For the monic polynomial
+// v^n + c_{n-1} v^{n-1} + ... + c_0, the matrix has ones on its subdiagonal and
+// -c_0, ..., -c_{n-1} in its last column.
+func CompanionMatrix(p Polynomial, v Variable) mat.Dense {
+	// Input Processing
+	coefficients := nonzeroUnivariateCoefficients(p, v, "CompanionMatrix")
+	if len(coefficients) == 1 {
+		panic(
+			smErrors.UnivariatePolynomialRequiredError{
+				Operation:  "CompanionMatrix (degree >= 1)",
+				Expression: p,
+				Variable:   v,
+			},
+		)
+	}
+
+	// Algorithm
+	monic := monicCoefficients(coefficients)
+	n := len(monic) - 1
+	companion := mat.NewDense(n, n, nil)
+	for ii := 0; ii < n; ii++ {
+		if ii > 0 {
+			companion.Set(ii, ii-1, 1.0)
+		}
+		companion.Set(ii, n-1, -monic[ii])
+	}
+
+	return *companion
+}
+
+// Roots Returns all of the (complex) roots of the univariate polynomial p (in the
+// variable v), repeated according to their multiplicity. The roots are computed as the
+// eigenvalues of the companion matrix of p and are sorted by their real part (and then
+// by their imaginary part). A constant polynomial has no roots.
+func Roots(p Polynomial, v Variable) []complex128 {
+	// Input Processing
+	coefficients := nonzeroUnivariateCoefficients(p, v, "Roots")
+	if len(coefficients) == 1 {
+		return []complex128{}
+	}
+
+	// Algorithm
+	companion := CompanionMatrix(p, v)
+
+	var eigen mat.Eigen
+	if ok := eigen.Factorize(&companion, mat.EigenNone); !ok {
+		panic(
+			smErrors.Errorf("the eigenvalue decomposition of the companion matrix of %v did not converge", p),
+		)
+	}
+
+	roots := eigen.Values(nil)
+	sort.Slice(roots, func(i, j int) bool {
+		if real(roots[i]) != real(roots[j]) {
+			return real(roots[i]) < real(roots[j])
+		}
+		return imag(roots[i]) < imag(roots[j])
+	})
+	return roots
+}
+
+// ratCoefficients Returns the (exact) rational values of the given coefficients.
+func ratCoefficients(coefficients []float64) []*big.Rat {
+	rationals := make([]*big.Rat, len(coefficients))
+	for ii, coefficient := range coefficients {
+		rationals[ii] = new(big.Rat).SetFloat64(coefficient)
+	}
+	return rationals
+}
+
+// trimRatCoefficients Removes the (exactly) zero leading coefficients, keeping at least one
+// coefficient.
+func trimRatCoefficients(coefficients []*big.Rat) []*big.Rat {
+	n := len(coefficients)
+	for n > 1 && coefficients[n-1].Sign() == 0 {
+		n--
+	}
+	return coefficients[:n]
+}
+
+// divideRatCoefficients Divides the polynomials with the given (rational) coefficients
+// exactly, returning the quotient and the remainder.
+func divideRatCoefficients(p, d []*big.Rat) (quotient, remainder []*big.Rat) {
+	remainder = make([]*big.Rat, len(p))
+	for ii := range p {
+		remainder[ii] = new(big.Rat).Set(p[ii])
+	}
+
+	degreeD := len(d) - 1
+	if len(p) <= degreeD {
+		return []*big.Rat{new(big.Rat)}, remainder
+	}
+
+	quotient = make([]*big.Rat, len(p)-degreeD)
+	for ii := len(quotient) - 1; ii >= 0; ii-- {
+		quotient[ii] = new(big.Rat).Quo(remainder[ii+degreeD], d[degreeD])
+		for jj := 0; jj <= degreeD; jj++ {
+			remainder[ii+jj].Sub(remainder[ii+jj], new(big.Rat).Mul(quotient[ii], d[jj]))
+		}
+	}
+
+	return quotient, trimRatCoefficients(remainder[:max(degreeD, 1)])
+}
+
+// derivativeRatCoefficients Returns the coefficients of the derivative of the polynomial
+// with the given (rational) coefficients.
+func derivativeRatCoefficients(coefficients []*big.Rat) []*big.Rat {
+	if len(coefficients) == 1 {
+		return []*big.Rat{new(big.Rat)}
+	}
+
+	derivative := make([]*big.Rat, len(coefficients)-1)
+	for ii := range derivative {
+		derivative[ii] = new(big.Rat).Mul(coefficients[ii+1], new(big.Rat).SetInt64(int64(ii+1)))
+	}
+	return derivative
+}
+
+// isZeroRatCoefficients Returns true if the polynomial with the given (trimmed, rational)
+// coefficients is zero.
+func isZeroRatCoefficients(coefficients []*big.Rat) bool {
+	return len(coefficients) == 1 && coefficients[0].Sign() == 0
+}
+
+// normalizeRatCoefficients Divides the coefficients by the magnitude of the leading
+// coefficient (which keeps the signs of the polynomial and the size of the numbers small).
+func normalizeRatCoefficients(coefficients []*big.Rat) []*big.Rat {
+	scale := new(big.Rat).Abs(coefficients[len(coefficients)-1])
+	normalized := make([]*big.Rat, len(coefficients))
+	for ii := range coefficients {
+		normalized[ii] = new(big.Rat).Quo(coefficients[ii], scale)
+	}
+	return normalized
+}
+
+// sturmSequence Returns the coefficients of the Sturm sequence of the square-free part of the
+// polynomial with the given coefficients: p_0 = p / gcd(p, p'), p_1 = p_0' and
+// p_{k+1} = -rem(p_{k-1}, p_k), until the remainder vanishes.
+//
+// The sequence is computed in exact rational arithmetic (every float64 is a rational number),
+// so that the root counts are certified rather than subject to rounding errors. Using the
+// square-free part means that the multiple roots of p are counted once, even when they are
+// the endpoints of an interval.
+func sturmSequence(coefficients []float64) [][]*big.Rat {
+	p := normalizeRatCoefficients(ratCoefficients(coefficients))
+
+	// gcd(p, p') with Euclid's algorithm
+	gcd, other := p, derivativeRatCoefficients(p)
+	for !isZeroRatCoefficients(other) {
+		_, remainder := divideRatCoefficients(gcd, other)
+		gcd, other = normalizeRatCoefficients(other), remainder
+	}
+	squareFree, _ := divideRatCoefficients(p, gcd)
+
+	sequence := [][]*big.Rat{normalizeRatCoefficients(squareFree)}
+	derivative := derivativeRatCoefficients(squareFree)
+	if isZeroRatCoefficients(derivative) {
+		return sequence
+	}
+	sequence = append(sequence, normalizeRatCoefficients(derivative))
+
+	for {
+		previous, current := sequence[len(sequence)-2], sequence[len(sequence)-1]
+		_, remainder := divideRatCoefficients(previous, current)
+		if isZeroRatCoefficients(remainder) {
+			return sequence
+		}
+
+		for _, coefficient := range remainder {
+			coefficient.Neg(coefficient)
+		}
+		sequence = append(sequence, normalizeRatCoefficients(remainder))
+	}
+}
+
+// signChanges Returns the number of sign changes in the Sturm sequence evaluated (exactly,
+// with Horner's method) at x. Zeros are skipped.
+func signChanges(sequence [][]*big.Rat, x float64) int {
+	xAsRat := new(big.Rat).SetFloat64(x)
+
+	changes := 0
+	previousSign := 0
+	for _, c := range sequence {
+		value := new(big.Rat)
+		for ii := len(c) - 1; ii >= 0; ii-- {
+			value.Mul(value, xAsRat)
+			value.Add(value, c[ii])
+		}
+
+		sign := value.Sign()
+		if sign == 0 {
+			continue
+		}
+
+		if previousSign != 0 && sign != previousSign {
+			changes++
+		}
+		previousSign = sign
+	}
+	return changes
+}
+
+// rootBound Returns the Cauchy bound on the magnitude of the roots of the polynomial
+// with the given coefficients: 1 + max_ii |c_ii / c_n|.
+func rootBound(coefficients []float64) float64 {
+	lead := coefficients[len(coefficients)-1]
+	bound := 0.0
+	for _, coefficient := range coefficients[:len(coefficients)-1] {
+		bound = math.Max(bound, math.Abs(coefficient/lead))
+	}
+	return 1.0 + bound
+}
+
+// CountRealRootsInInterval Returns the number of distinct real roots of the univariate
+// polynomial p (in the variable v) in the interval (lo, hi], using Sturm's theorem.
+// The bounds may be infinite.
+func CountRealRootsInInterval(p Polynomial, v Variable, lo, hi float64) int {
+	// Input Processing
+	coefficients := nonzeroUnivariateCoefficients(p, v, "CountRealRootsInInterval")
+	if lo >= hi {
+		return 0
+	}
+
+	// Algorithm
+	lo, hi = clampToRootBound(coefficients, lo, hi)
+	sequence := sturmSequence(coefficients)
+	return signChanges(sequence, lo) - signChanges(sequence, hi)
+}
+
+// clampToRootBound Replaces the bounds of the interval (lo, hi] which lie beyond the
+// Cauchy bound of the roots with the (slightly enlarged) bound, so that infinite bounds
+// can be used.
+func clampToRootBound(coefficients []float64, lo, hi float64) (float64, float64) {
+	bound := 2.0 * rootBound(coefficients)
+	return math.Max(lo, -bound), math.Min(hi, bound)
+}
+
+// RealRootsInInterval Returns the distinct real roots of the univariate polynomial p (in the
+// variable v) in the interval (lo, hi], sorted in increasing order. The roots are isolated by
+// bisection, using Sturm sequences to count the roots in each subinterval, and are then refined
+// (by bisection) until the subinterval that contains them cannot be split in floating point
+// arithmetic. The bounds may be infinite.
+func RealRootsInInterval(p Polynomial, v Variable, lo, hi float64) []float64 {
+	// Input Processing
+	coefficients := nonzeroUnivariateCoefficients(p, v, "RealRootsInInterval")
+	if lo >= hi || len(coefficients) == 1 {
+		return []float64{}
+	}
+
+	// Algorithm
+	lo, hi = clampToRootBound(coefficients, lo, hi)
+	sequence := sturmSequence(coefficients)
+	count := func(a, b float64) int {
+		return signChanges(sequence, a) - signChanges(sequence, b)
+	}
+
+	var roots []float64
+	var isolate func(a, b float64, n int)
+	isolate = func(a, b float64, n int) {
+		if n <= 0 {
+			return
+		}
+
+		middle := a + (b-a)/2
+		if middle <= a || middle >= b {
+			// The interval cannot be split any further in floating point arithmetic
+			roots = append(roots, b)
+			return
+		}
+
+		nLeft := count(a, middle)
+		isolate(a, middle, nLeft)
+		isolate(middle, b, n-nLeft)
+	}
+	isolate(lo, hi, count(lo, hi))
+
+	sort.Float64s(roots)
+	return roots
+}
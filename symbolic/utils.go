@@ -1,9 +1,8 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
 )
 
 // FindInSlice Identifies if the  input xIn is in the slice sliceIn.
@@ -17,7 +16,7 @@ func FindInSlice(xIn interface{}, sliceIn interface{}) (int, error) {
 	case string:
 		slice, ok := sliceIn.([]string)
 		if !ok {
-			return -1, fmt.Errorf(
+			return -1, smErrors.Errorf(
 				"the input slice is of type %T, but the element we're searching for is of type %T",
 				sliceIn,
 				x,
@@ -38,7 +37,7 @@ func FindInSlice(xIn interface{}, sliceIn interface{}) (int, error) {
 	case int:
 		slice, ok := sliceIn.([]int)
 		if !ok {
-			return -1, fmt.Errorf(
+			return -1, smErrors.Errorf(
 				"the input slice is of type %T, but the element we're searching for is of type %T",
 				sliceIn,
 				x,
@@ -73,7 +72,7 @@ func FindInSlice(xIn interface{}, sliceIn interface{}) (int, error) {
 	case Variable:
 		slice, ok := sliceIn.([]Variable)
 		if !ok {
-			return -1, fmt.Errorf(
+			return -1, smErrors.Errorf(
 				"the input slice is of type %T, but the element we're searching for is of type %T",
 				sliceIn,
 				x,
@@ -92,7 +91,7 @@ func FindInSlice(xIn interface{}, sliceIn interface{}) (int, error) {
 
 	default:
 
-		return -1, fmt.Errorf(
+		return -1, smErrors.Errorf(
 			"the FindInSlice() function was only defined for types %v, not type %T:",
 			allowedTypes,
 			xIn,
@@ -155,7 +154,7 @@ func CheckSubstitutionMap(subMap map[Variable]Expression) error {
 		// Verify that the key is a valid variable
 		err = tempVar.Check()
 		if err != nil {
-			return fmt.Errorf(
+			return smErrors.Errorf(
 				"key %v in the substitution map is not a valid variable: %v",
 				tempVar,
 				err,
@@ -165,7 +164,7 @@ func CheckSubstitutionMap(subMap map[Variable]Expression) error {
 		// Verify that the value is a valid expression
 		err = tempExpr.Check()
 		if err != nil {
-			return fmt.Errorf(
+			return smErrors.Errorf(
 				"value %v in the substitution map[%v] is not a valid expression: %v",
 				tempExpr,
 				tempVar,
@@ -175,7 +174,7 @@ func CheckSubstitutionMap(subMap map[Variable]Expression) error {
 
 		// Verify that the value is a scalar expression
 		if !IsScalarExpression(tempExpr) {
-			return fmt.Errorf(
+			return smErrors.Errorf(
 				"value %v in the substitution map[%v] is not a scalar expression (received %T)",
 				tempExpr,
 				tempVar,
@@ -187,3 +186,61 @@ func CheckSubstitutionMap(subMap map[Variable]Expression) error {
 	// All checks passed
 	return nil
 }
+
+// VecDenseToValueMap Creates the map used by the Evaluate methods from a vector of values.
+// The (ii)th element of x is assigned to the (ii)th variable in wrt.
+func VecDenseToValueMap(x mat.VecDense, wrt []Variable) map[Variable]float64 {
+	// Input Processing
+	if x.Len() != len(wrt) {
+		panic(
+			smErrors.VectorDimensionError{
+				Operation: "VecDenseToValueMap",
+				Arg1:      VecDenseToKVector(x),
+				Arg2:      VariableVector(wrt),
+			},
+		)
+	}
+
+	// Algorithm
+	values := make(map[Variable]float64, len(wrt))
+	for ii, v := range wrt {
+		values[v] = x.AtVec(ii)
+	}
+
+	return values
+}
+
+// checkValuesForEvaluate Panics with a MissingVariableValueError (naming e, the expression
+// being evaluated) if one of the variables of e does not have a value in the values map.
+// It is called once by the Evaluate methods before their elements are evaluated, so that the
+// error describes the expression that the caller evaluated and not one of its parts.
+func checkValuesForEvaluate(e Expression, values map[Variable]float64) {
+	for _, v := range e.Variables() {
+		if _, ok := values[v]; !ok {
+			panic(
+				smErrors.MissingVariableValueError{
+					Variable:   v,
+					Expression: e,
+				},
+			)
+		}
+	}
+}
+
+// valueMapForEvaluateAt Creates the value map used by the EvaluateAt methods.
+// If no slice of variables is given, then the values in x are assigned to the
+// variables of e in the order given by e.Variables().
+func valueMapForEvaluateAt(e Expression, x mat.VecDense, wrt [][]Variable) map[Variable]float64 {
+	// Check to see if the user provided a slice of variables
+	var wrtVars []Variable
+	switch len(wrt) {
+	case 0:
+		wrtVars = e.Variables()
+	case 1:
+		wrtVars = wrt[0]
+	default:
+		panic(smErrors.Errorf("Too many inputs provided to EvaluateAt() method."))
+	}
+
+	return VecDenseToValueMap(x, wrtVars)
+}
@@ -47,7 +47,7 @@ func (v Variable) LinearCoeff(wrt ...[]Variable) mat.VecDense {
 		wrtVars = wrt[0]
 	default:
 		panic(
-			fmt.Errorf("Too many inputs provided to Variable.LinearCoeff() method."),
+			smErrors.Errorf("Too many inputs provided to Variable.LinearCoeff() method."),
 		)
 	}
 
@@ -68,6 +68,20 @@ func (v Variable) LinearCoeff(wrt ...[]Variable) mat.VecDense {
 	return coeffOut
 }
 
+// QuadraticRepresentation Returns the matrix Q, the vector c and the constant r
+// such that the variable v can be written as 0.5 * x^T Q x + c^T x + r.
+// For a variable, Q is always zero and c contains a single 1.0.
+func (v Variable) QuadraticRepresentation(wrt ...[]Variable) (mat.SymDense, mat.VecDense, float64) {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return v.ToPolynomial().QuadraticRepresentation(wrt...)
+}
+
 // Plus adds the current expression to another and returns the resulting
 // expression.
 func (v Variable) Plus(rightIn interface{}) Expression {
@@ -110,6 +124,10 @@ func (v Variable) Plus(rightIn interface{}) Expression {
 		out = right.Plus(v)
 	case Polynomial:
 		out = right.Plus(v)
+	case RationalExpression:
+		out = right.Plus(v)
+	case FunctionExpression:
+		out = sumOf(v, right)
 	case *mat.VecDense:
 		out = v.Plus(VecDenseToKVector(*right))
 	case mat.VecDense:
@@ -189,6 +207,11 @@ func (v Variable) Eq(rhsIn interface{}) Constraint {
 	return v.Comparison(rhsIn, SenseEqual)
 }
 
+// Between returns the range constraint lower <= v <= upper.
+func (v Variable) Between(lower, upper interface{}) Constraint {
+	return newScalarRangeConstraint(v, lower, upper)
+}
+
 // Comparison This method compares the receiver with expression rhs in the sense provided by sense.
 // Usage:
 //
@@ -231,6 +254,12 @@ func (v Variable) Comparison(rhsIn interface{}, sense ConstrSense) Constraint {
 	case Polynomial:
 		// Create a new constraint
 		return ScalarConstraint{v, rhs, sense}
+	case RationalExpression:
+		// Create a new constraint
+		return ScalarConstraint{v, rhs, sense}
+	case FunctionExpression:
+		// Create a new constraint
+		return ScalarConstraint{v, rhs, sense}
 	}
 
 	panic(
@@ -249,10 +278,15 @@ type VarType byte
 // to Gurobi's encoding.
 const (
 	Continuous VarType = 'C'
-	Binary             = 'B'
-	Integer            = 'I'
+	Binary     VarType = 'B'
+	Integer    VarType = 'I'
 )
 
+// String Returns the letter that encodes the variable type (e.g., "C" for Continuous).
+func (vt VarType) String() string {
+	return string(rune(vt))
+}
+
 // UniqueVars This function creates a slice of unique variables from the slice given in
 // varsIn
 func UniqueVars(varsIn []Variable) []Variable {
@@ -260,8 +294,10 @@ func UniqueVars(varsIn []Variable) []Variable {
 
 	// Algorithm
 	var varsOut []Variable
+	seenIDs := make(map[uint64]bool, len(varsIn))
 	for _, v := range varsIn {
-		if vIndex, _ := FindInSlice(v, varsOut); vIndex == -1 { // If v is not yet in varsOut, then add it
+		if !seenIDs[v.ID] { // If v is not yet in varsOut, then add it
+			seenIDs[v.ID] = true
 			varsOut = append(varsOut, v)
 		}
 	}
@@ -315,9 +351,14 @@ func (v Variable) Multiply(rightIn interface{}) Expression {
 				Exponents:       []int{2},
 			}
 		} else {
+			// Keep the factors sorted by ID (see Monomial.isCanonical)
+			first, second := v, right
+			if second.ID < first.ID {
+				first, second = second, first
+			}
 			monomialOut = Monomial{
 				Coefficient:     1.0,
-				VariableFactors: []Variable{v, right},
+				VariableFactors: []Variable{first, second},
 				Exponents:       []int{1, 1},
 			}
 		}
@@ -328,6 +369,10 @@ func (v Variable) Multiply(rightIn interface{}) Expression {
 	case Polynomial:
 		// Create a new vector of polynomials.
 		out = right.Multiply(v)
+	case RationalExpression:
+		out = right.Multiply(v)
+	case FunctionExpression:
+		out = productOf(v, right)
 	case *mat.VecDense:
 		out = v.Multiply(*right)
 	case mat.VecDense:
@@ -357,9 +402,17 @@ func (v Variable) Dims() []int {
 
 // Check Checks whether the Variable has a sensible initialization.
 func (v Variable) Check() error {
-	// Check that the lower bound is below is the upper bound
-	if v.Lower >= v.Upper {
-		return fmt.Errorf(
+	// Check that the variable is not the zero Variable{} (which was never created)
+	if v.isZero() {
+		return smErrors.Errorf(
+			"variable is the zero Variable{}; create variables with NewVariable() or NewCustomVariable().",
+		)
+	}
+
+	// Check that the lower bound is not above the upper bound
+	// (fixed variables, e.g. the FX columns of an MPS file, have equal bounds)
+	if v.Lower > v.Upper {
+		return smErrors.Errorf(
 			"lower bound (%v) of variable must be less than upper bound (%v).",
 			v.Lower, v.Upper,
 		)
@@ -369,6 +422,12 @@ func (v Variable) Check() error {
 	return nil
 }
 
+// isZero returns true if v is the zero Variable{}.
+func (v Variable) isZero() bool {
+	return v.ID == 0 && v.Lower == 0 && v.Upper == 0 &&
+		v.Type == 0 && v.Name == "" && v.Environment == nil
+}
+
 // Transpose returns the variable itself, as the transpose of a scalar is itself.
 func (v Variable) Transpose() Expression {
 	return v
@@ -385,28 +444,31 @@ func NewContinuousVariable(envs ...Environment) Variable {
 	// Constants
 
 	// Input Processing
-	var currentEnv Environment = &DefaultEnvironment
+	var currentEnv Environment = DefaultEnvironment
 	switch len(envs) {
 	case 1:
 		currentEnv = envs[0]
 	}
 
-	// Get New Index
-	nextIdx := len(currentEnv.AllTrackedVariables())
+	// Get New Index and add the new variable to the environment
+	// (skipping the IDs whose default name is already used by another variable)
+	var variableOut Variable
+	for {
+		nextIdx := currentEnv.AllocateVariableID()
+		variableOut = Variable{
+			ID:          nextIdx,
+			Lower:       float64(-Infinity),
+			Upper:       float64(+Infinity),
+			Type:        Continuous,
+			Name:        fmt.Sprintf("x_%v", nextIdx),
+			Environment: currentEnv,
+		}
 
-	// Create variable
-	variableOut := Variable{
-		ID:          uint64(nextIdx),
-		Lower:       float64(-Infinity),
-		Upper:       float64(+Infinity),
-		Type:        Continuous,
-		Name:        fmt.Sprintf("x_%v", nextIdx),
-		Environment: currentEnv,
+		if currentEnv.TrackVariable(variableOut) {
+			break
+		}
 	}
 
-	// Update environment
-	currentEnv.TrackVariable(variableOut)
-
 	return variableOut
 
 }
@@ -416,30 +478,76 @@ func NewBinaryVariable(envs ...Environment) Variable {
 	// Constants
 
 	// Input Processing
-	var currentEnv Environment = &DefaultEnvironment
+	var currentEnv Environment = DefaultEnvironment
+	switch len(envs) {
+	case 1:
+		currentEnv = envs[0]
+	}
+
+	// Get New Index and add the new variable to the environment
+	// (skipping the IDs whose default name is already used by another variable)
+	var variableOut Variable
+	for {
+		nextIdx := currentEnv.AllocateVariableID()
+		variableOut = Variable{
+			ID:          nextIdx,
+			Lower:       0.0,
+			Upper:       1.0,
+			Type:        Binary,
+			Name:        fmt.Sprintf("x_%v", nextIdx),
+			Environment: currentEnv,
+		}
+
+		if currentEnv.TrackVariable(variableOut) {
+			break
+		}
+	}
+
+	return variableOut
+
+}
+
+// NewCustomVariable Creates a new variable with the given type, bounds and name
+// in the given environment (or the default environment if none is provided).
+// Panics if the environment already tracks a variable with the same name.
+func NewCustomVariable(vType VarType, lower, upper float64, name string, envs ...Environment) Variable {
+	// Constants
+
+	// Input Processing
+	var currentEnv Environment = DefaultEnvironment
 	switch len(envs) {
 	case 1:
 		currentEnv = envs[0]
 	}
 
 	// Get New Index
-	nextIdx := len(currentEnv.AllTrackedVariables())
+	nextIdx := currentEnv.AllocateVariableID()
 
-	// Get New Variable Object and add it to environment
+	// Create variable
 	variableOut := Variable{
-		ID:          uint64(nextIdx),
-		Lower:       0.0,
-		Upper:       1.0,
-		Type:        Binary,
-		Name:        fmt.Sprintf("x_%v", nextIdx),
+		ID:          nextIdx,
+		Lower:       lower,
+		Upper:       upper,
+		Type:        vType,
+		Name:        name,
 		Environment: currentEnv,
 	}
 
-	// Update env
-	currentEnv.TrackVariable(variableOut)
+	err := variableOut.Check()
+	if err != nil {
+		panic(err)
+	}
 
-	return variableOut
+	// Update environment (names must be unique)
+	if !currentEnv.TrackVariable(variableOut) {
+		panic(smErrors.DuplicateVariableError{
+			EnvironmentName: currentEnv.GetName(),
+			ID:              variableOut.ID,
+			Name:            variableOut.Name,
+		})
+	}
 
+	return variableOut
 }
 
 // ToMonomial Converts the variable into a monomial.
@@ -544,10 +652,19 @@ func (v Variable) SubstituteAccordingTo(subMap map[Variable]Expression) Expressi
 }
 
 // Power Computes the power of the variable.
+// Negative exponents produce a RationalExpression (e.g., x^-2 = 1/(x^2)).
 func (v Variable) Power(exponent int) Expression {
+	if exponent < 0 {
+		return scalarReciprocalPower(v, exponent)
+	}
 	return ScalarPowerTemplate(v, exponent)
 }
 
+// Divide Computes the ratio of the variable and another scalar expression.
+func (v Variable) Divide(rightIn interface{}) Expression {
+	return ScalarDivideTemplate(v, rightIn)
+}
+
 // At Returns the value at the given row and column index.
 // Note:
 //
@@ -585,3 +702,49 @@ func UnionOfVariables(varSlices ...[]Variable) []Variable {
 func (v Variable) AsSimplifiedExpression() Expression {
 	return v
 }
+
+// Evaluate Returns the value of the variable given in the values map.
+// If the variable is not in the map, then this panics with a MissingVariableValueError.
+func (v Variable) Evaluate(values map[Variable]float64) float64 {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	value, ok := values[v]
+	if !ok {
+		panic(
+			smErrors.MissingVariableValueError{
+				Variable:   v,
+				Expression: v,
+			},
+		)
+	}
+
+	return value
+}
+
+// EvaluateAt Returns the value of the variable at the point x.
+func (v Variable) EvaluateAt(x mat.VecDense, wrt ...[]Variable) float64 {
+	return v.Evaluate(valueMapForEvaluateAt(v, x, wrt))
+}
+
+// Equals Returns true if the variable and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (v Variable) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(v, other, tol)
+}
+
+// Canonicalize Returns the variable (variables are always in canonical form).
+func (v Variable) Canonicalize() Expression {
+	// Input Processing
+	err := v.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return v
+}
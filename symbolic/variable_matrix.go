@@ -58,7 +58,7 @@ func (vm VariableMatrix) Check() error {
 		for jj, v := range vmRow {
 			err := v.Check()
 			if err != nil {
-				return fmt.Errorf(
+				return smErrors.Errorf(
 					"error in entry (%v, %v): %v",
 					ii, jj,
 					err,
@@ -114,7 +114,7 @@ func (vm VariableMatrix) Plus(e interface{}) Expression {
 		err = eAsE.Check()
 		if err != nil {
 			panic(
-				fmt.Errorf("error in second argument to VariableMatrix.Plus: %v", err),
+				smErrors.Errorf("error in second argument to VariableMatrix.Plus: %v", err),
 			)
 		}
 
@@ -178,7 +178,7 @@ func (vm VariableMatrix) Minus(e interface{}) Expression {
 		err = eAsE.Check()
 		if err != nil {
 			panic(
-				fmt.Errorf("error in second argument to VariableMatrix.Minus: %v", err),
+				smErrors.Errorf("error in second argument to VariableMatrix.Minus: %v", err),
 			)
 		}
 
@@ -225,7 +225,7 @@ func (vm VariableMatrix) Multiply(e interface{}) Expression {
 		err = eAsE.Check()
 		if err != nil {
 			panic(
-				fmt.Errorf("error in second argument to VariableMatrix.Multiply: %v", err),
+				smErrors.Errorf("error in second argument to VariableMatrix.Multiply: %v", err),
 			)
 		}
 
@@ -252,6 +252,10 @@ func (vm VariableMatrix) Multiply(e interface{}) Expression {
 			mmOut = append(mmOut, mmRow)
 		}
 		out = mmOut
+	case RationalExpression:
+		out = right.Multiply(vm)
+	case FunctionExpression:
+		out = right.Multiply(vm)
 	case KVector:
 		// Constants
 		vmRows, vmCols := vm.Dims()[0], vm.Dims()[1]
@@ -273,6 +277,10 @@ func (vm VariableMatrix) Multiply(e interface{}) Expression {
 	case mat.VecDense:
 		// Use the KVector case
 		out = vm.Multiply(VecDenseToKVector(right))
+	case FunctionExpressionVector:
+		out = MatrixMultiplyTemplate(vm, right.Transpose().Transpose().(FunctionExpressionMatrix))
+	case FunctionExpressionMatrix:
+		out = MatrixMultiplyTemplate(vm, right)
 	case VariableVector:
 		// Output will be another vector
 		nVMCols := vm.Dims()[1]
@@ -349,7 +357,7 @@ func (vm VariableMatrix) Comparison(rightIn interface{}, sense ConstrSense) Cons
 		err = rightAsE.Check()
 		if err != nil {
 			panic(
-				fmt.Errorf("error in second argument to VariableMatrix.Comparison: %v", err),
+				smErrors.Errorf("error in second argument to VariableMatrix.Comparison: %v", err),
 			)
 		}
 
@@ -497,12 +505,12 @@ func NewVariableMatrixClassic(nRows, nCols int, envs ...Environment) VariableMat
 	var env Environment
 	switch len(envs) {
 	case 0:
-		env = &DefaultEnvironment
+		env = DefaultEnvironment
 	case 1:
 		env = envs[0]
 	default:
 		panic(
-			fmt.Errorf("Too many inputs provided to NewVariableMatrix() method"),
+			smErrors.Errorf("Too many inputs provided to NewVariableMatrix() method"),
 		)
 	}
 
@@ -600,3 +608,31 @@ func (vm VariableMatrix) Power(exponent int) Expression {
 func (vm VariableMatrix) AsSimplifiedExpression() Expression {
 	return vm
 }
+
+// Evaluate Returns the value of the variable matrix when each variable takes the value given in the values map.
+func (vm VariableMatrix) Evaluate(values map[Variable]float64) mat.Dense {
+	return MatrixEvaluateTemplate(vm, values)
+}
+
+// EvaluateAt Returns the value of the variable matrix at the point x.
+func (vm VariableMatrix) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.Dense {
+	return vm.Evaluate(valueMapForEvaluateAt(vm, x, wrt))
+}
+
+// Equals Returns true if the matrix and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (vm VariableMatrix) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(vm, other, tol)
+}
+
+// Canonicalize Returns the matrix (variable matrices are always in canonical form).
+func (vm VariableMatrix) Canonicalize() Expression {
+	// Input Processing
+	err := vm.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return vm
+}
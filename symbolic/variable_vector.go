@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -241,6 +239,12 @@ func (vv VariableVector) Eq(rightIn interface{}) Constraint {
 
 }
 
+// Between returns the elementwise range constraint lower <= vv <= upper.
+// Each bound is either a constant or a constant vector with the same length as vv.
+func (vv VariableVector) Between(lower, upper interface{}) Constraint {
+	return newVectorRangeConstraint(vv, lower, upper)
+}
+
 // Comparison This method creates a constraint of type sense between
 // the receiver (as left hand side) and rhs (as right hand side) if both are valid.
 func (vv VariableVector) Comparison(rightIn interface{}, sense ConstrSense) Constraint {
@@ -331,7 +335,7 @@ func (vv VariableVector) Check() error {
 	for ii, element := range vv {
 		err := element.Check()
 		if err != nil {
-			return fmt.Errorf(
+			return smErrors.Errorf(
 				"element %v has an issue: %v",
 				ii, err,
 			)
@@ -376,7 +380,7 @@ func NewVariableVector(N int, envs ...Environment) VariableVector {
 	// Constants
 
 	// Input Processing
-	var currentEnv Environment = &DefaultEnvironment
+	var currentEnv Environment = DefaultEnvironment
 	switch len(envs) {
 	case 1:
 		currentEnv = envs[0]
@@ -504,3 +508,31 @@ func (vv VariableVector) ToScalarExpressions() []ScalarExpression {
 	}
 	return out
 }
+
+// Evaluate Returns the value of the variable vector when each variable takes the value given in the values map.
+func (vv VariableVector) Evaluate(values map[Variable]float64) mat.VecDense {
+	return VectorEvaluateTemplate(vv, values)
+}
+
+// EvaluateAt Returns the value of the variable vector at the point x.
+func (vv VariableVector) EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.VecDense {
+	return vv.Evaluate(valueMapForEvaluateAt(vv, x, wrt))
+}
+
+// Equals Returns true if the vector and other represent the same expression,
+// where the coefficients of matching terms may differ by at most tol.
+func (vv VariableVector) Equals(other Expression, tol float64) bool {
+	return expressionsAreEqual(vv, other, tol)
+}
+
+// Canonicalize Returns the vector (variable vectors are always in canonical form).
+func (vv VariableVector) Canonicalize() Expression {
+	// Input Processing
+	err := vv.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return vv
+}
@@ -1,8 +1,6 @@
 package symbolic
 
 import (
-	"fmt"
-
 	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"gonum.org/v1/gonum/mat"
 )
@@ -101,6 +99,19 @@ func (vc VectorConstraint) IsLinear() bool {
 	return IsLinear(vc.RightHandSide) && IsLinear(vc.LeftHandSide)
 }
 
+// IsConvex Describes whether the set of points that satisfy the given vector
+// constraint is convex (according to the curvature of its left hand side minus its right hand side).
+func (vc VectorConstraint) IsConvex() bool {
+	// Input Processing
+	err := vc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return constraintIsConvex(vc)
+}
+
 // LinearInequalityConstraintRepresentation Returns the linear constraint representation of the scalar constraint.
 // Returns a tuple of the form (A, b) where A is a vector and b is a constant such that:
 // A.Dot(x) <= b
@@ -322,13 +333,16 @@ func (vc VectorConstraint) ImpliesThisIsAlsoSatisfied(other Constraint) bool {
 				return true
 			}
 		}
-	case VectorConstraint, MatrixConstraint:
+	case ScalarRangeConstraint:
+		// Both halves of the range constraint must be implied.
+		return impliesAllOf(vc, otherC.AsScalarConstraints())
+	case VectorConstraint, MatrixConstraint, VectorRangeConstraint, SecondOrderConeConstraint:
 		// TODO: Implement more advanced implication checks.
 		return false
 	default:
 		// Other types of constraints are not currently supported.
 		panic(
-			fmt.Errorf("implication checking between VectorConstraint and %T is not currently supported", other),
+			smErrors.Errorf("implication checking between VectorConstraint and %T is not currently supported", other),
 		)
 	}
 
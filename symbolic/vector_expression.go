@@ -60,6 +60,10 @@ type VectorExpression interface {
 	// and another
 	Eq(rhs interface{}) Constraint
 
+	// Between returns the elementwise range constraint lower <= expression <= upper
+	// (a VectorRangeConstraint)
+	Between(lower, upper interface{}) Constraint
+
 	// Len returns the length of the vector expression.
 	Len() int
 
@@ -93,9 +97,23 @@ type VectorExpression interface {
 	// Simplify simplifies the expression and returns the simplified version
 	AsSimplifiedExpression() Expression
 
+	// Equals returns true if the current expression and other represent the same
+	// expression (the coefficients of matching terms may differ by at most tol)
+	Equals(other Expression, tol float64) bool
+
+	// Canonicalize returns the expression in canonical form (see canonical.go)
+	Canonicalize() Expression
+
 	// ToScalarExpressions
 	// Converts the given VectorExpression into a slice of ScalarExpression interface objects
 	ToScalarExpressions() []ScalarExpression
+
+	// Evaluate returns the value of the expression when each variable takes the value given in values
+	Evaluate(values map[Variable]float64) mat.VecDense
+
+	// EvaluateAt returns the value of the expression at the point x, where the (ii)th element of x
+	// is the value of the (ii)th variable in wrt
+	EvaluateAt(x mat.VecDense, wrt ...[]Variable) mat.VecDense
 }
 
 // IsVectorExpression Determines whether or not an input object is a valid "VectorExpression" according to MatProInterface.
@@ -114,6 +132,8 @@ func IsVectorExpression(e interface{}) bool {
 		return true
 	case PolynomialVector:
 		return true
+	case FunctionExpressionVector:
+		return true
 	default:
 		return false
 
@@ -124,7 +144,7 @@ func IsVectorExpression(e interface{}) bool {
 func ToVectorExpression(e interface{}) (VectorExpression, error) {
 	// Input Processing
 	if !IsVectorExpression(e) {
-		return VecDenseToKVector(OnesVector(1)), fmt.Errorf(
+		return VecDenseToKVector(OnesVector(1)), smErrors.Errorf(
 			"the input interface is of type %T, which is not recognized as a VectorExpression.",
 			e,
 		)
@@ -144,8 +164,10 @@ func ToVectorExpression(e interface{}) (VectorExpression, error) {
 		return e2, nil
 	case PolynomialVector:
 		return e2, nil
+	case FunctionExpressionVector:
+		return e2, nil
 	default:
-		return VecDenseToKVector(OnesVector(1)), fmt.Errorf(
+		return VecDenseToKVector(OnesVector(1)), smErrors.Errorf(
 			"unexpected vector expression conversion requested for type %T!",
 			e,
 		)
@@ -157,7 +179,7 @@ func ConcretizeVectorExpression(sliceIn []ScalarExpression) VectorExpression {
 	// Input Processing
 	if len(sliceIn) == 0 {
 		panic(
-			fmt.Errorf(
+			smErrors.Errorf(
 				"the input slice is empty, which is not recognized as a VectorExpression.",
 			),
 		)
@@ -170,6 +192,7 @@ func ConcretizeVectorExpression(sliceIn []ScalarExpression) VectorExpression {
 		containsVariable   bool = false
 		containsMonomial   bool = false
 		containsPolynomial bool = false
+		containsFunction   bool = false
 	)
 
 	for _, expr := range sliceIn {
@@ -186,15 +209,23 @@ func ConcretizeVectorExpression(sliceIn []ScalarExpression) VectorExpression {
 			containsMonomial = true
 		case Polynomial:
 			containsPolynomial = true
+		case RationalExpression, FunctionExpression:
+			containsFunction = true
 		default:
 			panic(
-				fmt.Errorf("unexpected expression type in vector expression: %T", expr),
+				smErrors.Errorf("unexpected expression type in vector expression: %T", expr),
 			)
 		}
 	}
 
 	// Convert
 	switch {
+	case containsFunction:
+		// Keep the elements as they are in a function expression vector
+		out := make(FunctionExpressionVector, len(sliceIn))
+		copy(out, sliceIn)
+		return out
+
 	case containsPolynomial:
 		// Convert to a polynomial vector
 		var out PolynomialVector
@@ -269,7 +300,7 @@ func ConcretizeVectorExpression(sliceIn []ScalarExpression) VectorExpression {
 			i2AsK, tf := i2.(K)
 			if !tf {
 				panic(
-					fmt.Errorf(
+					smErrors.Errorf(
 						"unexpected expression type in vector expression at %v: %T",
 						ii,
 						i2,
@@ -283,7 +314,7 @@ func ConcretizeVectorExpression(sliceIn []ScalarExpression) VectorExpression {
 
 	default:
 		panic(
-			fmt.Errorf(
+			smErrors.Errorf(
 				"unrecognized vector expression type in ConcretizeVectorExpression.\n"+
 					"containsConstant = %v\n"+
 					"isAllVariables = %v\n"+
@@ -343,7 +374,7 @@ func VectorPowerTemplate(base VectorExpression, exponent int) Expression {
 
 	if base.Len() != 1 {
 		panic(
-			fmt.Errorf(
+			smErrors.Errorf(
 				"the Power operation is only defined for vectors of length 1, but the input vector has length %v.",
 				base.Len(),
 			),
@@ -414,3 +445,24 @@ func VectorPlusTemplate(ve VectorExpression, right Expression) Expression {
 
 	return ConcretizeExpression(sum)
 }
+
+// VectorEvaluateTemplate Defines the template for the vector evaluation operation.
+// Each element of the vector expression is evaluated using the values in the map.
+// If one of the variables of ve is not in the map, then this panics with a MissingVariableValueError.
+func VectorEvaluateTemplate(ve VectorExpression, values map[Variable]float64) mat.VecDense {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	checkValuesForEvaluate(ve, values)
+
+	// Algorithm
+	out := ZerosVector(ve.Len())
+	for ii := 0; ii < ve.Len(); ii++ {
+		out.SetVec(ii, ve.AtVec(ii).Evaluate(values))
+	}
+
+	return out
+}
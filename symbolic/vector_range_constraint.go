@@ -0,0 +1,356 @@
+package symbolic
+
+import (
+	"fmt"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"gonum.org/v1/gonum/mat"
+)
+
+// VectorRangeConstraint represents the elementwise two-sided constraint
+//
+//	LowerBound <= Expression <= UpperBound
+//
+// as a single constraint. An element can be left unbounded on one side by setting
+// its bound to -Infinity (respectively, +Infinity). As for ScalarRangeConstraint,
+// use Lower and Upper (and not Right) to access the bounds.
+type VectorRangeConstraint struct {
+	Expression VectorExpression
+	LowerBound KVector
+	UpperBound KVector
+}
+
+// newVectorRangeConstraint Creates the range constraint lower <= ve <= upper.
+// Each bound is either a constant (float64, int or K), which bounds all of the elements
+// of ve, or a constant vector (KVector or mat.VecDense) with the same length as ve.
+func newVectorRangeConstraint(ve VectorExpression, lower, upper interface{}) VectorRangeConstraint {
+	// Input Processing
+	err := ve.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	vrc := VectorRangeConstraint{
+		Expression: ve,
+		LowerBound: toVectorRangeBound(lower, ve.Len()),
+		UpperBound: toVectorRangeBound(upper, ve.Len()),
+	}
+
+	err = vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return vrc
+}
+
+// toVectorRangeBound Converts the bound of a vector range constraint of length n
+// into a constant vector.
+func toVectorRangeBound(bound interface{}, n int) KVector {
+	switch bound.(type) {
+	case float64, int, K:
+		return VecDenseToKVector(OnesVector(n)).Multiply(toRangeBound(bound)).(KVector)
+	}
+
+	boundAsVE, err := ToVectorExpression(bound)
+	if err != nil {
+		panic(smErrors.UnsupportedInputError{FunctionName: "Between", Input: bound})
+	}
+
+	boundAsKV, tf := boundAsVE.(KVector)
+	if !tf {
+		panic(smErrors.UnsupportedInputError{FunctionName: "Between", Input: bound})
+	}
+
+	return boundAsKV
+}
+
+// Left Returns the constrained expression of the range constraint.
+func (vrc VectorRangeConstraint) Left() Expression {
+	return vrc.Expression
+}
+
+// Right Returns the bounds of the range constraint as a matrix whose columns are
+// LowerBound and UpperBound. This is not a right hand side that can be compared with
+// Left (e.g., Left().Minus(Right()) is meaningless); use Lower and Upper instead.
+func (vrc VectorRangeConstraint) Right() Expression {
+	var bounds KMatrix
+	for ii := range vrc.LowerBound {
+		bounds = append(bounds, []K{vrc.LowerBound[ii], vrc.UpperBound[ii]})
+	}
+	return bounds
+}
+
+// Lower Returns the lower bounds of the elements of the range constraint.
+func (vrc VectorRangeConstraint) Lower() KVector {
+	return vrc.LowerBound
+}
+
+// Upper Returns the upper bounds of the elements of the range constraint.
+func (vrc VectorRangeConstraint) Upper() KVector {
+	return vrc.UpperBound
+}
+
+// ConstrSense Returns SenseRange. Use AsScalarConstraints to obtain the range constraint
+// as comparisons.
+func (vrc VectorRangeConstraint) ConstrSense() ConstrSense {
+	return SenseRange
+}
+
+// Check Checks that the VectorRangeConstraint is valid: its expression is well formed,
+// its bounds have the same length as its expression and no lower bound is greater than
+// the corresponding upper bound.
+func (vrc VectorRangeConstraint) Check() error {
+	// Check the expression
+	if vrc.Expression == nil {
+		return smErrors.Errorf("the expression of the range constraint is nil")
+	}
+
+	err := vrc.Expression.Check()
+	if err != nil {
+		return err
+	}
+
+	// Check the dimensions of the bounds
+	for _, bound := range []KVector{vrc.LowerBound, vrc.UpperBound} {
+		if bound.Len() != vrc.Expression.Len() {
+			return smErrors.VectorDimensionError{
+				Operation: "Check",
+				Arg1:      vrc.Expression,
+				Arg2:      bound,
+			}
+		}
+	}
+
+	// Check the bounds
+	for ii := range vrc.LowerBound {
+		if vrc.LowerBound[ii] > vrc.UpperBound[ii] {
+			return smErrors.Errorf(
+				"the lower bound of element %v of the range constraint (%v) is greater than its upper bound (%v)",
+				ii, vrc.LowerBound[ii], vrc.UpperBound[ii],
+			)
+		}
+	}
+
+	// All Checks Passed!
+	return nil
+}
+
+// Dims Returns the dimensions of the range constraint (the dimensions of its expression).
+func (vrc VectorRangeConstraint) Dims() []int {
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return vrc.Expression.Dims()
+}
+
+// Len Returns the length of the range constraint.
+func (vrc VectorRangeConstraint) Len() int {
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	return vrc.Expression.Len()
+}
+
+// AtVec Retrieves the range constraint formed by one element of the vector range constraint.
+func (vrc VectorRangeConstraint) AtVec(i int) ScalarRangeConstraint {
+	// Input Processing
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check to see whether or not the index is valid.
+	err = smErrors.CheckIndexOnVector(i, vrc)
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return ScalarRangeConstraint{
+		Expression: vrc.Expression.AtVec(i),
+		LowerBound: vrc.LowerBound[i],
+		UpperBound: vrc.UpperBound[i],
+	}
+}
+
+// IsLinear Describes whether the expression of the range constraint is linear or not.
+func (vrc VectorRangeConstraint) IsLinear() bool {
+	return IsLinear(vrc.Expression)
+}
+
+// IsConvex Describes whether the set of points that satisfy the range constraint is
+// convex: each of its elements must be convex.
+func (vrc VectorRangeConstraint) IsConvex() bool {
+	// Input Processing
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	return constraintIsConvex(vrc)
+}
+
+// AsScalarConstraints Decomposes the range constraint into scalar constraints
+// (see ScalarRangeConstraint.AsScalarConstraints), element by element.
+func (vrc VectorRangeConstraint) AsScalarConstraints() []ScalarConstraint {
+	// Input Processing
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	var out []ScalarConstraint
+	for ii := 0; ii < vrc.Len(); ii++ {
+		out = append(out, vrc.AtVec(ii).AsScalarConstraints()...)
+	}
+	return out
+}
+
+// LinearRangeRepresentation Returns the linear representation of the range constraint.
+// Returns a tuple of the form (A, lo, hi) where A is a matrix and lo and hi are vectors such that:
+// lo <= A.Multiply(x) <= hi
+func (vrc VectorRangeConstraint) LinearRangeRepresentation(wrt ...[]Variable) (A mat.Dense, lo, hi mat.VecDense) {
+	// Check that the constraint is well formed.
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Check that the constraint is linear.
+	if !vrc.IsLinear() {
+		panic(smErrors.LinearExpressionRequiredError{
+			Operation:  "LinearRangeRepresentation",
+			Expression: vrc.Expression,
+		})
+	}
+
+	// Algorithm
+	A = vrc.Expression.LinearCoeff(wrt...)
+
+	constant := vrc.Expression.Constant()
+	lo = *mat.NewVecDense(vrc.Len(), nil)
+	hi = *mat.NewVecDense(vrc.Len(), nil)
+	for ii := 0; ii < vrc.Len(); ii++ {
+		lo.SetVec(ii, float64(shiftRangeBound(vrc.LowerBound[ii], -constant.AtVec(ii))))
+		hi.SetVec(ii, float64(shiftRangeBound(vrc.UpperBound[ii], -constant.AtVec(ii))))
+	}
+
+	return A, lo, hi
+}
+
+// Substitute Substitutes the variable vIn with the scalar expression seIn in the range constraint.
+func (vrc VectorRangeConstraint) Substitute(vIn Variable, seIn ScalarExpression) Constraint {
+	// Check that the constraint is well formed.
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Return the new constraint
+	return VectorRangeConstraint{
+		Expression: vrc.Expression.Substitute(vIn, seIn).(VectorExpression),
+		LowerBound: vrc.LowerBound,
+		UpperBound: vrc.UpperBound,
+	}
+}
+
+// SubstituteAccordingTo Substitutes the variables in the map with the corresponding expressions
+// in the range constraint.
+func (vrc VectorRangeConstraint) SubstituteAccordingTo(subMap map[Variable]Expression) Constraint {
+	// Check that the constraint is well formed.
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Return the new constraint
+	return VectorRangeConstraint{
+		Expression: vrc.Expression.SubstituteAccordingTo(subMap).(VectorExpression),
+		LowerBound: vrc.LowerBound,
+		UpperBound: vrc.UpperBound,
+	}
+}
+
+// AsSimplifiedConstraint Simplifies the constraint by moving the constants of its expression into its bounds.
+func (vrc VectorRangeConstraint) AsSimplifiedConstraint() Constraint {
+	// Input Checking
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	constant := vrc.Expression.Constant()
+	newLower, newUpper := make(KVector, vrc.Len()), make(KVector, vrc.Len())
+	for ii := 0; ii < vrc.Len(); ii++ {
+		newLower[ii] = shiftRangeBound(vrc.LowerBound[ii], -constant.AtVec(ii))
+		newUpper[ii] = shiftRangeBound(vrc.UpperBound[ii], -constant.AtVec(ii))
+	}
+
+	return VectorRangeConstraint{
+		Expression: vrc.Expression.Minus(constant).(VectorExpression),
+		LowerBound: newLower,
+		UpperBound: newUpper,
+	}
+}
+
+// Variables Returns a slice of all the variables in the constraint.
+func (vrc VectorRangeConstraint) Variables() []Variable {
+	return VariablesInThisConstraint(vrc)
+}
+
+// ImpliesThisIsAlsoSatisfied Returns true if this constraint implies that the other constraint is also satisfied.
+func (vrc VectorRangeConstraint) ImpliesThisIsAlsoSatisfied(other Constraint) bool {
+	// Input Processing
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	err = other.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Algorithm
+	switch otherC := other.(type) {
+	case ScalarConstraint, ScalarRangeConstraint:
+		// Naive implication check:
+		// One of the elements of the range constraint produces the correct implications.
+		for ii := 0; ii < vrc.Len(); ii++ {
+			if vrc.AtVec(ii).ImpliesThisIsAlsoSatisfied(otherC) {
+				return true
+			}
+		}
+	case VectorConstraint, MatrixConstraint, VectorRangeConstraint, SecondOrderConeConstraint:
+		// TODO: Implement more advanced implication checks.
+		return false
+	default:
+		// Other types of constraints are not currently supported.
+		panic(
+			smErrors.Errorf("implication checking between VectorRangeConstraint and %T is not currently supported", other),
+		)
+	}
+
+	return false
+}
+
+// String Returns a string representation of the range constraint.
+func (vrc VectorRangeConstraint) String() string {
+	// Check that the constraint is well formed.
+	err := vrc.Check()
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the string representation
+	return fmt.Sprintf("%v <= %v <= %v", vrc.LowerBound, vrc.Expression, vrc.UpperBound)
+}
@@ -0,0 +1,284 @@
+package symbolic_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+basic_environment_test.go
+Description:
+	Tests for the functions mentioned in the basic_environment.go file.
+*/
+
+/*
+TestBasicEnvironment_AllocateVariableID1
+Description:
+
+	Tests that variables created concurrently (from many goroutines) in the
+	same environment all receive different IDs and are all tracked.
+*/
+func TestBasicEnvironment_AllocateVariableID1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestBasicEnvironment_AllocateVariableID1")
+	nGoroutines, nVariablesEach := 8, 250
+
+	// Test
+	var wg sync.WaitGroup
+	for ii := 0; ii < nGoroutines; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for jj := 0; jj < nVariablesEach; jj++ {
+				if jj%2 == 0 {
+					symbolic.NewVariable(env)
+				} else {
+					symbolic.NewBinaryVariable(env)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	trackedVars := env.AllTrackedVariables()
+	if len(trackedVars) != nGoroutines*nVariablesEach {
+		t.Errorf(
+			"expected %v tracked variables; received %v",
+			nGoroutines*nVariablesEach,
+			len(trackedVars),
+		)
+	}
+
+	seenIDs := map[uint64]bool{}
+	for _, v := range trackedVars {
+		if seenIDs[v.ID] {
+			t.Errorf("expected all IDs to be unique; ID %v was allocated twice", v.ID)
+		}
+		seenIDs[v.ID] = true
+	}
+}
+
+/*
+TestBasicEnvironment_AllocateVariableID2
+Description:
+
+	Tests that IDs allocated after a variable with a large ID was tracked
+	do not collide with that variable.
+*/
+func TestBasicEnvironment_AllocateVariableID2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestBasicEnvironment_AllocateVariableID2")
+	external := symbolic.Variable{
+		ID:          10,
+		Lower:       -1.0,
+		Upper:       1.0,
+		Type:        symbolic.Continuous,
+		Name:        "external",
+		Environment: env,
+	}
+
+	// Test
+	if !env.TrackVariable(external) {
+		t.Errorf("expected TrackVariable to add the external variable")
+	}
+
+	if env.TrackVariable(external) {
+		t.Errorf("expected TrackVariable to refuse tracking the same variable twice")
+	}
+
+	x := symbolic.NewVariable(env)
+	if x.ID != 11 {
+		t.Errorf("expected the new variable to have ID 11; received %v", x.ID)
+	}
+}
+
+/*
+TestBasicEnvironment_AllTrackedVariables1
+Description:
+
+	Tests that modifying the slice returned by AllTrackedVariables does not
+	modify the environment.
+*/
+func TestBasicEnvironment_AllTrackedVariables1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestBasicEnvironment_AllTrackedVariables1")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	trackedVars := env.AllTrackedVariables()
+	trackedVars[0].Name = "changed"
+
+	if env.AllTrackedVariables()[0].Name != x.Name {
+		t.Errorf(
+			"expected the environment to keep the name %v; received %v",
+			x.Name,
+			env.AllTrackedVariables()[0].Name,
+		)
+	}
+}
+
+/*
+TestBasicEnvironment_TrackVariable1
+Description:
+
+	Tests that the TrackVariable method refuses a variable whose name is
+	already used by another variable of the environment, but accepts several
+	variables without a name.
+*/
+func TestBasicEnvironment_TrackVariable1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestBasicEnvironment_TrackVariable1")
+	x := symbolic.NewVariable(env)
+	sameName := symbolic.Variable{ID: 100, Lower: 0.0, Upper: 1.0, Type: symbolic.Continuous, Name: x.Name}
+	unnamed1 := symbolic.Variable{ID: 101, Lower: 0.0, Upper: 1.0, Type: symbolic.Continuous}
+	unnamed2 := symbolic.Variable{ID: 102, Lower: 0.0, Upper: 1.0, Type: symbolic.Continuous}
+
+	// Test
+	if env.TrackVariable(sameName) {
+		t.Errorf("expected TrackVariable to refuse a second variable named %v", x.Name)
+	}
+
+	if !env.TrackVariable(unnamed1) || !env.TrackVariable(unnamed2) {
+		t.Errorf("expected TrackVariable to accept several variables without a name")
+	}
+
+	if len(env.AllTrackedVariables()) != 3 {
+		t.Errorf("expected 3 tracked variables; received %v", len(env.AllTrackedVariables()))
+	}
+}
+
+/*
+TestBasicEnvironment_TrackVariable2
+Description:
+
+	Tests that a zero BasicEnvironment (created without MakeBasicEnvironment)
+	can allocate IDs and track variables, and that the Variables field
+	holds the tracked variables.
+*/
+func TestBasicEnvironment_TrackVariable2(t *testing.T) {
+	// Constants
+	env := &symbolic.BasicEnvironment{}
+
+	// Test
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewVariable(env)
+	if x.ID == y.ID {
+		t.Errorf("expected the variables of a zero environment to have different IDs; received %v twice", x.ID)
+	}
+
+	if env.TrackVariable(x) {
+		t.Errorf("expected TrackVariable to refuse %v, which is already tracked", x)
+	}
+
+	if vars := env.Variables; len(vars) != 2 || vars[0].ID != x.ID || vars[1].ID != y.ID {
+		t.Errorf("expected Variables to return [%v %v]; received %v", x, y, vars)
+	}
+}
+
+/*
+TestBasicEnvironment_TrackVariable3
+Description:
+
+	Tests that a zero BasicEnvironment{} can be used directly: the lookups
+	work before and after the first variable is tracked and the IDs it
+	allocates do not collide with the tracked variables.
+*/
+func TestBasicEnvironment_TrackVariable3(t *testing.T) {
+	// Constants
+	env := &symbolic.BasicEnvironment{}
+	tracked := symbolic.Variable{ID: 7, Lower: 0.0, Upper: 1.0, Type: symbolic.Continuous, Name: "tracked"}
+
+	// Test
+	if _, tf := env.VariableByName("tracked"); tf {
+		t.Errorf("expected VariableByName(\"tracked\") to find nothing in an empty environment")
+	}
+
+	if !env.TrackVariable(tracked) {
+		t.Errorf("expected TrackVariable to add %v", tracked)
+	}
+
+	if found, tf := env.VariableByName("tracked"); !tf || found != tracked {
+		t.Errorf("expected VariableByName(\"tracked\") to return %v; received %v, %v", tracked, found, tf)
+	}
+
+	if found, tf := env.VariableByID(7); !tf || found != tracked {
+		t.Errorf("expected VariableByID(7) to return %v; received %v, %v", tracked, found, tf)
+	}
+
+	x := symbolic.NewVariable(env)
+	if x.ID == tracked.ID {
+		t.Errorf("expected a new ID; received %v", x.ID)
+	}
+}
+
+/*
+TestBasicEnvironment_VariableByID1
+Description:
+
+	Tests that the VariableByID method finds the tracked variables and
+	reports when there is no variable with the given ID.
+*/
+func TestBasicEnvironment_VariableByID1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestBasicEnvironment_VariableByID1")
+	x := symbolic.NewVariableVector(3, env)
+
+	// Test
+	for _, xi := range x {
+		found, tf := env.VariableByID(xi.ID)
+		if !tf || found != xi {
+			t.Errorf("expected VariableByID(%v) to return %v; received %v, %v", xi.ID, xi, found, tf)
+		}
+	}
+
+	if _, tf := env.VariableByID(3); tf {
+		t.Errorf("expected VariableByID(3) to find nothing")
+	}
+}
+
+/*
+TestBasicEnvironment_VariableByName1
+Description:
+
+	Tests that the VariableByName method finds the tracked variables and
+	reports when there is no variable with the given name.
+*/
+func TestBasicEnvironment_VariableByName1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestBasicEnvironment_VariableByName1")
+	x := symbolic.NewVariable(env)
+	flow := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 5.0, "flow", env)
+
+	// Test
+	if found, tf := env.VariableByName("x_0"); !tf || found != x {
+		t.Errorf("expected VariableByName(\"x_0\") to return %v; received %v, %v", x, found, tf)
+	}
+
+	if found, tf := env.VariableByName("flow"); !tf || found != flow {
+		t.Errorf("expected VariableByName(\"flow\") to return %v; received %v, %v", flow, found, tf)
+	}
+
+	if _, tf := env.VariableByName("missing"); tf {
+		t.Errorf("expected VariableByName(\"missing\") to find nothing")
+	}
+
+	if _, tf := env.VariableByName(""); tf {
+		t.Errorf("expected VariableByName(\"\") to find nothing")
+	}
+}
+
+/*
+BenchmarkBasicEnvironment_NewVariable
+Description:
+
+	Measures the cost of creating variables in a large environment
+	(which should not grow with the number of tracked variables).
+*/
+func BenchmarkBasicEnvironment_NewVariable(b *testing.B) {
+	env := symbolic.NewBasicEnvironment("BenchmarkBasicEnvironment_NewVariable")
+	for ii := 0; ii < b.N; ii++ {
+		symbolic.NewVariable(env)
+	}
+}
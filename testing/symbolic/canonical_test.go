@@ -0,0 +1,235 @@
+package symbolic_test
+
+/*
+canonical_test.go
+Description:
+	Tests for the Equals and Canonicalize methods (defined in each expression's file)
+	and the helpers in symbolic/canonical.go.
+*/
+
+import (
+	"testing"
+
+	getKMatrix "github.com/MatProGo-dev/SymbolicMath.go/get/KMatrix"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestMonomial_Canonicalize1
+Description:
+
+	Tests that the Canonicalize() method sorts the factors of a monomial by
+	variable ID, merges repeated factors and removes zero exponents.
+*/
+func TestMonomial_Canonicalize1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestMonomial_Canonicalize1")
+	x := symbolic.NewVariableVector(3, env)
+	m := symbolic.Monomial{
+		Coefficient:     2.0,
+		VariableFactors: []symbolic.Variable{x[2], x[0], x[1], x[2]},
+		Exponents:       []int{1, 3, 0, 2},
+	}
+
+	// Test
+	mOut, tf := m.Canonicalize().(symbolic.Monomial)
+	if !tf {
+		t.Errorf("expected Canonicalize() to return a Monomial; received %T", m.Canonicalize())
+	}
+
+	if len(mOut.VariableFactors) != 2 {
+		t.Errorf("expected 2 factors; received %v", len(mOut.VariableFactors))
+	}
+
+	if mOut.VariableFactors[0].ID != x[0].ID || mOut.Exponents[0] != 3 {
+		t.Errorf("expected the first factor to be %v^3; received %v", x[0], mOut)
+	}
+
+	if mOut.VariableFactors[1].ID != x[2].ID || mOut.Exponents[1] != 3 {
+		t.Errorf("expected the second factor to be %v^3; received %v", x[2], mOut)
+	}
+
+	if mOut.Coefficient != 2.0 {
+		t.Errorf("expected the coefficient to be 2; received %v", mOut.Coefficient)
+	}
+
+	// The original monomial should not be modified
+	if m.VariableFactors[0].ID != x[2].ID {
+		t.Errorf("expected Canonicalize() to leave the original monomial unchanged; received %v", m)
+	}
+}
+
+/*
+TestPolynomial_Canonicalize1
+Description:
+
+	Tests that the Canonicalize() method orders the terms of a polynomial
+	in graded lexicographic order, so that two polynomials built in different
+	orders have the same String().
+*/
+func TestPolynomial_Canonicalize1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPolynomial_Canonicalize1")
+	x := symbolic.NewVariableVector(2, env)
+	p1 := symbolic.K(1.0).Plus(x[1]).Plus(x[1].Power(2)).Plus(x[1].Multiply(x[0])).Plus(x[0].Power(2)).Plus(x[0])
+	p2 := x[0].Plus(x[0].Multiply(x[1])).Plus(x[0].Power(2)).Plus(x[1].Power(2)).Plus(x[1]).Plus(1.0)
+
+	// Test
+	expected := x[0].Power(2).(symbolic.ScalarExpression).String()
+	s1 := p1.(symbolic.Polynomial).Canonicalize().String()
+	s2 := p2.(symbolic.Polynomial).Canonicalize().String()
+	if s1 != s2 {
+		t.Errorf("expected the canonical forms to match; received %v and %v", s1, s2)
+	}
+
+	canonical := p1.(symbolic.Polynomial).Canonicalize().(symbolic.Polynomial)
+	if len(canonical.Monomials) != 6 {
+		t.Errorf("expected 6 terms; received %v", len(canonical.Monomials))
+	}
+
+	if canonical.Monomials[0].String() != expected {
+		t.Errorf("expected the first term to be %v; received %v", expected, canonical.Monomials[0])
+	}
+
+	expectedDegrees := []int{2, 2, 2, 1, 1, 0}
+	for ii, monomial := range canonical.Monomials {
+		if monomial.Degree() != expectedDegrees[ii] {
+			t.Errorf(
+				"expected term %v to have degree %v; received %v (%v)",
+				ii,
+				expectedDegrees[ii],
+				monomial.Degree(),
+				canonical,
+			)
+		}
+	}
+
+	if canonical.Monomials[1].VariableFactors[0].ID != x[0].ID ||
+		canonical.Monomials[1].VariableFactors[1].ID != x[1].ID {
+		t.Errorf("expected the second term to be x_0 x_1; received %v", canonical.Monomials[1])
+	}
+}
+
+/*
+TestPolynomial_Equals1
+Description:
+
+	Tests that the Equals() method ignores the order of terms and factors
+	and compares coefficients using the given tolerance.
+*/
+func TestPolynomial_Equals1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPolynomial_Equals1")
+	x := symbolic.NewVariableVector(2, env)
+	p1 := x[0].Multiply(x[1]).Plus(x[0]).Plus(3.0).(symbolic.Polynomial)
+	p2 := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 3.0 + 1e-9, VariableFactors: []symbolic.Variable{}, Exponents: []int{}},
+			{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x[1], x[0]}, Exponents: []int{1, 1}},
+			{Coefficient: 0.5, VariableFactors: []symbolic.Variable{x[0]}, Exponents: []int{1}},
+			{Coefficient: 0.5, VariableFactors: []symbolic.Variable{x[0]}, Exponents: []int{1}},
+		},
+	}
+
+	// Test
+	if !p1.Equals(p2, 1e-6) {
+		t.Errorf("expected %v to equal %v with tolerance 1e-6", p1, p2)
+	}
+
+	if p1.Equals(p2, 1e-12) {
+		t.Errorf("expected %v not to equal %v with tolerance 1e-12", p1, p2)
+	}
+
+	if p1.Equals(x[0].Multiply(x[1]), 1e-6) {
+		t.Errorf("expected %v not to equal %v", p1, x[0].Multiply(x[1]))
+	}
+}
+
+/*
+TestPolynomial_Equals2
+Description:
+
+	Tests that the Equals() method compares expressions of different types
+	(here, a constant and a polynomial with only a constant term).
+*/
+func TestPolynomial_Equals2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPolynomial_Equals2")
+	x := symbolic.NewVariable(env)
+	p := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			x.ToMonomial(),
+			symbolic.K(2.0).ToMonomial(),
+			x.ToMonomial().Multiply(-1.0).(symbolic.Monomial),
+		},
+	}
+
+	// Test
+	if !p.Equals(symbolic.K(2.0), 0.0) {
+		t.Errorf("expected %v to equal 2", p)
+	}
+
+	if !symbolic.K(2.0).Equals(p, 0.0) {
+		t.Errorf("expected 2 to equal %v", p)
+	}
+
+	if symbolic.K(2.0).Equals(nil, 0.0) {
+		t.Errorf("expected 2 not to equal nil")
+	}
+}
+
+/*
+TestPolynomialVector_Equals1
+Description:
+
+	Tests that the Equals() method of a vector compares elements pairwise and
+	returns false when the dimensions do not match.
+*/
+func TestPolynomialVector_Equals1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPolynomialVector_Equals1")
+	x := symbolic.NewVariableVector(2, env)
+	pv := x.Plus(symbolic.VecDenseToKVector(symbolic.OnesVector(2))).(symbolic.PolynomialVector)
+	swapped := symbolic.PolynomialVector{
+		symbolic.K(1.0).Plus(x[0]).(symbolic.Polynomial),
+		symbolic.K(1.0).Plus(x[1]).(symbolic.Polynomial),
+	}
+
+	// Test
+	if !pv.Equals(swapped, 0.0) {
+		t.Errorf("expected %v to equal %v", pv, swapped)
+	}
+
+	if pv.Equals(x[0], 0.0) {
+		t.Errorf("expected a vector of length 2 not to equal a scalar")
+	}
+
+	if pv.Canonicalize().(symbolic.PolynomialVector)[0].Monomials[0].Degree() != 1 {
+		t.Errorf("expected the canonical form to put the degree 1 term first; received %v", pv.Canonicalize())
+	}
+}
+
+/*
+TestKMatrix_Equals1
+Description:
+
+	Tests that the Equals() method of a constant matrix uses the tolerance.
+*/
+func TestKMatrix_Equals1(t *testing.T) {
+	// Constants
+	km1 := getKMatrix.From([][]float64{{1.0, 2.0}, {3.0, 4.0}})
+	km2 := getKMatrix.From([][]float64{{1.0, 2.0}, {3.0, 4.001}})
+
+	// Test
+	if !km1.Equals(km2, 0.01) {
+		t.Errorf("expected %v to equal %v with tolerance 0.01", km1, km2)
+	}
+
+	if km1.Equals(km2, 0.0001) {
+		t.Errorf("expected %v not to equal %v with tolerance 0.0001", km1, km2)
+	}
+
+	if !km1.Canonicalize().Equals(km1, 0.0) {
+		t.Errorf("expected the canonical form of %v to equal itself", km1)
+	}
+}
@@ -1504,3 +1504,92 @@ func TestConstant_SubstituteAccordingTo1(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestK_Evaluate1
+Description:
+
+	Tests that the Evaluate() method of a constant returns the
+	constant's value, even when the values map is empty.
+*/
+func TestK_Evaluate1(t *testing.T) {
+	// Constants
+	k1 := symbolic.K(3.14)
+
+	// Test
+	if value := k1.Evaluate(map[symbolic.Variable]float64{}); value != 3.14 {
+		t.Errorf(
+			"expected %v.Evaluate() to return 3.14; received %v",
+			k1,
+			value,
+		)
+	}
+}
+
+/*
+TestConstant_QuadraticRepresentation1
+Description:
+
+	Tests that the QuadraticRepresentation() method of a constant returns
+	a zero Q and c and the constant's value as r.
+*/
+func TestConstant_QuadraticRepresentation1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	k1 := symbolic.K(2.5)
+
+	// Test
+	Q, c, r := k1.QuadraticRepresentation([]symbolic.Variable{x})
+	if Q.At(0, 0) != 0.0 {
+		t.Errorf("expected Q to be zero; received %v", Q.At(0, 0))
+	}
+
+	if c.AtVec(0) != 0.0 {
+		t.Errorf("expected c to be zero; received %v", c.AtVec(0))
+	}
+
+	if r != 2.5 {
+		t.Errorf("expected r to be 2.5; received %v", r)
+	}
+}
+
+/*
+TestConstant_QuadraticRepresentation2
+Description:
+
+	Tests that the QuadraticRepresentation() method of a constant panics
+	when no variables are given.
+*/
+func TestConstant_QuadraticRepresentation2(t *testing.T) {
+	// Constants
+	k1 := symbolic.K(2.5)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic; received nil",
+			)
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic with an error; received %v",
+				r,
+			)
+		}
+
+		expectedError := smErrors.EmptyLinearCoeffsError{Expression: k1}
+		if !strings.Contains(rAsE.Error(), expectedError.Error()) {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic with error \"%v\"; received \"%v\"",
+				expectedError,
+				rAsE,
+			)
+		}
+	}()
+
+	k1.QuadraticRepresentation()
+}
@@ -7,8 +7,10 @@ Description:
 */
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 )
 
@@ -217,6 +219,44 @@ func TestConstraint_VariablesInThisConstraint1(t *testing.T) {
 	}
 }
 
+/*
+TestConstraint_VariablesInThisConstraint2
+Description:
+
+	Verifies that the VariablesInThisConstraint function and the IsConvex method
+	handle scalar and vector range constraints without comparing Left() with
+	Right(): for range constraints, whose Right() only stacks their bounds,
+	Left().Minus(Right()) either panics or has the wrong dimensions.
+*/
+func TestConstraint_VariablesInThisConstraint2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestConstraint_VariablesInThisConstraint2")
+	x := symbolic.NewVariableVector(3, env)
+	constraints := []symbolic.Constraint{
+		x[0].Plus(x[1]).(symbolic.ScalarExpression).Between(-1.0, 1.0),
+		x.Between(0.0, symbolic.KVector{1.0, 2.0, 3.0}),
+	}
+
+	// Test
+	for _, c := range constraints {
+		func() {
+			defer func() { recover() }()
+			difference := c.Left().Minus(c.Right())
+			if fmt.Sprint(difference.Dims()) == fmt.Sprint(c.Left().Dims()) {
+				t.Errorf("expected Left().Minus(Right()) to be meaningless for the range constraint %v; received %v", c, difference)
+			}
+		}()
+
+		if vars := symbolic.VariablesInThisConstraint(c); len(vars) != len(c.Left().Variables()) {
+			t.Errorf("expected the variables of %v to be %v; received %v", c, c.Left().Variables(), vars)
+		}
+
+		if !c.IsConvex() {
+			t.Errorf("expected the linear range constraint %v to be convex", c)
+		}
+	}
+}
+
 /*
 TestConstraint_CompileConstraintsIntoScalarConstraints1
 Description:
@@ -284,3 +324,106 @@ func TestConstraint_CompileConstraintsIntoScalarConstraints2(t *testing.T) {
 	}
 
 }
+
+/*
+TestConstraint_CompileConstraintsIntoScalarConstraints3
+Description:
+
+	Verifies that range constraints are decomposed into their halves by
+	CompileConstraintsIntoScalarConstraints: 0 <= x <= 1 gives two constraints
+	and the vector range constraint 0 <= (x, y) <= (1, Infinity) gives three.
+*/
+func TestConstraint_CompileConstraintsIntoScalarConstraints3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestConstraint_CompileConstraintsIntoScalarConstraints3")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	compiled := symbolic.CompileConstraintsIntoScalarConstraints([]symbolic.Constraint{
+		x[0].Between(0.0, 1.0),
+		x.Between(0.0, symbolic.KVector{1.0, symbolic.Infinity}),
+	})
+
+	if len(compiled) != 5 {
+		t.Errorf("expected 5 scalar constraints; received %v", compiled)
+	}
+}
+
+/*
+TestConstraint_ToScalarConstraints1
+Description:
+
+	Verifies that ToScalarConstraints returns an UnsupportedConstraintError
+	for a second-order cone constraint (which can not be decomposed into scalar
+	constraints) and that CompileConstraintsIntoScalarConstraints panics with it.
+*/
+func TestConstraint_ToScalarConstraints1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestConstraint_ToScalarConstraints1")
+	x := symbolic.NewVariableVector(2, env)
+	y := symbolic.NewVariable(env)
+	constraints := []symbolic.Constraint{
+		y.LessEq(1.0),
+		symbolic.NewSecondOrderConeConstraint(x, y),
+	}
+
+	// Test
+	_, err := symbolic.ToScalarConstraints(constraints)
+	if _, tf := err.(smErrors.UnsupportedConstraintError); !tf {
+		t.Errorf("expected an UnsupportedConstraintError; received %v", err)
+	}
+
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.UnsupportedConstraintError); !tf {
+			t.Errorf("expected a panic with an UnsupportedConstraintError; received %v", r)
+		}
+	}()
+
+	symbolic.CompileConstraintsIntoScalarConstraints(constraints)
+}
+
+/*
+TestConstraint_ToScalarRows1
+Description:
+
+	Verifies that ToScalarRows keeps each element of a range constraint as a
+	single ScalarRangeConstraint row and decomposes the other constraints into
+	ScalarConstraints, in the order of the constraints.
+*/
+func TestConstraint_ToScalarRows1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestConstraint_ToScalarRows1")
+	x := symbolic.NewVariableVector(2, env)
+	constraints := []symbolic.Constraint{
+		x[0].LessEq(1.0),
+		x.Between(symbolic.KVector{0.0, -1.0}, 2.0),
+		x.GreaterEq(symbolic.KVector{-5.0, -5.0}),
+	}
+
+	// Test
+	rows, err := symbolic.ToScalarRows(constraints)
+	if err != nil {
+		t.Fatalf("expected ToScalarRows to succeed; received error %v", err)
+	}
+
+	expectedTypes := []string{"ScalarConstraint", "ScalarRangeConstraint", "ScalarRangeConstraint", "ScalarConstraint", "ScalarConstraint"}
+	if len(rows) != len(expectedTypes) {
+		t.Fatalf("expected %v rows; received %v", len(expectedTypes), rows)
+	}
+
+	for ii, row := range rows {
+		if typeName := fmt.Sprintf("%T", row); typeName != "symbolic."+expectedTypes[ii] {
+			t.Errorf("expected row %v to be a %v; received %v", ii, expectedTypes[ii], typeName)
+		}
+	}
+
+	if second := rows[2].(symbolic.ScalarRangeConstraint); second.Lower() != -1.0 || second.Upper() != 2.0 {
+		t.Errorf("expected the second range row to be -1 <= x_1 <= 2; received %v", second)
+	}
+
+	_, err = symbolic.ToScalarRows([]symbolic.Constraint{symbolic.NewSecondOrderConeConstraint(x, x[0])})
+	if _, tf := err.(smErrors.UnsupportedConstraintError); !tf {
+		t.Errorf("expected an UnsupportedConstraintError; received %v", err)
+	}
+}
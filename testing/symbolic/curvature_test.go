@@ -0,0 +1,161 @@
+package symbolic_test
+
+/*
+curvature_test.go
+Description:
+	Tests for the Curvature function and the CurvatureType object
+	(defined in symbolic/curvature.go).
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestCurvatureType_Plus1
+Description:
+
+	Verifies the addition rules of the curvatures: affine terms do not change
+	the curvature and the sum of a convex and a concave expression is unknown.
+*/
+func TestCurvatureType_Plus1(t *testing.T) {
+	// Constants
+	testCases := []struct {
+		Left     symbolic.CurvatureType
+		Right    symbolic.CurvatureType
+		Expected symbolic.CurvatureType
+	}{
+		{symbolic.CurvatureAffine, symbolic.CurvatureConvex, symbolic.CurvatureConvex},
+		{symbolic.CurvatureConcave, symbolic.CurvatureAffine, symbolic.CurvatureConcave},
+		{symbolic.CurvatureConvex, symbolic.CurvatureConvex, symbolic.CurvatureConvex},
+		{symbolic.CurvatureConvex, symbolic.CurvatureConcave, symbolic.CurvatureUnknown},
+		{symbolic.CurvatureUnknown, symbolic.CurvatureAffine, symbolic.CurvatureUnknown},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		if result := tc.Left.Plus(tc.Right); result != tc.Expected {
+			t.Errorf("expected %v + %v to be %v; received %v", tc.Left, tc.Right, tc.Expected, result)
+		}
+	}
+}
+
+/*
+TestCurvature1
+Description:
+
+	Verifies that the curvature of quadratic expressions is determined by the
+	eigenvalues of their Hessian: x^2 + x y + y^2 is convex, -x^2 - y^2 + x is
+	concave, x y is unknown (indefinite) and 2 x + 3 is affine.
+*/
+func TestCurvature1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestCurvature1")
+	x := symbolic.NewVariableVector(2, env)
+
+	testCases := []struct {
+		Expression symbolic.Expression
+		Expected   symbolic.CurvatureType
+	}{
+		{x[0].Power(2).Plus(x[0].Multiply(x[1])).Plus(x[1].Power(2)), symbolic.CurvatureConvex},
+		{x[0].Power(2).Multiply(-1.0).Minus(x[1].Power(2)).Plus(x[0]), symbolic.CurvatureConcave},
+		{x[0].Multiply(x[1]), symbolic.CurvatureUnknown},
+		{x[0].Multiply(2.0).Plus(3.0), symbolic.CurvatureAffine},
+		{symbolic.K(4.0), symbolic.CurvatureAffine},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		if result := symbolic.Curvature(tc.Expression); result != tc.Expected {
+			t.Errorf("expected the curvature of %v to be %v; received %v", tc.Expression, tc.Expected, result)
+		}
+	}
+}
+
+/*
+TestCurvature2
+Description:
+
+	Verifies that the even powers of a single variable are convex (or concave,
+	when their coefficient is negative) and that x^3 is unknown.
+*/
+func TestCurvature2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestCurvature2")
+	x := symbolic.NewVariableVector(2, env)
+
+	testCases := []struct {
+		Expression symbolic.Expression
+		Expected   symbolic.CurvatureType
+	}{
+		{x[0].Power(4).Plus(x[1].Power(2)), symbolic.CurvatureConvex},
+		{x[0].Power(6).Multiply(-2.0).Plus(x[1]), symbolic.CurvatureConcave},
+		{x[0].Power(3), symbolic.CurvatureUnknown},
+		{x[0].Power(2).Multiply(x[1].Power(2)), symbolic.CurvatureUnknown},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		if result := symbolic.Curvature(tc.Expression); result != tc.Expected {
+			t.Errorf("expected the curvature of %v to be %v; received %v", tc.Expression, tc.Expected, result)
+		}
+	}
+}
+
+/*
+TestCurvature3
+Description:
+
+	Verifies the DCP composition rules on function expressions:
+	exp(x^2) + |y| is convex, log(x) - 2 exp(y) is concave,
+	sqrt(x^2) is unknown (a concave function of a convex expression)
+	and sin(x) is unknown.
+*/
+func TestCurvature3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestCurvature3")
+	x := symbolic.NewVariableVector(2, env)
+
+	testCases := []struct {
+		Expression symbolic.Expression
+		Expected   symbolic.CurvatureType
+	}{
+		{symbolic.Exp(x[0].Power(2)).Plus(symbolic.Abs(x[1])), symbolic.CurvatureConvex},
+		{symbolic.Log(x[0]).Minus(symbolic.Exp(x[1]).Multiply(2.0)), symbolic.CurvatureConcave},
+		{symbolic.Sqrt(x[0].Power(2)), symbolic.CurvatureUnknown},
+		{symbolic.Sin(x[0]), symbolic.CurvatureUnknown},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		if result := symbolic.Curvature(tc.Expression); result != tc.Expected {
+			t.Errorf("expected the curvature of %v to be %v; received %v", tc.Expression, tc.Expected, result)
+		}
+	}
+}
+
+/*
+TestCurvature4
+Description:
+
+	Verifies that a vector expression is convex when each of its elements is
+	convex (or affine), and unknown as soon as one element is concave.
+*/
+func TestCurvature4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestCurvature4")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	convex := symbolic.VStack(x[0].Power(2), x[1])
+	if result := symbolic.Curvature(convex); result != symbolic.CurvatureConvex {
+		t.Errorf("expected the curvature of %v to be Convex; received %v", convex, result)
+	}
+
+	mixed := symbolic.VStack(x[0].Power(2), x[1].Power(2).Multiply(-1.0))
+	if result := symbolic.Curvature(mixed); result != symbolic.CurvatureUnknown {
+		t.Errorf("expected the curvature of %v to be Unknown; received %v", mixed, result)
+	}
+}
@@ -0,0 +1,386 @@
+package symbolic_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+derivatives_test.go
+Description:
+
+	Tests for the functions mentioned in the derivatives.go file.
+*/
+
+/*
+TestGradient1
+Description:
+
+	Tests that the Gradient() function correctly computes the gradient
+	of the quadratic polynomial x^2 + 3 x y, which should be
+	[2x + 3y, 3x].
+*/
+func TestGradient1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(2).Plus(x.Multiply(y).Multiply(3.0)).(symbolic.Polynomial)
+
+	// Test
+	gradient := symbolic.Gradient(f, []symbolic.Variable{x, y})
+	if gradient.Len() != 2 {
+		t.Errorf(
+			"expected gradient to have length 2; received %v",
+			gradient.Len(),
+		)
+	}
+
+	values := gradient.Evaluate(map[symbolic.Variable]float64{x: 1.0, y: 2.0})
+	expected := []float64{8.0, 3.0}
+	for ii, expectedII := range expected {
+		if values.AtVec(ii) != expectedII {
+			t.Errorf(
+				"expected element %v of the gradient to be %v; received %v",
+				ii,
+				expectedII,
+				values.AtVec(ii),
+			)
+		}
+	}
+}
+
+/*
+TestGradient2
+Description:
+
+	Tests that the Gradient() function returns a KVector when the
+	input expression is linear.
+*/
+func TestGradient2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Multiply(2.0).Plus(y.Multiply(-5.0)).Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	gradient := symbolic.Gradient(f, []symbolic.Variable{x, y})
+	gradientAsKV, tf := gradient.(symbolic.KVector)
+	if !tf {
+		t.Errorf(
+			"expected gradient to be a KVector; received %T",
+			gradient,
+		)
+	}
+
+	expected := []float64{2.0, -5.0}
+	for ii, expectedII := range expected {
+		if float64(gradientAsKV[ii]) != expectedII {
+			t.Errorf(
+				"expected element %v of the gradient to be %v; received %v",
+				ii,
+				expectedII,
+				gradientAsKV[ii],
+			)
+		}
+	}
+}
+
+/*
+TestGradient3
+Description:
+
+	Tests that the Gradient() function panics when it is given an empty
+	slice of variables.
+*/
+func TestGradient3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	var wrt []symbolic.Variable
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected Gradient() to panic; received nil",
+			)
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf(
+				"expected Gradient() to panic with an error; received %v",
+				r,
+			)
+		}
+
+		expectedError := smErrors.EmptyVectorError{Expression: symbolic.VariableVector(wrt)}
+		if !strings.Contains(rAsE.Error(), expectedError.Error()) {
+			t.Errorf(
+				"expected Gradient() to panic with error \"%v\"; received \"%v\"",
+				expectedError,
+				rAsE,
+			)
+		}
+	}()
+
+	symbolic.Gradient(x, wrt)
+}
+
+/*
+TestGradient4
+Description:
+
+	Tests that the Gradient() function returns a PolynomialVector (and not a
+	VariableVector) for the bilinear expression x y, whose gradient is [y, x].
+*/
+func TestGradient4(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Multiply(y).(symbolic.Monomial)
+
+	// Test
+	gradient := symbolic.Gradient(f, []symbolic.Variable{x, y})
+	gradientAsPV, tf := gradient.(symbolic.PolynomialVector)
+	if !tf {
+		t.Fatalf(
+			"expected gradient to be a PolynomialVector; received %T",
+			gradient,
+		)
+	}
+
+	for ii, expected := range []symbolic.Variable{y, x} {
+		if !gradientAsPV[ii].Equals(expected, 1e-12) {
+			t.Errorf(
+				"expected element %v of the gradient to be %v; received %v",
+				ii,
+				expected,
+				gradientAsPV[ii],
+			)
+		}
+	}
+}
+
+/*
+TestJacobian1
+Description:
+
+	Tests that the Jacobian() function returns the constant matrix A
+	when it is given the linear vector expression A x.
+*/
+func TestJacobian1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+	A := mat.NewDense(3, 2, []float64{
+		1.0, 2.0,
+		3.0, 4.0,
+		5.0, 6.0,
+	})
+	Ax := symbolic.DenseToKMatrix(*A).Multiply(x).(symbolic.VectorExpression)
+
+	// Test
+	jacobian := symbolic.Jacobian(Ax, x)
+	jacobianAsKM, tf := jacobian.(symbolic.KMatrix)
+	if !tf {
+		t.Errorf(
+			"expected Jacobian to be a KMatrix; received %T",
+			jacobian,
+		)
+	}
+
+	jacobianAsDense := jacobianAsKM.ToDense()
+
+	if !mat.Equal(A, &jacobianAsDense) {
+		t.Errorf(
+			"expected Jacobian to be %v; received %v",
+			mat.Formatted(A),
+			mat.Formatted(&jacobianAsDense),
+		)
+	}
+}
+
+/*
+TestJacobian2
+Description:
+
+	Tests that the Jacobian() function keeps the shape of a matrix
+	(i.e., it has e.Len() rows and len(wrt) columns) when only one
+	variable is given.
+*/
+func TestJacobian2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Power(2).(symbolic.Monomial).ToPolynomial(),
+		x.Multiply(y).(symbolic.Monomial).ToPolynomial(),
+		y.ToPolynomial(),
+	}
+
+	// Test
+	jacobian := symbolic.Jacobian(pv, []symbolic.Variable{x})
+	if jacobian.Dims()[0] != 3 || jacobian.Dims()[1] != 1 {
+		t.Errorf(
+			"expected Jacobian to have dimensions [3 1]; received %v",
+			jacobian.Dims(),
+		)
+	}
+
+	values := jacobian.Evaluate(map[symbolic.Variable]float64{x: 2.0, y: 3.0})
+	expected := []float64{4.0, 3.0, 0.0}
+	for ii, expectedII := range expected {
+		if values.At(ii, 0) != expectedII {
+			t.Errorf(
+				"expected element (%v,0) of the Jacobian to be %v; received %v",
+				ii,
+				expectedII,
+				values.At(ii, 0),
+			)
+		}
+	}
+}
+
+/*
+TestJacobian3
+Description:
+
+	Tests that the Jacobian() function returns a PolynomialMatrix for the
+	polynomial vector [x^2, x y] (whose Jacobian [[2 x, 0], [y, x]] mixes
+	monomials, constants and variables) and a FunctionExpressionMatrix for
+	[sin(x), y].
+*/
+func TestJacobian3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	wrt := []symbolic.Variable{x, y}
+	pv := symbolic.PolynomialVector{
+		x.Power(2).(symbolic.Monomial).ToPolynomial(),
+		x.Multiply(y).(symbolic.Monomial).ToPolynomial(),
+	}
+
+	// Test
+	if jacobian := symbolic.Jacobian(pv, wrt); !isPolynomialMatrix(jacobian) {
+		t.Errorf(
+			"expected Jacobian to be a PolynomialMatrix; received %T",
+			jacobian,
+		)
+	}
+
+	fev := symbolic.VStack(symbolic.Sin(x), y).(symbolic.VectorExpression)
+	if jacobian := symbolic.Jacobian(fev, wrt); !isFunctionExpressionMatrix(jacobian) {
+		t.Errorf(
+			"expected Jacobian to be a FunctionExpressionMatrix; received %T",
+			jacobian,
+		)
+	}
+}
+
+/*
+TestHessian1
+Description:
+
+	Tests that the Hessian() function returns the constant matrix
+	[[2, 3], [3, 0]] when it is given the quadratic x^2 + 3 x y.
+*/
+func TestHessian1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(2).Plus(x.Multiply(y).Multiply(3.0)).(symbolic.Polynomial)
+
+	// Test
+	hessian := symbolic.Hessian(f, []symbolic.Variable{x, y})
+	hessianAsKM, tf := hessian.(symbolic.KMatrix)
+	if !tf {
+		t.Errorf(
+			"expected Hessian to be a KMatrix; received %T",
+			hessian,
+		)
+	}
+
+	hessianAsDense := hessianAsKM.ToDense()
+
+	expected := mat.NewDense(2, 2, []float64{2.0, 3.0, 3.0, 0.0})
+	if !mat.Equal(expected, &hessianAsDense) {
+		t.Errorf(
+			"expected Hessian to be %v; received %v",
+			mat.Formatted(expected),
+			mat.Formatted(&hessianAsDense),
+		)
+	}
+}
+
+/*
+TestHessian2
+Description:
+
+	Tests that the Hessian() function correctly computes the Hessian
+	of the cubic x^3 y, which should be [[6 x y, 3 x^2], [3 x^2, 0]].
+*/
+func TestHessian2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	f := x.Power(3).Multiply(y).(symbolic.Monomial)
+
+	// Test
+	hessian := symbolic.Hessian(f, []symbolic.Variable{x, y})
+	values := hessian.Evaluate(map[symbolic.Variable]float64{x: 2.0, y: 3.0})
+
+	expected := mat.NewDense(2, 2, []float64{36.0, 12.0, 12.0, 0.0})
+	if !mat.Equal(expected, &values) {
+		t.Errorf(
+			"expected Hessian to evaluate to %v; received %v",
+			mat.Formatted(expected),
+			mat.Formatted(&values),
+		)
+	}
+}
+
+/*
+TestHessian3
+Description:
+
+	Tests that the Hessian() function returns a PolynomialMatrix for the cubic
+	x^3 y and a FunctionExpressionMatrix for exp(x) + y.
+*/
+func TestHessian3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	wrt := []symbolic.Variable{x, y}
+
+	// Test
+	if hessian := symbolic.Hessian(x.Power(3).Multiply(y).(symbolic.Monomial), wrt); !isPolynomialMatrix(hessian) {
+		t.Errorf(
+			"expected Hessian to be a PolynomialMatrix; received %T",
+			hessian,
+		)
+	}
+
+	f := symbolic.Exp(x).Plus(y).(symbolic.ScalarExpression)
+	if hessian := symbolic.Hessian(f, wrt); !isFunctionExpressionMatrix(hessian) {
+		t.Errorf(
+			"expected Hessian to be a FunctionExpressionMatrix; received %T",
+			hessian,
+		)
+	}
+}
+
+// isPolynomialMatrix Returns true if me is a PolynomialMatrix.
+func isPolynomialMatrix(me symbolic.MatrixExpression) bool {
+	_, tf := me.(symbolic.PolynomialMatrix)
+	return tf
+}
+
+// isFunctionExpressionMatrix Returns true if me is a FunctionExpressionMatrix.
+func isFunctionExpressionMatrix(me symbolic.MatrixExpression) bool {
+	_, tf := me.(symbolic.FunctionExpressionMatrix)
+	return tf
+}
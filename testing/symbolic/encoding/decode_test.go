@@ -0,0 +1,333 @@
+package encoding_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/encoding"
+)
+
+/*
+decode_test.go
+Description:
+
+	Tests for the functions mentioned in the symbolic/encoding/decode.go file.
+*/
+
+/*
+TestUnmarshalExpression1
+Description:
+
+	Tests that each of the supported expression types can be written with
+	MarshalExpression and read back into a fresh environment without changes.
+*/
+func TestUnmarshalExpression1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestUnmarshalExpression1")
+	x := symbolic.NewVariableVector(2, env)
+	vm := symbolic.VariableMatrix{{x[0], x[1]}, {x[1], x[0]}}
+	m := x[0].Power(2).Multiply(x[1]).Multiply(3.0).(symbolic.Monomial)
+	p := m.Plus(x[1]).Plus(2.0).(symbolic.Polynomial)
+
+	testCases := []symbolic.Expression{
+		symbolic.K(2.5),
+		symbolic.KVector{1.0, -2.0},
+		symbolic.KMatrix{{1.0, 2.0}, {3.0, 4.0}},
+		x[0],
+		x,
+		vm,
+		m,
+		symbolic.MonomialVector{m, x[1].ToMonomial()},
+		symbolic.MonomialMatrix{{m, m}, {x[0].ToMonomial(), m}},
+		p,
+		symbolic.PolynomialVector{p, x[0].ToPolynomial()},
+		symbolic.PolynomialMatrix{{p, p}, {p, x[1].ToPolynomial()}},
+	}
+
+	// Test
+	for _, e := range testCases {
+		data, err := encoding.MarshalExpression(e)
+		if err != nil {
+			t.Errorf("expected MarshalExpression(%v) to succeed; received error %v", e, err)
+			continue
+		}
+
+		loadEnv := symbolic.NewBasicEnvironment("TestUnmarshalExpression1-load")
+		decoded, err := encoding.UnmarshalExpression(data, loadEnv)
+		if err != nil {
+			t.Errorf("expected UnmarshalExpression(%s) to succeed; received error %v", data, err)
+			continue
+		}
+
+		if fmt.Sprintf("%T", decoded) != fmt.Sprintf("%T", e) {
+			t.Errorf("expected the decoded expression to be a %T; received %T", e, decoded)
+		}
+
+		if fmt.Sprintf("%v", decoded) != fmt.Sprintf("%v", e) {
+			t.Errorf("expected the decoded expression to be %v; received %v", e, decoded)
+		}
+	}
+}
+
+/*
+TestUnmarshalExpression2
+Description:
+
+	Tests that the UnmarshalExpression function rebinds stored variables to the
+	variables of the environment with the same name and creates the missing ones
+	(with their stored bounds and type).
+*/
+func TestUnmarshalExpression2(t *testing.T) {
+	// Constants
+	saveEnv := symbolic.NewBasicEnvironment("TestUnmarshalExpression2-save")
+	a := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 10.0, "a", saveEnv)
+	b := symbolic.NewCustomVariable(symbolic.Integer, -5.0, 5.0, "b", saveEnv)
+
+	loadEnv := symbolic.NewBasicEnvironment("TestUnmarshalExpression2-load")
+	symbolic.NewVariable(loadEnv)
+	existingA := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 10.0, "a", loadEnv)
+
+	// Test
+	data, err := encoding.MarshalExpression(a.Plus(b))
+	if err != nil {
+		t.Errorf("expected MarshalExpression to succeed; received error %v", err)
+	}
+
+	decoded, err := encoding.UnmarshalExpression(data, loadEnv)
+	if err != nil {
+		t.Errorf("expected UnmarshalExpression to succeed; received error %v", err)
+	}
+
+	trackedVars := loadEnv.AllTrackedVariables()
+	if len(trackedVars) != 3 {
+		t.Fatalf("expected the environment to track 3 variables; received %v", trackedVars)
+	}
+
+	newB := trackedVars[2]
+	if newB.Name != "b" || newB.Type != symbolic.Integer || newB.Lower != -5.0 || newB.Upper != 5.0 {
+		t.Errorf("expected a new integer variable b in [-5, 5]; received %v", newB)
+	}
+
+	values := map[symbolic.Variable]float64{existingA: 1.0, newB: 2.0}
+	if value := decoded.(symbolic.ScalarExpression).Evaluate(values); value != 3.0 {
+		t.Errorf("expected the decoded expression to use the environment's variables; evaluated to %v", value)
+	}
+}
+
+/*
+TestUnmarshalExpression3
+Description:
+
+	Tests that the UnmarshalExpression function rejects documents with an
+	unsupported version, an unknown variable ID or an unknown expression type.
+*/
+func TestUnmarshalExpression3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestUnmarshalExpression3")
+	testCases := []string{
+		`{"version": 2, "variables": [], "expression": {"type": "K", "data": 1}}`,
+		`{"version": 1, "variables": [], "expression": {"type": "Variable", "data": 3}}`,
+		`{"version": 1, "variables": [], "expression": {"type": "Quaternion", "data": 1}}`,
+		`{"version": 1, "variables": [{"id": 0, "name": "x", "lower": 1, "upper": 0, "type": "C"}], "expression": {"type": "Variable", "data": 0}}`,
+		`{"version": 1, "variables": [], "expression": {"type": "KMatrix", "data": [[1, 2], [3]]}}`,
+		`{"version": 1, "variables": []}`,
+	}
+
+	// Test
+	for _, src := range testCases {
+		_, err := encoding.UnmarshalExpression([]byte(src), env)
+		if err == nil {
+			t.Errorf("expected UnmarshalExpression(%s) to return an error; received nil", src)
+		}
+	}
+
+	if len(env.AllTrackedVariables()) != 0 {
+		t.Errorf(
+			"expected invalid documents to leave the environment unchanged; received %v",
+			env.AllTrackedVariables(),
+		)
+	}
+}
+
+/*
+TestUnmarshalExpression4
+Description:
+
+	Tests that the UnmarshalExpression function returns an error (and leaves the
+	environment unchanged) when the environment contains a variable with a stored
+	name but a different type or different bounds.
+*/
+func TestUnmarshalExpression4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestUnmarshalExpression4")
+	symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 10.0, "a", env)
+	testCases := []string{
+		`{"version": 1, "variables": [{"id": 0, "name": "b", "lower": 0, "upper": 1, "type": "C"}, {"id": 1, "name": "a", "lower": 0, "upper": 5, "type": "C"}], "expression": {"type": "VariableVector", "data": [0, 1]}}`,
+		`{"version": 1, "variables": [{"id": 0, "name": "a", "lower": 0, "upper": 10, "type": "I"}], "expression": {"type": "Variable", "data": 0}}`,
+	}
+
+	// Test
+	for _, src := range testCases {
+		_, err := encoding.UnmarshalExpression([]byte(src), env)
+		if err == nil {
+			t.Errorf("expected UnmarshalExpression(%s) to return an error; received nil", src)
+		}
+	}
+
+	if len(env.AllTrackedVariables()) != 1 {
+		t.Errorf(
+			"expected the environment to only track a; received %v",
+			env.AllTrackedVariables(),
+		)
+	}
+}
+
+/*
+TestUnmarshalExpression5
+Description:
+
+	Tests that a fixed variable (with equal bounds) can be written with
+	MarshalExpression and read back into a fresh environment.
+*/
+func TestUnmarshalExpression5(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestUnmarshalExpression5")
+	x := symbolic.NewCustomVariable(symbolic.Continuous, 2.0, 2.0, "x", env)
+
+	// Test
+	data, err := encoding.MarshalExpression(x)
+	if err != nil {
+		t.Fatalf("expected MarshalExpression to succeed; received error %v", err)
+	}
+
+	loadEnv := symbolic.NewBasicEnvironment("TestUnmarshalExpression5-load")
+	decoded, err := encoding.UnmarshalExpression(data, loadEnv)
+	if err != nil {
+		t.Fatalf("expected UnmarshalExpression(%s) to succeed; received error %v", data, err)
+	}
+
+	decodedX, tf := decoded.(symbolic.Variable)
+	if !tf {
+		t.Fatalf("expected the decoded expression to be a Variable; received %T", decoded)
+	}
+
+	if decodedX.Name != "x" || decodedX.Lower != 2.0 || decodedX.Upper != 2.0 || decodedX.Environment != loadEnv {
+		t.Errorf("expected a fixed variable x = 2 of the load environment; received %v in [%v, %v]", decodedX, decodedX.Lower, decodedX.Upper)
+	}
+}
+
+/*
+TestUnmarshalExpression6
+Description:
+
+	Tests that the UnmarshalExpression and UnmarshalConstraint functions leave the
+	environment unchanged when the variables of a document are valid but its
+	expression (or constraint) is not.
+*/
+func TestUnmarshalExpression6(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestUnmarshalExpression6")
+	variables := `[{"id": 0, "name": "x", "lower": 0, "upper": 1, "type": "C"}, {"id": 1, "name": "y", "lower": 0, "upper": 1, "type": "C"}]`
+	expressions := []string{
+		`{"version": 1, "variables": ` + variables + `, "expression": {"type": "VariableVector", "data": [0, 2]}}`,
+		`{"version": 1, "variables": ` + variables + `, "expression": {"type": "Monomial", "data": {"coefficient": 1, "variables": [0, 1], "exponents": [1]}}}`,
+	}
+	constraints := []string{
+		`{"version": 1, "variables": ` + variables + `, "constraint": {"type": "VectorConstraint", "left": {"type": "VariableVector", "data": [0, 1]}, "right": {"type": "KVector", "data": [1, 2, 3]}, "sense": "<="}}`,
+	}
+
+	// Test
+	for _, src := range expressions {
+		_, err := encoding.UnmarshalExpression([]byte(src), env)
+		if err == nil {
+			t.Errorf("expected UnmarshalExpression(%s) to return an error; received nil", src)
+		}
+	}
+
+	for _, src := range constraints {
+		_, err := encoding.UnmarshalConstraint([]byte(src), env)
+		if err == nil {
+			t.Errorf("expected UnmarshalConstraint(%s) to return an error; received nil", src)
+		}
+	}
+
+	if len(env.AllTrackedVariables()) != 0 {
+		t.Errorf(
+			"expected invalid documents to leave the environment unchanged; received %v",
+			env.AllTrackedVariables(),
+		)
+	}
+}
+
+/*
+TestUnmarshalConstraint1
+Description:
+
+	Tests that scalar, vector, matrix and range constraints can be written with
+	MarshalConstraint and read back without changes.
+*/
+func TestUnmarshalConstraint1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestUnmarshalConstraint1")
+	x := symbolic.NewVariableVector(2, env)
+	vm := symbolic.VariableMatrix{{x[0], x[1]}, {x[1], x[0]}}
+
+	testCases := []symbolic.Constraint{
+		x[0].Power(2).Plus(x[1]).LessEq(4.0),
+		x.Plus(symbolic.KVector{1.0, 2.0}).GreaterEq(symbolic.KVector{0.0, 0.0}),
+		vm.Eq(symbolic.KMatrix{{1.0, 0.0}, {0.0, 1.0}}),
+		x[0].Plus(x[1]).(symbolic.ScalarExpression).Between(-1.0, 1.0),
+		x.Between(symbolic.KVector{0.0, -symbolic.Infinity}, 3.0),
+	}
+
+	// Test
+	for _, c := range testCases {
+		data, err := encoding.MarshalConstraint(c)
+		if err != nil {
+			t.Errorf("expected MarshalConstraint(%v) to succeed; received error %v", c, err)
+			continue
+		}
+
+		loadEnv := symbolic.NewBasicEnvironment("TestUnmarshalConstraint1-load")
+		decoded, err := encoding.UnmarshalConstraint(data, loadEnv)
+		if err != nil {
+			t.Errorf("expected UnmarshalConstraint(%s) to succeed; received error %v", data, err)
+			continue
+		}
+
+		if fmt.Sprintf("%T", decoded) != fmt.Sprintf("%T", c) {
+			t.Errorf("expected the decoded constraint to be a %T; received %T", c, decoded)
+		}
+
+		if decoded.ConstrSense() != c.ConstrSense() ||
+			fmt.Sprintf("%v", decoded.Left()) != fmt.Sprintf("%v", c.Left()) ||
+			fmt.Sprintf("%v", decoded.Right()) != fmt.Sprintf("%v", c.Right()) {
+			t.Errorf("expected the decoded constraint to be %v; received %v", c, decoded)
+		}
+	}
+}
+
+/*
+TestUnmarshalConstraint2
+Description:
+
+	Tests that the UnmarshalConstraint function rejects a ScalarConstraint
+	whose sides are vectors and an unknown sense.
+*/
+func TestUnmarshalConstraint2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestUnmarshalConstraint2")
+	testCases := []string{
+		`{"version": 1, "variables": [], "constraint": {"type": "ScalarConstraint", "left": {"type": "KVector", "data": [1]}, "right": {"type": "K", "data": 1}, "sense": "<="}}`,
+		`{"version": 1, "variables": [], "constraint": {"type": "ScalarConstraint", "left": {"type": "K", "data": 1}, "right": {"type": "K", "data": 1}, "sense": "<"}}`,
+	}
+
+	// Test
+	for _, src := range testCases {
+		_, err := encoding.UnmarshalConstraint([]byte(src), env)
+		if err == nil {
+			t.Errorf("expected UnmarshalConstraint(%s) to return an error; received nil", src)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package encoding_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/encoding"
+)
+
+/*
+encode_test.go
+Description:
+
+	Tests for the functions mentioned in the symbolic/encoding/encode.go file.
+*/
+
+/*
+TestMarshalExpression1
+Description:
+
+	Tests that the MarshalExpression function writes the schema version and a
+	variable table (sorted by ID) containing the name, bounds and type of each variable.
+*/
+func TestMarshalExpression1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestMarshalExpression1")
+	x := symbolic.NewVariable(env)
+	b := symbolic.NewBinaryVariable(env)
+	p := b.Multiply(3.0).Plus(x.Power(2)).(symbolic.Polynomial)
+
+	// Test
+	data, err := encoding.MarshalExpression(p)
+	if err != nil {
+		t.Errorf("expected MarshalExpression to succeed; received error %v", err)
+	}
+
+	var doc struct {
+		Version   int `json:"version"`
+		Variables []struct {
+			ID    uint64  `json:"id"`
+			Name  string  `json:"name"`
+			Lower float64 `json:"lower"`
+			Upper float64 `json:"upper"`
+			Type  string  `json:"type"`
+		} `json:"variables"`
+		Expression struct {
+			Type string `json:"type"`
+		} `json:"expression"`
+	}
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		t.Errorf("expected the output to be valid JSON; received error %v", err)
+	}
+
+	if doc.Version != encoding.SchemaVersion {
+		t.Errorf("expected version %v; received %v", encoding.SchemaVersion, doc.Version)
+	}
+
+	if doc.Expression.Type != "Polynomial" {
+		t.Errorf("expected the expression type to be Polynomial; received %v", doc.Expression.Type)
+	}
+
+	if len(doc.Variables) != 2 {
+		t.Fatalf("expected 2 variables in the table; received %v", len(doc.Variables))
+	}
+
+	if doc.Variables[0].ID != x.ID || doc.Variables[0].Name != x.Name || doc.Variables[0].Type != "C" {
+		t.Errorf("expected the first variable to be %v; received %v", x, doc.Variables[0])
+	}
+
+	if doc.Variables[1].ID != b.ID || doc.Variables[1].Type != "B" ||
+		doc.Variables[1].Lower != 0.0 || doc.Variables[1].Upper != 1.0 {
+		t.Errorf("expected the second variable to be the binary %v; received %v", b, doc.Variables[1])
+	}
+}
+
+/*
+TestMarshalExpression2
+Description:
+
+	Tests that the MarshalExpression function returns an error when two
+	different variables share the same ID.
+*/
+func TestMarshalExpression2(t *testing.T) {
+	// Constants
+	env1 := symbolic.NewBasicEnvironment("TestMarshalExpression2-1")
+	env2 := symbolic.NewBasicEnvironment("TestMarshalExpression2-2")
+	x := symbolic.NewCustomVariable(symbolic.Continuous, -1.0, 1.0, "x", env1)
+	y := symbolic.NewCustomVariable(symbolic.Continuous, -1.0, 1.0, "y", env2)
+
+	// Test
+	_, err := encoding.MarshalExpression(symbolic.VariableVector{x, y})
+	if err == nil {
+		t.Errorf("expected MarshalExpression to return an error; received nil")
+	}
+
+	if err != nil && !strings.Contains(err.Error(), "share the ID") {
+		t.Errorf("expected an error about the shared ID; received %v", err)
+	}
+}
+
+/*
+TestMarshalConstraint1
+Description:
+
+	Tests that the MarshalConstraint function stores the type and the sense
+	of a VectorConstraint.
+*/
+func TestMarshalConstraint1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestMarshalConstraint1")
+	x := symbolic.NewVariableVector(2, env)
+	c := x.GreaterEq(symbolic.KVector{0.0, 1.0})
+
+	// Test
+	data, err := encoding.MarshalConstraint(c)
+	if err != nil {
+		t.Errorf("expected MarshalConstraint to succeed; received error %v", err)
+	}
+
+	var doc struct {
+		Constraint struct {
+			Type  string `json:"type"`
+			Sense string `json:"sense"`
+		} `json:"constraint"`
+	}
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		t.Errorf("expected the output to be valid JSON; received error %v", err)
+	}
+
+	if doc.Constraint.Type != "VectorConstraint" || doc.Constraint.Sense != ">=" {
+		t.Errorf("expected a >= VectorConstraint; received %v", doc.Constraint)
+	}
+}
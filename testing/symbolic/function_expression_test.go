@@ -0,0 +1,568 @@
+package symbolic_test
+
+/*
+function_expression_test.go
+Description:
+	Tests for the FunctionExpression object (and its vector and matrix
+	counterparts) defined in symbolic/function_expression*.go.
+*/
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestFunctionExpression_Check1
+Description:
+
+	Verifies that the Check() method returns an error when the
+	function expression uses an unknown function.
+*/
+func TestFunctionExpression_Check1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Check1")
+	x := symbolic.NewVariable(env)
+	fe := symbolic.FunctionExpression{
+		Function:  symbolic.ScalarFunction("tan"),
+		Arguments: []symbolic.ScalarExpression{x},
+	}
+
+	// Test
+	err := fe.Check()
+	if err == nil {
+		t.Errorf("expected Check() to return an error; received nil")
+	}
+}
+
+/*
+TestFunctionExpression_Check2
+Description:
+
+	Verifies that the Check() method returns an error when a unary
+	function is given the wrong number of arguments.
+*/
+func TestFunctionExpression_Check2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Check2")
+	x := symbolic.NewVariableVector(2, env)
+	fe := symbolic.FunctionExpression{
+		Function:  symbolic.FunctionSin,
+		Arguments: []symbolic.ScalarExpression{x[0], x[1]},
+	}
+
+	// Test
+	err := fe.Check()
+	if err == nil {
+		t.Errorf("expected Check() to return an error; received nil")
+	}
+}
+
+/*
+TestFunctionExpression_Constructors1
+Description:
+
+	Verifies that the constructors fold constant arguments into a
+	constant (e.g., Sqrt(4) = 2).
+*/
+func TestFunctionExpression_Constructors1(t *testing.T) {
+	// Constants
+	expected := map[string]float64{
+		"Sqrt(4)": 2.0,
+		"Exp(0)":  1.0,
+		"Log(1)":  0.0,
+		"Abs(-3)": 3.0,
+		"Cos(0)":  1.0,
+	}
+	results := map[string]symbolic.Expression{
+		"Sqrt(4)": symbolic.Sqrt(4.0),
+		"Exp(0)":  symbolic.Exp(symbolic.K(0.0)),
+		"Log(1)":  symbolic.Log(1),
+		"Abs(-3)": symbolic.Abs(-3.0),
+		"Cos(0)":  symbolic.Cos(0.0),
+	}
+
+	// Test
+	for name, result := range results {
+		resultAsK, tf := result.(symbolic.K)
+		if !tf {
+			t.Errorf("expected %v to be a K; received %T", name, result)
+			continue
+		}
+
+		if float64(resultAsK) != expected[name] {
+			t.Errorf("expected %v = %v; received %v", name, expected[name], resultAsK)
+		}
+	}
+}
+
+/*
+TestFunctionExpression_IsPolynomialLike1
+Description:
+
+	Verifies that a function expression is not considered polynomial like
+	(and therefore is neither linear nor quadratic).
+*/
+func TestFunctionExpression_IsPolynomialLike1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_IsPolynomialLike1")
+	x := symbolic.NewVariable(env)
+	fe := symbolic.Exp(x)
+
+	// Test
+	if symbolic.IsPolynomialLike(fe) {
+		t.Errorf("expected exp(x) to not be polynomial like")
+	}
+
+	if symbolic.IsLinear(fe) {
+		t.Errorf("expected exp(x) to not be linear")
+	}
+
+	if symbolic.IsQuadratic(fe) {
+		t.Errorf("expected exp(x) to not be quadratic")
+	}
+}
+
+/*
+TestFunctionExpression_LinearCoeff1
+Description:
+
+	Verifies that the LinearCoeff() method panics with a
+	LinearExpressionRequiredError.
+*/
+func TestFunctionExpression_LinearCoeff1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_LinearCoeff1")
+	x := symbolic.NewVariable(env)
+	fe := symbolic.Sin(x).(symbolic.FunctionExpression)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("expected LinearCoeff() to panic; received nil")
+		}
+
+		if _, tf := r.(smErrors.LinearExpressionRequiredError); !tf {
+			t.Errorf("expected a LinearExpressionRequiredError; received %v", r)
+		}
+	}()
+
+	fe.LinearCoeff()
+}
+
+/*
+TestFunctionExpression_String1
+Description:
+
+	Verifies that the String() method of a sum of a variable and a
+	function expression prints both terms.
+*/
+func TestFunctionExpression_String1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_String1")
+	x := symbolic.NewVariable(env)
+	sinX := symbolic.Sin(x)
+
+	// Test
+	expected := x.String() + " - sin(" + x.String() + ")"
+	result := x.Minus(sinX)
+	if result.String() != expected {
+		t.Errorf("expected %v; received %v", expected, result.String())
+	}
+}
+
+/*
+TestFunctionExpression_Plus1
+Description:
+
+	Verifies that adding and then subtracting the same function expression
+	cancels the function term, leaving only the constant.
+*/
+func TestFunctionExpression_Plus1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Plus1")
+	x := symbolic.NewVariable(env)
+	sinX := symbolic.Sin(x).(symbolic.FunctionExpression)
+
+	// Test
+	result := sinX.Minus(1.0).Minus(sinX)
+	resultAsK, tf := result.(symbolic.K)
+	if !tf {
+		t.Errorf("expected the result to be a K; received %T (%v)", result, result)
+	}
+
+	if float64(resultAsK) != -1.0 {
+		t.Errorf("expected the result to be -1; received %v", resultAsK)
+	}
+}
+
+/*
+TestFunctionExpression_Plus2
+Description:
+
+	Verifies that the terms of a sum are combined according to their structure
+	(the IDs of their variables) and not according to their String(): sin(x) and
+	sin(y) are kept apart even though x and y are both named "x".
+*/
+func TestFunctionExpression_Plus2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Plus2")
+	x := symbolic.NewCustomVariable(symbolic.Continuous, -1.0, 1.0, "x", env)
+	y := symbolic.Variable{ID: x.ID + 1, Lower: x.Lower, Upper: x.Upper, Type: symbolic.Continuous, Name: "x"}
+
+	// Test
+	sum := symbolic.Sin(x).Plus(symbolic.Sin(y)).(symbolic.ScalarExpression)
+	if sum.String() != "sin(x) + sin(x)" {
+		t.Errorf("expected sin(x) and sin(y) to be separate terms; received %v", sum)
+	}
+
+	values := map[symbolic.Variable]float64{x: 0.5, y: 1.0}
+	if expected := math.Sin(0.5) + math.Sin(1.0); math.Abs(sum.Evaluate(values)-expected) > 1e-12 {
+		t.Errorf("expected %v at x = 0.5, y = 1; received %v", expected, sum.Evaluate(values))
+	}
+
+	doubled := sum.Plus(symbolic.Sin(x)).(symbolic.ScalarExpression)
+	if expected := 2*math.Sin(0.5) + math.Sin(1.0); math.Abs(doubled.Evaluate(values)-expected) > 1e-12 {
+		t.Errorf("expected %v at x = 0.5, y = 1; received %v", expected, doubled.Evaluate(values))
+	}
+}
+
+/*
+TestFunctionExpression_Constructors2
+Description:
+
+	Verifies that applying log or sqrt to a constant outside of their domain
+	panics with a DomainError (instead of returning NaN).
+*/
+func TestFunctionExpression_Constructors2(t *testing.T) {
+	// Constants
+	testCases := map[string]func(){
+		"log(-1)":  func() { symbolic.Log(symbolic.K(-1.0)) },
+		"log(0)":   func() { symbolic.Log(0.0) },
+		"sqrt(-4)": func() { symbolic.Sqrt(symbolic.K(-4.0)) },
+	}
+
+	// Test
+	for name, f := range testCases {
+		func() {
+			defer func() {
+				r := recover()
+				if _, tf := r.(smErrors.DomainError); !tf {
+					t.Errorf("expected %v to panic with a DomainError; received %v", name, r)
+				}
+			}()
+
+			f()
+		}()
+	}
+
+	if value := symbolic.Sqrt(symbolic.K(4.0)); value != symbolic.K(2.0) {
+		t.Errorf("expected sqrt(4) to be 2; received %v", value)
+	}
+}
+
+/*
+TestFunctionExpression_Evaluate1
+Description:
+
+	Verifies that the Evaluate() method matches the corresponding
+	functions from the math package.
+*/
+func TestFunctionExpression_Evaluate1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Evaluate1")
+	x := symbolic.NewVariable(env)
+	fe := symbolic.Exp(x).Plus(
+		symbolic.Sin(x).Multiply(symbolic.Sqrt(x)),
+	).(symbolic.FunctionExpression)
+	xValue := 0.7
+
+	// Test
+	expected := math.Exp(xValue) + math.Sin(xValue)*math.Sqrt(xValue)
+	result := fe.Evaluate(map[symbolic.Variable]float64{x: xValue})
+	if math.Abs(result-expected) > 1e-12 {
+		t.Errorf("expected %v; received %v", expected, result)
+	}
+}
+
+/*
+TestFunctionExpression_DerivativeWrt1
+Description:
+
+	Verifies that the derivative of x * sin(x) is sin(x) + x * cos(x)
+	(product rule).
+*/
+func TestFunctionExpression_DerivativeWrt1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_DerivativeWrt1")
+	x := symbolic.NewVariable(env)
+	fe := x.Multiply(symbolic.Sin(x))
+
+	// Test
+	derivative := fe.DerivativeWrt(x)
+	expected := symbolic.Sin(x).Plus(x.Multiply(symbolic.Cos(x)))
+	if !derivative.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, derivative)
+	}
+}
+
+/*
+TestFunctionExpression_DerivativeWrt2
+Description:
+
+	Verifies that the derivative of each of the elementary functions
+	(composed with a polynomial) agrees with a central finite difference
+	(chain rule).
+*/
+func TestFunctionExpression_DerivativeWrt2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_DerivativeWrt2")
+	x := symbolic.NewVariable(env)
+	inner := x.Power(2).Plus(1.0)
+	functions := map[string]symbolic.Expression{
+		"exp":  symbolic.Exp(inner),
+		"log":  symbolic.Log(inner),
+		"sin":  symbolic.Sin(inner),
+		"cos":  symbolic.Cos(inner),
+		"sqrt": symbolic.Sqrt(inner),
+		"abs":  symbolic.Abs(x.Minus(0.5)),
+	}
+	xValue, h := 0.3, 1e-6
+
+	// Test
+	for name, f := range functions {
+		fAsFE := f.(symbolic.FunctionExpression)
+		derivative := fAsFE.DerivativeWrt(x).(symbolic.ScalarExpression)
+
+		plus := fAsFE.Evaluate(map[symbolic.Variable]float64{x: xValue + h})
+		minus := fAsFE.Evaluate(map[symbolic.Variable]float64{x: xValue - h})
+		expected := (plus - minus) / (2 * h)
+
+		result := derivative.Substitute(x, symbolic.K(xValue))
+		resultAsK, tf := result.(symbolic.K)
+		if !tf {
+			t.Errorf("expected the derivative of %v at a point to be a K; received %T", name, result)
+			continue
+		}
+
+		if math.Abs(float64(resultAsK)-expected) > 1e-6 {
+			t.Errorf("expected d/dx %v = %v; received %v", name, expected, resultAsK)
+		}
+	}
+}
+
+/*
+TestFunctionExpression_Substitute1
+Description:
+
+	Verifies that substituting a variable with another expression
+	replaces the variable inside of the function's argument.
+*/
+func TestFunctionExpression_Substitute1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Substitute1")
+	x := symbolic.NewVariableVector(2, env)
+	fe := symbolic.Log(x[0]).(symbolic.FunctionExpression)
+
+	// Test
+	result := fe.Substitute(x[0], x[1].Multiply(2.0).(symbolic.ScalarExpression))
+	expected := symbolic.Log(x[1].Multiply(2.0))
+	if !result.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, result)
+	}
+
+	for _, v := range result.Variables() {
+		if v.ID == x[0].ID {
+			t.Errorf("expected %v to be removed from %v", x[0], result)
+		}
+	}
+}
+
+/*
+TestFunctionExpression_Equals1
+Description:
+
+	Verifies that two sums containing the same terms in a different
+	order are equal, while different functions are not.
+*/
+func TestFunctionExpression_Equals1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Equals1")
+	x := symbolic.NewVariable(env)
+	e1 := x.Plus(symbolic.Sin(x))
+	e2 := symbolic.Sin(x).Plus(x)
+	e3 := x.Plus(symbolic.Cos(x))
+
+	// Test
+	if !e1.Equals(e2, 1e-12) {
+		t.Errorf("expected %v to equal %v", e1, e2)
+	}
+
+	if e1.Equals(e3, 1e-12) {
+		t.Errorf("expected %v to not equal %v", e1, e3)
+	}
+}
+
+/*
+TestFunctionExpression_Comparison1
+Description:
+
+	Verifies that comparing a function expression to a constant creates
+	a scalar constraint.
+*/
+func TestFunctionExpression_Comparison1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpression_Comparison1")
+	x := symbolic.NewVariable(env)
+	fe := symbolic.Exp(x).(symbolic.FunctionExpression)
+
+	// Test
+	constr := fe.LessEq(2.0)
+	if _, tf := constr.(symbolic.ScalarConstraint); !tf {
+		t.Errorf("expected a ScalarConstraint; received %T", constr)
+	}
+}
+
+/*
+TestFunctionExpressionVector_Sin1
+Description:
+
+	Verifies that Sin() applied to a variable vector creates a
+	FunctionExpressionVector whose elements are sin(x_i).
+*/
+func TestFunctionExpressionVector_Sin1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpressionVector_Sin1")
+	x := symbolic.NewVariableVector(3, env)
+
+	// Test
+	result := symbolic.Sin(x)
+	resultAsFEV, tf := result.(symbolic.FunctionExpressionVector)
+	if !tf {
+		t.Errorf("expected a FunctionExpressionVector; received %T", result)
+	}
+
+	if resultAsFEV.Len() != x.Len() {
+		t.Errorf("expected the length to be %v; received %v", x.Len(), resultAsFEV.Len())
+	}
+
+	for ii, element := range resultAsFEV {
+		if !element.Equals(symbolic.Sin(x[ii]), 1e-12) {
+			t.Errorf("expected element %v to be sin(%v); received %v", ii, x[ii], element)
+		}
+	}
+
+	if symbolic.IsPolynomialLike(resultAsFEV) {
+		t.Errorf("expected %v to not be polynomial like", resultAsFEV)
+	}
+}
+
+/*
+TestFunctionExpressionVector_DerivativeWrt1
+Description:
+
+	Verifies that the derivative of sin(x) (elementwise) with respect to
+	x_0 is the vector [cos(x_0), 0].
+*/
+func TestFunctionExpressionVector_DerivativeWrt1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpressionVector_DerivativeWrt1")
+	x := symbolic.NewVariableVector(2, env)
+	fev := symbolic.Sin(x).(symbolic.FunctionExpressionVector)
+
+	// Test
+	derivative := fev.DerivativeWrt(x[0])
+	if !derivative.At(0, 0).Equals(symbolic.Cos(x[0]), 1e-12) {
+		t.Errorf("expected the first element to be cos(%v); received %v", x[0], derivative.At(0, 0))
+	}
+
+	if !derivative.At(1, 0).Equals(symbolic.K(0.0), 1e-12) {
+		t.Errorf("expected the second element to be 0; received %v", derivative.At(1, 0))
+	}
+}
+
+/*
+TestFunctionExpressionVector_Multiply1
+Description:
+
+	Verifies that a constant matrix times an elementwise function of a
+	vector produces a FunctionExpressionVector with the expected values.
+*/
+func TestFunctionExpressionVector_Multiply1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpressionVector_Multiply1")
+	x := symbolic.NewVariableVector(2, env)
+	A := symbolic.KMatrix{{1, 2}, {3, 4}}
+	values := map[symbolic.Variable]float64{x[0]: 0.1, x[1]: 0.2}
+
+	// Test
+	result := A.Multiply(symbolic.Sin(x))
+	resultAsFEV, tf := result.(symbolic.FunctionExpressionVector)
+	if !tf {
+		t.Errorf("expected a FunctionExpressionVector; received %T", result)
+	}
+
+	evaluated := resultAsFEV.Evaluate(values)
+	expected := []float64{
+		math.Sin(0.1) + 2*math.Sin(0.2),
+		3*math.Sin(0.1) + 4*math.Sin(0.2),
+	}
+	for ii := range expected {
+		if math.Abs(evaluated.AtVec(ii)-expected[ii]) > 1e-12 {
+			t.Errorf("expected element %v to be %v; received %v", ii, expected[ii], evaluated.AtVec(ii))
+		}
+	}
+}
+
+/*
+TestFunctionExpressionMatrix_Exp1
+Description:
+
+	Verifies that Exp() applied to a variable matrix creates a
+	FunctionExpressionMatrix with the same dimensions.
+*/
+func TestFunctionExpressionMatrix_Exp1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpressionMatrix_Exp1")
+	X := symbolic.NewVariableMatrix(2, 3, env)
+
+	// Test
+	result := symbolic.Exp(X)
+	resultAsFEM, tf := result.(symbolic.FunctionExpressionMatrix)
+	if !tf {
+		t.Errorf("expected a FunctionExpressionMatrix; received %T", result)
+	}
+
+	if resultAsFEM.Dims()[0] != 2 || resultAsFEM.Dims()[1] != 3 {
+		t.Errorf("expected dimensions (2,3); received %v", resultAsFEM.Dims())
+	}
+
+	if !strings.Contains(resultAsFEM.String(), "exp("+X[1][2].String()+")") {
+		t.Errorf("expected %v to contain exp(%v)", resultAsFEM.String(), X[1][2])
+	}
+}
+
+/*
+TestFunctionExpressionMatrix_Minus1
+Description:
+
+	Verifies that subtracting a function expression matrix from itself
+	produces a zero matrix.
+*/
+func TestFunctionExpressionMatrix_Minus1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestFunctionExpressionMatrix_Minus1")
+	X := symbolic.NewVariableMatrix(2, 2, env)
+	fem := symbolic.Cos(X).(symbolic.FunctionExpressionMatrix)
+
+	// Test
+	result := fem.Minus(fem)
+	if !result.Equals(symbolic.KMatrix{{0, 0}, {0, 0}}, 1e-12) {
+		t.Errorf("expected a zero matrix; received %v", result)
+	}
+}
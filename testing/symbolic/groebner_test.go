@@ -0,0 +1,213 @@
+package symbolic_test
+
+/*
+groebner_test.go
+Description:
+	Tests for the monomial orders, Reduce and GroebnerBasis
+	(defined in symbolic/groebner.go).
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestMonomialOrder_Check1
+Description:
+
+	Verifies that the Check() method returns an error for an unknown monomial order.
+*/
+func TestMonomialOrder_Check1(t *testing.T) {
+	// Constants
+	order := symbolic.MonomialOrder("revlex")
+
+	// Test
+	if err := order.Check(); err == nil {
+		t.Errorf("expected an error for the monomial order %v; received nil", order)
+	}
+
+	for _, order := range []symbolic.MonomialOrder{symbolic.LexOrder, symbolic.GradedLexOrder, symbolic.GradedReverseLexOrder} {
+		if err := order.Check(); err != nil {
+			t.Errorf("expected no error for the monomial order %v; received %v", order, err)
+		}
+	}
+}
+
+/*
+TestMonomialOrder_Compare1
+Description:
+
+	Verifies that the monomial orders compare x z^2 and y^3 (where x, y and z
+	have increasing IDs) as expected: x z^2 is larger in lex and grlex, but
+	smaller in grevlex (as it contains the last variable z).
+*/
+func TestMonomialOrder_Compare1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestMonomialOrder_Compare1")
+	x := symbolic.NewVariableVector(3, env)
+	m1 := x[0].Multiply(x[2].Power(2)).(symbolic.Monomial)
+	m2 := x[1].Power(3).(symbolic.Monomial)
+
+	// Test
+	expected := map[symbolic.MonomialOrder]int{
+		symbolic.LexOrder:              1,
+		symbolic.GradedLexOrder:        1,
+		symbolic.GradedReverseLexOrder: -1,
+	}
+	for order, comparison := range expected {
+		if result := order.Compare(m1, m2); result != comparison {
+			t.Errorf("expected %v to compare %v and %v as %v; received %v", order, m1, m2, comparison, result)
+		}
+
+		if result := order.Compare(m2, m1); result != -comparison {
+			t.Errorf("expected %v to compare %v and %v as %v; received %v", order, m2, m1, -comparison, result)
+		}
+	}
+
+	if result := symbolic.LexOrder.Compare(m1, m1.Multiply(3.0).(symbolic.Monomial)); result != 0 {
+		t.Errorf("expected the coefficients to be ignored; received %v", result)
+	}
+}
+
+/*
+TestReduce1
+Description:
+
+	Verifies that the remainder of the division of x^2 y + x y^2 + y^2 by
+	(x y - 1, y^2 - 1) in lex order is x + y + 1.
+*/
+func TestReduce1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestReduce1")
+	x := symbolic.NewVariableVector(2, env)
+	p := x[0].Power(2).Multiply(x[1]).Plus(x[0].Multiply(x[1].Power(2))).Plus(x[1].Power(2)).(symbolic.Polynomial)
+	basis := []symbolic.Polynomial{
+		x[0].Multiply(x[1]).Minus(1.0).(symbolic.Polynomial),
+		x[1].Power(2).Minus(1.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	remainder := symbolic.Reduce(p, basis, symbolic.LexOrder)
+	expected := x[0].Plus(x[1]).Plus(1.0)
+	if !remainder.Equals(expected, 1e-12) {
+		t.Errorf("expected the remainder %v; received %v", expected, remainder)
+	}
+}
+
+/*
+TestReduce2
+Description:
+
+	Verifies that an element of an ideal reduces to zero modulo the Groebner basis of the ideal.
+*/
+func TestReduce2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestReduce2")
+	x := symbolic.NewVariableVector(2, env)
+	f1 := x[0].Power(2).Plus(x[1].Power(2)).Minus(1.0).(symbolic.Polynomial)
+	f2 := x[0].Minus(x[1]).(symbolic.Polynomial)
+	basis := symbolic.GroebnerBasis([]symbolic.Polynomial{f1, f2}, symbolic.GradedReverseLexOrder)
+
+	// Test
+	p := f1.Multiply(x[1].Plus(3.0)).Plus(f2.Multiply(x[0].Power(3))).(symbolic.Polynomial)
+	remainder := symbolic.Reduce(p, basis, symbolic.GradedReverseLexOrder)
+	if !remainder.Equals(symbolic.K(0.0), 1e-12) {
+		t.Errorf("expected the remainder 0; received %v", remainder)
+	}
+}
+
+/*
+TestGroebnerBasis1
+Description:
+
+	Verifies that the reduced Groebner basis of (x^2 + y^2 - 1, x - y) in lex
+	order is (x - y, y^2 - 1/2): the last polynomial eliminates x.
+*/
+func TestGroebnerBasis1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestGroebnerBasis1")
+	x := symbolic.NewVariableVector(2, env)
+	polys := []symbolic.Polynomial{
+		x[0].Power(2).Plus(x[1].Power(2)).Minus(1.0).(symbolic.Polynomial),
+		x[0].Minus(x[1]).(symbolic.Polynomial),
+	}
+
+	// Test
+	basis := symbolic.GroebnerBasis(polys, symbolic.LexOrder)
+	expected := []symbolic.Expression{
+		x[0].Minus(x[1]),
+		x[1].Power(2).Minus(0.5),
+	}
+	if len(basis) != len(expected) {
+		t.Fatalf("expected the basis %v; received %v", expected, basis)
+	}
+
+	for ii := range expected {
+		if !basis[ii].Equals(expected[ii], 1e-12) {
+			t.Errorf("expected element %v of the basis to be %v; received %v", ii, expected[ii], basis[ii])
+		}
+	}
+}
+
+/*
+TestGroebnerBasis2
+Description:
+
+	Verifies that the reduced Groebner basis of (x^3 - 2 x y, x^2 y - 2 y^2 + x)
+	in grlex order is (x^2, x y, y^2 - x/2).
+*/
+func TestGroebnerBasis2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestGroebnerBasis2")
+	x := symbolic.NewVariableVector(2, env)
+	polys := []symbolic.Polynomial{
+		x[0].Power(3).Minus(x[0].Multiply(x[1]).Multiply(2.0)).(symbolic.Polynomial),
+		x[0].Power(2).Multiply(x[1]).Minus(x[1].Power(2).Multiply(2.0)).Plus(x[0]).(symbolic.Polynomial),
+	}
+
+	// Test
+	basis := symbolic.GroebnerBasis(polys, symbolic.GradedLexOrder)
+	expected := []symbolic.Expression{
+		x[0].Power(2),
+		x[0].Multiply(x[1]),
+		x[1].Power(2).Minus(x[0].Multiply(0.5)),
+	}
+	if len(basis) != len(expected) {
+		t.Fatalf("expected the basis %v; received %v", expected, basis)
+	}
+
+	for ii := range expected {
+		if !basis[ii].Equals(expected[ii], 1e-12) {
+			t.Errorf("expected element %v of the basis to be %v; received %v", ii, expected[ii], basis[ii])
+		}
+	}
+}
+
+/*
+TestGroebnerBasis3
+Description:
+
+	Verifies that the basis of an ideal which contains a nonzero constant is (1)
+	and that the basis of the zero ideal is empty.
+*/
+func TestGroebnerBasis3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestGroebnerBasis3")
+	x := symbolic.NewVariable(env)
+	polys := []symbolic.Polynomial{
+		x.Minus(1.0).(symbolic.Polynomial),
+		x.Minus(2.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	basis := symbolic.GroebnerBasis(polys, symbolic.GradedReverseLexOrder)
+	if len(basis) != 1 || !basis[0].Equals(symbolic.K(1.0), 1e-12) {
+		t.Errorf("expected the basis (1); received %v", basis)
+	}
+
+	if basis := symbolic.GroebnerBasis([]symbolic.Polynomial{symbolic.K(0.0).ToPolynomial()}, symbolic.LexOrder); len(basis) != 0 {
+		t.Errorf("expected an empty basis; received %v", basis)
+	}
+}
@@ -0,0 +1,175 @@
+package symbolic_test
+
+/*
+integrals_test.go
+Description:
+	Tests for the integration functions defined in symbolic/integrals.go
+	(IntegrateWrt, DefiniteIntegral, IntegrateOverBox and IntegrateOverUnitSimplex).
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestIntegrateWrt1
+Description:
+
+	Verifies that the antiderivative of 3 x^2 y with respect to x is x^3 y
+	and that the antiderivative of the constant 2 is 2 x.
+*/
+func TestIntegrateWrt1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestIntegrateWrt1")
+	x := symbolic.NewVariableVector(2, env)
+	m := x[0].Power(2).Multiply(x[1]).Multiply(3.0).(symbolic.Monomial)
+
+	// Test
+	antiderivative := symbolic.IntegrateWrt(m, x[0])
+	if expected := x[0].Power(3).Multiply(x[1]); !antiderivative.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, antiderivative)
+	}
+
+	antiderivative = symbolic.IntegrateWrt(symbolic.K(2.0), x[0])
+	if expected := x[0].Multiply(2.0); !antiderivative.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, antiderivative)
+	}
+}
+
+/*
+TestIntegrateWrt2
+Description:
+
+	Verifies that differentiating the antiderivative of each element of a
+	polynomial vector recovers the element.
+*/
+func TestIntegrateWrt2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestIntegrateWrt2")
+	x := symbolic.NewVariableVector(2, env)
+	pv := symbolic.PolynomialVector{
+		x[0].Power(3).Plus(x[0].Multiply(x[1])).Plus(4.0).(symbolic.Polynomial),
+		x[1].Power(2).Minus(x[0]).(symbolic.Polynomial),
+	}
+
+	// Test
+	antiderivative, tf := symbolic.IntegrateWrt(pv, x[0]).(symbolic.VectorExpression)
+	if !tf {
+		t.Fatalf("expected a VectorExpression; received %T", antiderivative)
+	}
+
+	for ii := 0; ii < pv.Len(); ii++ {
+		derivative := antiderivative.AtVec(ii).DerivativeWrt(x[0])
+		if !derivative.Equals(pv[ii], 1e-12) {
+			t.Errorf("expected the derivative of element %v to be %v; received %v", ii, pv[ii], derivative)
+		}
+	}
+}
+
+/*
+TestDefiniteIntegral1
+Description:
+
+	Verifies that the integral of x^2 + x y with respect to x from 0 to 2
+	is 8/3 + 2 y.
+*/
+func TestDefiniteIntegral1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestDefiniteIntegral1")
+	x := symbolic.NewVariableVector(2, env)
+	p := x[0].Power(2).Plus(x[0].Multiply(x[1])).(symbolic.Polynomial)
+
+	// Test
+	integral := symbolic.DefiniteIntegral(p, x[0], 0.0, 2.0)
+	expected := x[1].Multiply(2.0).Plus(8.0 / 3.0)
+	if !integral.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, integral)
+	}
+}
+
+/*
+TestIntegrateOverBox1
+Description:
+
+	Verifies that the integral of x y^2 over the box [0, 1] x [0, 2] is 4/3.
+*/
+func TestIntegrateOverBox1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestIntegrateOverBox1")
+	x := symbolic.NewVariableVector(2, env)
+	m := x[0].Multiply(x[1].Power(2)).(symbolic.Monomial)
+	lo := mat.NewVecDense(2, []float64{0.0, 0.0})
+	hi := mat.NewVecDense(2, []float64{1.0, 2.0})
+
+	// Test
+	integral := symbolic.IntegrateOverBox(m, x, *lo, *hi)
+	if _, tf := integral.(symbolic.K); !tf {
+		t.Errorf("expected a K; received %T", integral)
+	}
+
+	if !integral.Equals(symbolic.K(4.0/3.0), 1e-12) {
+		t.Errorf("expected 4/3; received %v", integral)
+	}
+}
+
+/*
+TestIntegrateOverBox2
+Description:
+
+	Verifies that IntegrateOverBox panics with a VectorDimensionError when the
+	bounds do not have one element per variable.
+*/
+func TestIntegrateOverBox2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestIntegrateOverBox2")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.VectorDimensionError); !tf {
+			t.Errorf("expected a VectorDimensionError; received %v", r)
+		}
+	}()
+
+	symbolic.IntegrateOverBox(x[0], x, *mat.NewVecDense(2, nil), *mat.NewVecDense(3, nil))
+}
+
+/*
+TestIntegrateOverUnitSimplex1
+Description:
+
+	Verifies the integrals of 1, x, x y and x^2 over the unit triangle
+	(1/2, 1/6, 1/24 and 1/12) and that the other variables are kept:
+	the integral of x z over the triangle in (x, y) is z / 6.
+*/
+func TestIntegrateOverUnitSimplex1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestIntegrateOverUnitSimplex1")
+	x := symbolic.NewVariableVector(3, env)
+	triangle := []symbolic.Variable{x[0], x[1]}
+
+	// Test
+	integrands := []symbolic.PolynomialLike{
+		symbolic.K(1.0),
+		x[0],
+		x[0].Multiply(x[1]).(symbolic.Monomial),
+		x[0].Power(2).(symbolic.Monomial),
+	}
+	expected := []float64{1.0 / 2.0, 1.0 / 6.0, 1.0 / 24.0, 1.0 / 12.0}
+	for ii, integrand := range integrands {
+		integral := symbolic.IntegrateOverUnitSimplex(integrand, triangle)
+		if !integral.Equals(symbolic.K(expected[ii]), 1e-12) {
+			t.Errorf("expected the integral of %v to be %v; received %v", integrand, expected[ii], integral)
+		}
+	}
+
+	integral := symbolic.IntegrateOverUnitSimplex(x[0].Multiply(x[2]).(symbolic.Monomial), triangle)
+	if expectedZ := x[2].Multiply(1.0 / 6.0); !integral.Equals(expectedZ, 1e-12) {
+		t.Errorf("expected %v; received %v", expectedZ, integral)
+	}
+}
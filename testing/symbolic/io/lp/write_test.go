@@ -0,0 +1,468 @@
+package lp_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/io/lp"
+)
+
+/*
+write_test.go
+Description:
+
+	Tests for the Write function in symbolic/io/lp/write.go.
+*/
+
+/*
+TestWrite1
+Description:
+
+	Tests that the Write function produces the expected LP file for a small
+	mixed integer linear program containing a vector constraint.
+*/
+func TestWrite1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite1")
+	x := symbolic.NewVariableVector(2, env)
+	b := symbolic.NewBinaryVariable(env)
+
+	objective := x.AtVec(0).Plus(x.AtVec(1).Multiply(2.0)).Plus(b.Multiply(-3.0))
+	constraints := []symbolic.Constraint{
+		x.LessEq(symbolic.KVector{4.0, 5.0}),
+		x.AtVec(0).Plus(x.AtVec(1)).Plus(b).GreaterEq(1.0),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective.(symbolic.ScalarExpression), symbolic.SenseMaximize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"Maximize",
+		" obj: 1 x_0 + 2 x_1 - 3 x_2",
+		"Subject To",
+		" c0: 1 x_0 <= 4",
+		" c1: 1 x_1 <= 5",
+		" c2: 1 x_0 + 1 x_1 + 1 x_2 >= 1",
+		"Bounds",
+		" x_0 free",
+		" x_1 free",
+		"Binaries",
+		" x_2",
+		"End",
+		"",
+	}, "\n")
+	if buffer.String() != expected {
+		t.Errorf(
+			"expected Write to produce\n%v\nreceived\n%v",
+			expected,
+			buffer.String(),
+		)
+	}
+}
+
+/*
+TestWrite2
+Description:
+
+	Tests that the Write function writes the quadratic terms of the objective
+	in the [ ... ]/2 syntax (with doubled coefficients) and the quadratic terms
+	of a constraint in the [ ... ] syntax.
+*/
+func TestWrite2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite2")
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewVariable(env)
+
+	objective := x.Power(2).Plus(x.Multiply(y).Multiply(3.0)).Plus(x)
+	constraints := []symbolic.Constraint{
+		x.Power(2).Plus(y.Power(2)).LessEq(4.0),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective.(symbolic.ScalarExpression), symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	for _, expectedLine := range []string{
+		" obj: 1 x_0 + [ 2 x_0 ^ 2 + 6 x_0 * x_1 ]/2\n",
+		" c0: [ 1 x_0 ^ 2 + 1 x_1 ^ 2 ] <= 4\n",
+	} {
+		if !strings.Contains(buffer.String(), expectedLine) {
+			t.Errorf(
+				"expected Write to produce the line %q; received\n%v",
+				expectedLine,
+				buffer.String(),
+			)
+		}
+	}
+}
+
+/*
+TestWrite3
+Description:
+
+	Tests that the Write function writes finite bounds, integer variables
+	and moves the constants of a constraint to its right hand side.
+*/
+func TestWrite3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite3")
+	x := symbolic.NewVariable(env)
+	x.Lower = -2.0
+	z := symbolic.NewVariable(env)
+	z.Lower, z.Upper, z.Type = 0.0, 10.0, symbolic.Integer
+
+	objective := x.Plus(z)
+	constraints := []symbolic.Constraint{
+		x.Plus(1.5).Eq(z.Minus(2.0)),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective.(symbolic.ScalarExpression), symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	for _, expectedLine := range []string{
+		" c0: 1 x_0 - 1 x_1 = -3.5\n",
+		" -2 <= x_0 <= +inf\n",
+		" 0 <= x_1 <= 10\n",
+		"Generals\n x_1\n",
+	} {
+		if !strings.Contains(buffer.String(), expectedLine) {
+			t.Errorf(
+				"expected Write to produce %q; received\n%v",
+				expectedLine,
+				buffer.String(),
+			)
+		}
+	}
+}
+
+/*
+TestWrite4
+Description:
+
+	Tests that the Write function returns a QuadraticExpressionRequiredError
+	when the objective has degree greater than 2.
+*/
+func TestWrite4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite4")
+	x := symbolic.NewVariable(env)
+	objective := x.Power(3).Plus(x).(symbolic.Polynomial)
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective, symbolic.SenseMinimize, nil)
+	if err == nil {
+		t.Errorf("expected Write to return an error; received nil")
+	}
+
+	expectedError := smErrors.QuadraticExpressionRequiredError{
+		Operation:  "lp.Write",
+		Expression: objective,
+	}
+	if err.Error() != expectedError.Error() {
+		t.Errorf(
+			"expected Write to return error \"%v\"; received \"%v\"",
+			expectedError,
+			err,
+		)
+	}
+}
+
+/*
+TestWrite5
+Description:
+
+	Tests that the Write function returns an error when two different variables
+	share the same name.
+*/
+func TestWrite5(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite5")
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewVariable(env)
+	y.Name = x.Name
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, x.Plus(y).(symbolic.ScalarExpression), symbolic.SenseMinimize, nil)
+	if err == nil {
+		t.Errorf("expected Write to return an error; received nil")
+	}
+
+	if !strings.Contains(err.Error(), "share the name") {
+		t.Errorf(
+			"expected Write to return an error about a shared name; received \"%v\"",
+			err,
+		)
+	}
+}
+
+/*
+TestWrite6
+Description:
+
+	Tests that the Write function returns an error when a variable has a name
+	that is not allowed in an LP file (i.e., it contains a space).
+*/
+func TestWrite6(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite6")
+	x := symbolic.NewVariable(env)
+	x.Name = "my variable"
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, x, symbolic.SenseMinimize, nil)
+	if err == nil {
+		t.Errorf("expected Write to return an error; received nil")
+	}
+
+	if !strings.Contains(err.Error(), "not a valid LP name") {
+		t.Errorf(
+			"expected Write to return an error about an invalid name; received \"%v\"",
+			err,
+		)
+	}
+}
+
+/*
+TestWrite7
+Description:
+
+	Tests that the Write function breaks long expressions into multiple lines
+	so that no line is longer than CPLEX allows.
+*/
+func TestWrite7(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite7")
+	x := symbolic.NewVariableVector(200, env)
+
+	var objective symbolic.Expression = symbolic.K(0.0)
+	for ii := 0; ii < x.Len(); ii++ {
+		objective = objective.Plus(x.AtVec(ii).Multiply(1.25))
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective.(symbolic.ScalarExpression), symbolic.SenseMinimize, nil)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	for _, line := range strings.Split(buffer.String(), "\n") {
+		if len(line) > 255 {
+			t.Errorf(
+				"expected all lines to contain at most 255 characters; received a line with %v",
+				len(line),
+			)
+		}
+	}
+}
+
+/*
+TestWrite8
+Description:
+
+	Tests that the Write function returns an error when the objective sense
+	is not recognized.
+*/
+func TestWrite8(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite8")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, x, symbolic.ObjSense("Find"), nil)
+	if err == nil {
+		t.Errorf("expected Write to return an error; received nil")
+	}
+
+	expectedError := symbolic.ObjSense("Find").Check()
+	if err.Error() != expectedError.Error() {
+		t.Errorf(
+			"expected Write to return error \"%v\"; received \"%v\"",
+			expectedError,
+			err,
+		)
+	}
+}
+
+/*
+TestWrite9
+Description:
+
+	Tests that the Write function returns an UnsupportedConstraintError (instead
+	of panicking) when one of the constraints is a second-order cone constraint.
+*/
+func TestWrite9(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite9")
+	x := symbolic.NewVariableVector(2, env)
+	y := symbolic.NewVariable(env)
+	constraints := []symbolic.Constraint{
+		symbolic.NewSecondOrderConeConstraint(x, y),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, y, symbolic.SenseMinimize, constraints)
+	if _, tf := err.(smErrors.UnsupportedConstraintError); !tf {
+		t.Errorf("expected Write to return an UnsupportedConstraintError; received %v", err)
+	}
+}
+
+/*
+TestWrite10
+Description:
+
+	Tests that the Write function writes a right hand side of 0 (and not -0)
+	for linear and quadratic constraints that do not have a constant term.
+*/
+func TestWrite10(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite10")
+	x := symbolic.NewVariableVector(2, env)
+
+	objective := x.AtVec(0).Plus(x.AtVec(1))
+	constraints := []symbolic.Constraint{
+		x.AtVec(0).Plus(x.AtVec(1)).LessEq(0.0),
+		x.AtVec(0).Multiply(x.AtVec(0)).LessEq(x.AtVec(1)),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective.(symbolic.ScalarExpression), symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"Minimize",
+		" obj: 1 x_0 + 1 x_1",
+		"Subject To",
+		" c0: 1 x_0 + 1 x_1 <= 0",
+		" c1: - 1 x_1 + [ 1 x_0 ^ 2 ] <= 0",
+		"Bounds",
+		" x_0 free",
+		" x_1 free",
+		"End",
+		"",
+	}, "\n")
+	if buffer.String() != expected {
+		t.Errorf(
+			"expected Write to produce\n%v\nreceived\n%v",
+			expected,
+			buffer.String(),
+		)
+	}
+}
+
+/*
+TestWrite11
+Description:
+
+	Tests that the Write function writes each range constraint (and each
+	element of a vector range constraint) as a single ranged row, moving the
+	constant of the expression into the bounds and omitting infinite bounds.
+*/
+func TestWrite11(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite11")
+	x := symbolic.NewVariableVector(2, env)
+
+	objective := x.AtVec(0).Plus(x.AtVec(1))
+	constraints := []symbolic.Constraint{
+		x.AtVec(0).Plus(x.AtVec(1)).Plus(1.0).(symbolic.ScalarExpression).Between(2.0, 5.0),
+		x.Between(symbolic.KVector{-1.0, 0.0}, symbolic.KVector{1.0, symbolic.Infinity}),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective.(symbolic.ScalarExpression), symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"Minimize",
+		" obj: 1 x_0 + 1 x_1",
+		"Subject To",
+		" c0: 1 <= 1 x_0 + 1 x_1 <= 4",
+		" c1: -1 <= 1 x_0 <= 1",
+		" c2: 1 x_1 >= 0",
+		"Bounds",
+		" x_0 free",
+		" x_1 free",
+		"End",
+		"",
+	}, "\n")
+	if buffer.String() != expected {
+		t.Errorf(
+			"expected Write to produce\n%v\nreceived\n%v",
+			expected,
+			buffer.String(),
+		)
+	}
+}
+
+/*
+TestWrite12
+Description:
+
+	Tests that the Write function skips the rows of range constraints whose
+	bounds are both infinite (instead of writing -inf <= expression <= +inf),
+	while still writing the bounds of their variables.
+*/
+func TestWrite12(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite12")
+	x := symbolic.NewVariableVector(2, env)
+
+	objective := x.AtVec(0)
+	constraints := []symbolic.Constraint{
+		x.Between(symbolic.KVector{-symbolic.Infinity, 0.0}, symbolic.KVector{symbolic.Infinity, 1.0}),
+		x.AtVec(1).Between(-symbolic.Infinity, symbolic.Infinity),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := lp.Write(&buffer, objective, symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"Minimize",
+		" obj: 1 x_0",
+		"Subject To",
+		" c0: 0 <= 1 x_1 <= 1",
+		"Bounds",
+		" x_0 free",
+		" x_1 free",
+		"End",
+		"",
+	}, "\n")
+	if buffer.String() != expected {
+		t.Errorf(
+			"expected Write to produce\n%v\nreceived\n%v",
+			expected,
+			buffer.String(),
+		)
+	}
+}
@@ -0,0 +1,308 @@
+package mps_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/io/mps"
+)
+
+/*
+read_test.go
+Description:
+
+	Tests for the Read function in symbolic/io/mps/read.go.
+*/
+
+/*
+TestRead1
+Description:
+
+	Tests that the Read function correctly parses a free MPS file containing
+	comments, integer markers, bounds of several types and an objective constant.
+*/
+func TestRead1(t *testing.T) {
+	// Constants
+	file := strings.Join([]string{
+		"* A small test problem",
+		"NAME          test1",
+		"OBJSENSE",
+		"    MAX",
+		"ROWS",
+		" N  cost",
+		" L  lim1",
+		" G  lim2",
+		" E  myeqn",
+		"COLUMNS",
+		"    x1  cost  1  lim1  1",
+		"    x1  lim2  1",
+		"    MARKER  'MARKER'  'INTORG'",
+		"    x2  cost  2  lim1  1",
+		"    x2  myeqn  -1",
+		"    MARKER  'MARKER'  'INTEND'",
+		"    x3  cost  -1  myeqn  1",
+		"RHS",
+		"    RHS  cost  -3",
+		"    RHS  lim1  4  lim2  1",
+		"    RHS  myeqn  7",
+		"BOUNDS",
+		" UP BND  x1  4",
+		" MI BND  x2",
+		" UP BND  x2  1",
+		" BV BND  x3",
+		"ENDATA",
+	}, "\n")
+	env := symbolic.NewBasicEnvironment("TestRead1")
+
+	// Test
+	model, err := mps.Read(strings.NewReader(file), env)
+	if err != nil {
+		t.Errorf("expected Read to succeed; received error %v", err)
+	}
+
+	if model.Name != "test1" || model.Sense != symbolic.SenseMaximize {
+		t.Errorf(
+			"expected a maximization problem named test1; received %v (%v)",
+			model.Name,
+			model.Sense,
+		)
+	}
+
+	// Check the variables
+	expectedVariables := []symbolic.Variable{
+		{Name: "x1", Lower: 0.0, Upper: 4.0, Type: symbolic.Continuous},
+		{Name: "x2", Lower: -float64(symbolic.Infinity), Upper: 1.0, Type: symbolic.Integer},
+		{Name: "x3", Lower: 0.0, Upper: 1.0, Type: symbolic.Binary},
+	}
+	if len(model.Variables) != len(expectedVariables) {
+		t.Errorf(
+			"expected %v variables; received %v",
+			len(expectedVariables),
+			len(model.Variables),
+		)
+	}
+	for ii, expected := range expectedVariables {
+		v := model.Variables[ii]
+		if v.Name != expected.Name || v.Lower != expected.Lower || v.Upper != expected.Upper || v.Type != expected.Type {
+			t.Errorf(
+				"expected variable %v to be %+v; received %+v",
+				ii,
+				expected,
+				v,
+			)
+		}
+	}
+
+	if len(env.AllTrackedVariables()) != 3 {
+		t.Errorf(
+			"expected the environment to track 3 variables; received %v",
+			len(env.AllTrackedVariables()),
+		)
+	}
+
+	// Check the objective (x1 + 2 x2 - x3 + 3) at (1, 2, 1)
+	values := map[symbolic.Variable]float64{
+		model.Variables[0]: 1.0,
+		model.Variables[1]: 2.0,
+		model.Variables[2]: 1.0,
+	}
+	if model.Objective.Evaluate(values) != 7.0 {
+		t.Errorf(
+			"expected the objective to evaluate to 7; received %v",
+			model.Objective.Evaluate(values),
+		)
+	}
+
+	// Check the constraints
+	expectedSenses := []symbolic.ConstrSense{
+		symbolic.SenseLessThanEqual,
+		symbolic.SenseGreaterThanEqual,
+		symbolic.SenseEqual,
+	}
+	expectedLHS := []float64{3.0, 1.0, -1.0}
+	expectedRHS := []float64{4.0, 1.0, 7.0}
+	if len(model.Constraints) != len(expectedSenses) {
+		t.Errorf(
+			"expected %v constraints; received %v",
+			len(expectedSenses),
+			len(model.Constraints),
+		)
+	}
+	for ii, c := range model.Constraints {
+		constraint := c.(symbolic.ScalarConstraint)
+		lhs := constraint.LeftHandSide.Evaluate(values)
+		rhs := constraint.RightHandSide.Evaluate(values)
+		if constraint.Sense != expectedSenses[ii] || lhs != expectedLHS[ii] || rhs != expectedRHS[ii] {
+			t.Errorf(
+				"expected constraint %v to be %v %v %v; received %v %v %v",
+				ii,
+				expectedLHS[ii], expectedSenses[ii], expectedRHS[ii],
+				lhs, constraint.Sense, rhs,
+			)
+		}
+	}
+}
+
+/*
+TestRead2
+Description:
+
+	Tests that the Read function turns ranged rows into range constraints
+	and gives fixed variables equal lower and upper bounds
+	(without adding a constraint).
+*/
+func TestRead2(t *testing.T) {
+	// Constants
+	file := strings.Join([]string{
+		"NAME ranged",
+		"ROWS",
+		" N obj",
+		" E r1",
+		"COLUMNS",
+		" x obj 1 r1 1",
+		" y obj 1 r1 1",
+		"RHS",
+		" r1 2",
+		"RANGES",
+		" RNG r1 -3",
+		"BOUNDS",
+		" FX BND y 1.5",
+		"ENDATA",
+	}, "\n")
+	env := symbolic.NewBasicEnvironment("TestRead2")
+
+	// Test
+	model, err := mps.Read(strings.NewReader(file), env)
+	if err != nil {
+		t.Errorf("expected Read to succeed; received error %v", err)
+	}
+
+	if len(model.Constraints) != 1 {
+		t.Fatalf(
+			"expected 1 constraint; received %v",
+			len(model.Constraints),
+		)
+	}
+
+	// The ranged equality row with a negative range is -1 <= x + y <= 2
+	rangeConstraint, tf := model.Constraints[0].(symbolic.ScalarRangeConstraint)
+	if !tf {
+		t.Fatalf("expected a ScalarRangeConstraint; received %T", model.Constraints[0])
+	}
+
+	if rangeConstraint.Lower() != -1.0 || rangeConstraint.Upper() != 2.0 {
+		t.Errorf(
+			"expected the range constraint to have bounds [-1, 2]; received %v",
+			rangeConstraint,
+		)
+	}
+
+	if model.Variables[1].Lower != 1.5 || model.Variables[1].Upper != 1.5 {
+		t.Errorf(
+			"expected the fixed variable to have bounds [1.5, 1.5]; received [%v, %v]",
+			model.Variables[1].Lower,
+			model.Variables[1].Upper,
+		)
+	}
+}
+
+/*
+TestRead3
+Description:
+
+	Tests that the Read function returns an error when a column refers to
+	a row that was not defined in the ROWS section.
+*/
+func TestRead3(t *testing.T) {
+	// Constants
+	file := strings.Join([]string{
+		"NAME bad",
+		"ROWS",
+		" N obj",
+		"COLUMNS",
+		" x obj 1 r1 1",
+		"ENDATA",
+	}, "\n")
+	env := symbolic.NewBasicEnvironment("TestRead3")
+
+	// Test
+	_, err := mps.Read(strings.NewReader(file), env)
+	if err == nil {
+		t.Errorf("expected Read to return an error; received nil")
+	}
+
+	if !strings.Contains(err.Error(), "unknown row r1") {
+		t.Errorf(
+			"expected Read to return an error about the unknown row; received \"%v\"",
+			err,
+		)
+	}
+}
+
+/*
+TestRead4
+Description:
+
+	Tests that the Read function returns an error when the file does not
+	contain the ENDATA section.
+*/
+func TestRead4(t *testing.T) {
+	// Constants
+	file := strings.Join([]string{
+		"NAME truncated",
+		"ROWS",
+		" N obj",
+		"COLUMNS",
+		" x obj 1",
+	}, "\n")
+	env := symbolic.NewBasicEnvironment("TestRead4")
+
+	// Test
+	_, err := mps.Read(strings.NewReader(file), env)
+	if err == nil {
+		t.Errorf("expected Read to return an error; received nil")
+	}
+
+	if !strings.Contains(err.Error(), "ENDATA") {
+		t.Errorf(
+			"expected Read to return an error about the missing ENDATA section; received \"%v\"",
+			err,
+		)
+	}
+}
+
+/*
+TestRead5
+Description:
+
+	Tests that the Read function returns an error (without creating any
+	variable) when a column has the same name as a variable of the environment.
+*/
+func TestRead5(t *testing.T) {
+	// Constants
+	file := strings.Join([]string{
+		"NAME clash",
+		"ROWS",
+		" N obj",
+		"COLUMNS",
+		" a obj 1",
+		" x obj 1",
+		"ENDATA",
+	}, "\n")
+	env := symbolic.NewBasicEnvironment("TestRead5")
+	symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 1.0, "x", env)
+
+	// Test
+	_, err := mps.Read(strings.NewReader(file), env)
+	if err == nil {
+		t.Errorf("expected Read to return an error; received nil")
+	}
+
+	if len(env.AllTrackedVariables()) != 1 {
+		t.Errorf(
+			"expected Read to leave the environment unchanged; received %v",
+			env.AllTrackedVariables(),
+		)
+	}
+}
@@ -0,0 +1,414 @@
+package mps_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/io/mps"
+)
+
+/*
+write_test.go
+Description:
+
+	Tests for the Write function in symbolic/io/mps/write.go.
+*/
+
+/*
+TestWrite1
+Description:
+
+	Tests that the Write function produces the expected MPS file for a small
+	mixed integer linear program with an integer and a binary variable.
+*/
+func TestWrite1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite1")
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewCustomVariable(symbolic.Integer, 0.0, 10.0, "y", env)
+	b := symbolic.NewBinaryVariable(env)
+
+	objective := x.Plus(y.Multiply(2.0)).Plus(b.Multiply(-3.0)).Plus(5.0)
+	constraints := []symbolic.Constraint{
+		x.Plus(y).GreaterEq(1.0),
+		x.Minus(y).LessEq(4.0),
+		x.Plus(b).Eq(2.0),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := mps.Write(&buffer, objective.(symbolic.ScalarExpression), symbolic.SenseMaximize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"NAME",
+		"OBJSENSE",
+		"    MAX",
+		"ROWS",
+		" N  obj",
+		" G  c0",
+		" L  c1",
+		" E  c2",
+		"COLUMNS",
+		"    x_0  obj  1",
+		"    x_0  c0  1",
+		"    x_0  c1  1",
+		"    x_0  c2  1",
+		"    MARKER  'MARKER'  'INTORG'",
+		"    y  obj  2",
+		"    y  c0  1",
+		"    y  c1  -1",
+		"    MARKER  'MARKER'  'INTEND'",
+		"    x_2  obj  -3",
+		"    x_2  c2  1",
+		"RHS",
+		"    RHS  obj  -5",
+		"    RHS  c0  1",
+		"    RHS  c1  4",
+		"    RHS  c2  2",
+		"BOUNDS",
+		" FR BND  x_0",
+		" LO BND  y  0",
+		" UP BND  y  10",
+		" BV BND  x_2",
+		"ENDATA",
+		"",
+	}, "\n")
+	if buffer.String() != expected {
+		t.Errorf(
+			"expected Write to produce\n%v\nreceived\n%v",
+			expected,
+			buffer.String(),
+		)
+	}
+}
+
+/*
+TestWrite2
+Description:
+
+	Tests that the Write function returns a LinearExpressionRequiredError
+	when the objective is quadratic.
+*/
+func TestWrite2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite2")
+	x := symbolic.NewVariable(env)
+	objective := x.Power(2).Plus(x).(symbolic.Polynomial)
+
+	// Test
+	var buffer bytes.Buffer
+	err := mps.Write(&buffer, objective, symbolic.SenseMinimize, nil)
+	if err == nil {
+		t.Errorf("expected Write to return an error; received nil")
+	}
+
+	expectedError := smErrors.LinearExpressionRequiredError{
+		Operation:  "mps.Write",
+		Expression: objective,
+	}
+	if err.Error() != expectedError.Error() {
+		t.Errorf(
+			"expected Write to return error \"%v\"; received \"%v\"",
+			expectedError,
+			err,
+		)
+	}
+}
+
+/*
+TestWrite3
+Description:
+
+	Tests that a problem written by Write and then parsed by Read describes
+	the same problem (i.e., the objective and constraints evaluate to the same
+	values and the variables keep their bounds, types and names).
+*/
+func TestWrite3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite3")
+	x := symbolic.NewCustomVariable(symbolic.Continuous, -1.0, 3.0, "x", env)
+	y := symbolic.NewCustomVariable(symbolic.Integer, -float64(symbolic.Infinity), 7.0, "y", env)
+	z := symbolic.NewBinaryVariable(env)
+
+	objective := x.Multiply(1.5).Minus(y).Plus(z).Plus(2.0).(symbolic.ScalarExpression)
+	constraints := []symbolic.Constraint{
+		x.Plus(y.Multiply(2.0)).LessEq(z.Plus(4.0)),
+		x.Minus(z).GreaterEq(-1.0),
+	}
+
+	// Write and then read the problem
+	var buffer bytes.Buffer
+	err := mps.Write(&buffer, objective, symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Errorf("expected Write to succeed; received error %v", err)
+	}
+
+	readEnv := symbolic.NewBasicEnvironment("TestWrite3-read")
+	model, err := mps.Read(&buffer, readEnv)
+	if err != nil {
+		t.Errorf("expected Read to succeed; received error %v", err)
+	}
+
+	// Test
+	if len(model.Variables) != 3 || len(model.Constraints) != 2 {
+		t.Errorf(
+			"expected 3 variables and 2 constraints; received %v and %v",
+			len(model.Variables),
+			len(model.Constraints),
+		)
+	}
+
+	for ii, original := range []symbolic.Variable{x, y, z} {
+		read := model.Variables[ii]
+		if read.Name != original.Name || read.Lower != original.Lower ||
+			read.Upper != original.Upper || read.Type != original.Type {
+			t.Errorf(
+				"expected variable %v to match %+v; received %+v",
+				ii,
+				original,
+				read,
+			)
+		}
+	}
+
+	point := []float64{0.5, -2.0, 1.0}
+	originalValues := map[symbolic.Variable]float64{x: point[0], y: point[1], z: point[2]}
+	readValues := map[symbolic.Variable]float64{}
+	for ii, v := range model.Variables {
+		readValues[v] = point[ii]
+	}
+
+	if objective.Evaluate(originalValues) != model.Objective.Evaluate(readValues) {
+		t.Errorf(
+			"expected objectives to have the same value; received %v and %v",
+			objective.Evaluate(originalValues),
+			model.Objective.Evaluate(readValues),
+		)
+	}
+
+	for ii, original := range constraints {
+		constraint := original.(symbolic.ScalarConstraint)
+		readConstraint := model.Constraints[ii].(symbolic.ScalarConstraint)
+		originalSlack := constraint.LeftHandSide.Evaluate(originalValues) - constraint.RightHandSide.Evaluate(originalValues)
+		readSlack := readConstraint.LeftHandSide.Evaluate(readValues) - readConstraint.RightHandSide.Evaluate(readValues)
+		if originalSlack != readSlack || constraint.Sense != readConstraint.Sense {
+			t.Errorf(
+				"expected constraint %v to be preserved; received %v (slack %v) instead of %v (slack %v)",
+				ii,
+				readConstraint,
+				readSlack,
+				constraint,
+				originalSlack,
+			)
+		}
+	}
+}
+
+/*
+TestWrite4
+Description:
+
+	Tests that a file with a fixed (FX) column survives a round trip through
+	Read and Write: Read gives the column equal lower and upper bounds, which
+	Write turns back into an FX bound, so that writing the problem read back
+	gives the same file.
+*/
+func TestWrite4(t *testing.T) {
+	// Constants
+	file := strings.Join([]string{
+		"NAME fixed",
+		"ROWS",
+		" N obj",
+		" L r1",
+		"COLUMNS",
+		" x obj 1 r1 1",
+		" y obj 2 r1 1",
+		"RHS",
+		" r1 4",
+		"BOUNDS",
+		" FX BND y 1.5",
+		"ENDATA",
+	}, "\n")
+
+	// Read and write the problem twice
+	var written []string
+	reader := io.Reader(strings.NewReader(file))
+	for ii := 0; ii < 2; ii++ {
+		model, err := mps.Read(reader, symbolic.NewBasicEnvironment(fmt.Sprintf("TestWrite4-%v", ii)))
+		if err != nil {
+			t.Fatalf("expected Read to succeed; received error %v", err)
+		}
+
+		var buffer bytes.Buffer
+		err = mps.Write(&buffer, model.Objective, model.Sense, model.Constraints)
+		if err != nil {
+			t.Fatalf("expected Write to succeed; received error %v", err)
+		}
+
+		written = append(written, buffer.String())
+		reader = &buffer
+	}
+
+	// Test
+	expected := strings.Join([]string{
+		"NAME",
+		"OBJSENSE",
+		"    MIN",
+		"ROWS",
+		" N  obj",
+		" L  c0",
+		"COLUMNS",
+		"    x  obj  1",
+		"    x  c0  1",
+		"    y  obj  2",
+		"    y  c0  1",
+		"RHS",
+		"    RHS  c0  4",
+		"BOUNDS",
+		" LO BND  x  0",
+		" FX BND  y  1.5",
+		"ENDATA",
+		"",
+	}, "\n")
+	for ii, out := range written {
+		if out != expected {
+			t.Errorf(
+				"expected Write to produce\n%v\nreceived (pass %v)\n%v",
+				expected,
+				ii,
+				out,
+			)
+		}
+	}
+}
+
+/*
+TestWrite5
+Description:
+
+	Tests that the Write function writes a constraint v == value on a single
+	variable as a row (in the same position as in the input) instead of
+	turning it into an FX bound, and only writes FX for variables whose own
+	lower and upper bounds are equal.
+*/
+func TestWrite5(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite5")
+	x := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 5.0, "x", env)
+	y := symbolic.NewCustomVariable(symbolic.Continuous, 2.0, 2.0, "y", env)
+
+	constraints := []symbolic.Constraint{
+		x.Eq(1.5),
+		x.Plus(y).LessEq(4.0),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := mps.Write(&buffer, x.ToMonomial(), symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Fatalf("expected Write to succeed; received error %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"NAME",
+		"OBJSENSE",
+		"    MIN",
+		"ROWS",
+		" N  obj",
+		" E  c0",
+		" L  c1",
+		"COLUMNS",
+		"    x  obj  1",
+		"    x  c0  1",
+		"    x  c1  1",
+		"    y  c1  1",
+		"RHS",
+		"    RHS  c0  1.5",
+		"    RHS  c1  4",
+		"BOUNDS",
+		" LO BND  x  0",
+		" UP BND  x  5",
+		" FX BND  y  2",
+		"ENDATA",
+		"",
+	}, "\n")
+	if buffer.String() != expected {
+		t.Errorf("expected Write to produce\n%v\nreceived\n%v", expected, buffer.String())
+	}
+}
+
+/*
+TestWrite6
+Description:
+
+	Tests that the Write function writes each range constraint (and each
+	element of a vector range constraint) as a single row with a RANGES entry
+	(or as an inequality when one bound is infinite), and that Read turns the
+	ranged row back into a range constraint with the same bounds.
+*/
+func TestWrite6(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestWrite6")
+	x := symbolic.NewVariableVector(2, env)
+
+	constraints := []symbolic.Constraint{
+		x.AtVec(0).Plus(x.AtVec(1)).Plus(1.0).(symbolic.ScalarExpression).Between(2.0, 5.0),
+		x.Between(symbolic.KVector{-symbolic.Infinity, 0.0}, symbolic.KVector{1.0, 3.0}),
+	}
+
+	// Test
+	var buffer bytes.Buffer
+	err := mps.Write(&buffer, x[0].ToMonomial(), symbolic.SenseMinimize, constraints)
+	if err != nil {
+		t.Fatalf("expected Write to succeed; received error %v", err)
+	}
+
+	expected := strings.Join([]string{
+		"NAME",
+		"OBJSENSE",
+		"    MIN",
+		"ROWS",
+		" N  obj",
+		" G  c0",
+		" L  c1",
+		" G  c2",
+		"COLUMNS",
+		"    x_0  obj  1",
+		"    x_0  c0  1",
+		"    x_0  c1  1",
+		"    x_1  c0  1",
+		"    x_1  c2  1",
+		"RHS",
+		"    RHS  c0  1",
+		"    RHS  c1  1",
+		"RANGES",
+		"    RNG  c0  3",
+		"    RNG  c2  3",
+		"BOUNDS",
+		" FR BND  x_0",
+		" FR BND  x_1",
+		"ENDATA",
+		"",
+	}, "\n")
+	if buffer.String() != expected {
+		t.Fatalf("expected Write to produce\n%v\nreceived\n%v", expected, buffer.String())
+	}
+
+	model, err := mps.Read(&buffer, symbolic.NewBasicEnvironment("TestWrite6-read"))
+	if err != nil {
+		t.Fatalf("expected Read to succeed; received error %v", err)
+	}
+
+	rangeConstraint, tf := model.Constraints[0].(symbolic.ScalarRangeConstraint)
+	if !tf || rangeConstraint.Lower() != 1.0 || rangeConstraint.Upper() != 4.0 {
+		t.Errorf("expected the first row to be read as 1 <= x_0 + x_1 <= 4; received %v", model.Constraints[0])
+	}
+}
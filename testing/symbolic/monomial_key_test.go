@@ -0,0 +1,178 @@
+package symbolic_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+monomial_key_test.go
+Description:
+	Tests for the functions mentioned in the monomial_key.go file.
+*/
+
+/*
+TestMonomial_Key1
+Description:
+
+	Verifies that monomials with the same variables and exponents have the same key,
+	regardless of the order of their factors, of their coefficients, of repeated
+	factors and of factors with exponent zero.
+*/
+func TestMonomial_Key1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	m1 := symbolic.Monomial{Coefficient: 2.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{1, 2}}
+	sameForms := []symbolic.Monomial{
+		{Coefficient: -3.0, VariableFactors: []symbolic.Variable{y, x}, Exponents: []int{2, 1}},
+		{Coefficient: 1.0, VariableFactors: []symbolic.Variable{y, x, y}, Exponents: []int{1, 1, 1}},
+		{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{1, 2}},
+	}
+
+	// Test
+	for _, m2 := range sameForms {
+		if m1.Key() != m2.Key() {
+			t.Errorf("expected %v and %v to have the same key", m1, m2)
+		}
+	}
+
+	constant := symbolic.Monomial{Coefficient: 4.0, VariableFactors: []symbolic.Variable{x}, Exponents: []int{0}}
+	if constant.Key() != symbolic.K(4.0).ToMonomial().Key() {
+		t.Errorf("expected %v to have the key of a constant; received %q", constant, constant.Key())
+	}
+}
+
+/*
+TestMonomial_Key2
+Description:
+
+	Verifies that monomials with different forms have different keys.
+*/
+func TestMonomial_Key2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	monomials := []symbolic.Monomial{
+		x.ToMonomial(),
+		y.ToMonomial(),
+		x.Power(2).(symbolic.Monomial),
+		{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{1, 2}},
+		{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{2, 1}},
+		symbolic.K(1.0).ToMonomial(),
+	}
+
+	// Test
+	for ii := range monomials {
+		for jj := ii + 1; jj < len(monomials); jj++ {
+			if monomials[ii].Key() == monomials[jj].Key() {
+				t.Errorf("expected %v and %v to have different keys", monomials[ii], monomials[jj])
+			}
+		}
+	}
+}
+
+/*
+TestMonomial_Multiply_Canonical1
+Description:
+
+	Verifies that Plus and Multiply return monomials whose factors are sorted by
+	variable ID (with repeated factors merged), even when their inputs are not:
+	(y x^0 x) * y x gives x^2 y^2, and y x + 2 x y gives 3 x y.
+*/
+func TestMonomial_Multiply_Canonical1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestMonomial_Multiply_Canonical1")
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewVariable(env)
+	unsorted := symbolic.Monomial{Coefficient: 1.0, VariableFactors: []symbolic.Variable{y, x, x}, Exponents: []int{1, 0, 1}}
+
+	// Test
+	product, tf := unsorted.Multiply(y.Multiply(x)).(symbolic.Monomial)
+	if !tf {
+		t.Fatalf("expected the product to be a Monomial; received %T", product)
+	}
+
+	if len(product.VariableFactors) != 2 || product.VariableFactors[0].ID != x.ID || product.VariableFactors[1].ID != y.ID ||
+		product.Exponents[0] != 2 || product.Exponents[1] != 2 {
+		t.Errorf("expected the product to be x^2 y^2 (in this order); received %v", product)
+	}
+
+	sum, tf := unsorted.Plus(x.Multiply(y).Multiply(2.0)).(symbolic.Monomial)
+	if !tf {
+		t.Fatalf("expected the sum to be a Monomial; received %T", sum)
+	}
+
+	if sum.Coefficient != 3.0 || len(sum.VariableFactors) != 2 || sum.VariableFactors[0].ID != x.ID || sum.VariableFactors[1].ID != y.ID {
+		t.Errorf("expected the sum to be 3 x y (in this order); received %v", sum)
+	}
+}
+
+/*
+TestMonomial_MatchesFormOf1
+Description:
+
+	Verifies that MatchesFormOf compares the exponent of each variable with the
+	exponent of the same variable in the other monomial (x y^2 and y x^2 do not match,
+	while x y^2 and y^2 x do).
+*/
+func TestMonomial_MatchesFormOf1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	m1 := symbolic.Monomial{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{1, 2}}
+	m2 := symbolic.Monomial{Coefficient: 1.0, VariableFactors: []symbolic.Variable{y, x}, Exponents: []int{1, 2}}
+	m3 := symbolic.Monomial{Coefficient: 5.0, VariableFactors: []symbolic.Variable{y, x}, Exponents: []int{2, 1}}
+
+	// Test
+	if m1.MatchesFormOf(m2) {
+		t.Errorf("expected %v not to match the form of %v", m1, m2)
+	}
+
+	if !m1.MatchesFormOf(m3) {
+		t.Errorf("expected %v to match the form of %v", m1, m3)
+	}
+}
+
+/*
+TestPolynomial_Simplify3
+Description:
+
+	Verifies that the Polynomial.Simplify method combines terms whose factors are
+	written in different orders, keeps the terms in the order in which they first
+	appear and removes the terms that cancel.
+*/
+func TestPolynomial_Simplify3(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	p := symbolic.Polynomial{
+		Monomials: []symbolic.Monomial{
+			{Coefficient: 1.0, VariableFactors: []symbolic.Variable{x, y}, Exponents: []int{1, 1}},
+			{Coefficient: 2.0, VariableFactors: []symbolic.Variable{y}, Exponents: []int{1}},
+			{Coefficient: 3.0, VariableFactors: []symbolic.Variable{y, x}, Exponents: []int{1, 1}},
+			{Coefficient: 4.0, VariableFactors: []symbolic.Variable{}, Exponents: []int{}},
+			{Coefficient: -2.0, VariableFactors: []symbolic.Variable{y}, Exponents: []int{1}},
+		},
+	}
+
+	// Test
+	simplified := p.Simplify()
+	if len(simplified.Monomials) != 2 {
+		t.Fatalf("expected 2 monomials after simplification; received %v", simplified)
+	}
+
+	if !simplified.Monomials[0].IsConstant() || simplified.Monomials[0].Coefficient != 4.0 {
+		t.Errorf("expected the first monomial to be the constant 4; received %v", simplified.Monomials[0])
+	}
+
+	product := simplified.Monomials[1]
+	if product.Coefficient != 4.0 || product.VariableFactors[0].ID != x.ID || product.VariableFactors[1].ID != y.ID {
+		t.Errorf("expected the second monomial to be 4 x y; received %v", product)
+	}
+}
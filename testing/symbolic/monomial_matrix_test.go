@@ -2425,3 +2425,34 @@ func TestMonomialMatrix_Power1(t *testing.T) {
 		}
 	}
 }
+
+/*
+TestMonomialMatrix_ToPolynomialMatrix1
+Description:
+
+	Tests that the ToPolynomialMatrix() method converts each monomial of the
+	matrix into a polynomial with that single monomial.
+*/
+func TestMonomialMatrix_ToPolynomialMatrix1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	var mm symbolic.MonomialMatrix = [][]symbolic.Monomial{
+		{x.ToMonomial(), x.Power(2).(symbolic.Monomial)},
+		{symbolic.K(3.0).ToMonomial(), x.Multiply(-2.0).(symbolic.Monomial)},
+	}
+
+	// Test
+	pm := mm.ToPolynomialMatrix()
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			if len(pm[ii][jj].Monomials) != 1 || !pm[ii][jj].Equals(mm[ii][jj], 1e-12) {
+				t.Errorf(
+					"expected element (%v,%v) to be the polynomial %v; received %v",
+					ii, jj,
+					mm[ii][jj],
+					pm[ii][jj],
+				)
+			}
+		}
+	}
+}
@@ -1605,6 +1605,43 @@ func TestMonomial_DerivativeWrt5(t *testing.T) {
 	}
 }
 
+/*
+TestMonomial_DerivativeWrt6
+Description:
+
+	Verifies that the Monomial.DerivativeWrt function does not modify the
+	exponents of the original monomial when the variable we are differentiating
+	with respect to appears with degree >= 2.
+*/
+func TestMonomial_DerivativeWrt6(t *testing.T) {
+	// Constants
+	v1 := symbolic.NewVariable()
+	v2 := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{v1, v2},
+		Exponents:       []int{3, 1},
+	}
+
+	// Compute DerivativeWrt
+	m1.DerivativeWrt(v1)
+
+	// Verify that the original monomial is unchanged
+	if m1.Exponents[0] != 3 {
+		t.Errorf(
+			"expected original monomial to keep exponent 3 for v1; received %v",
+			m1.Exponents[0],
+		)
+	}
+
+	if m1.Coefficient != 1.0 {
+		t.Errorf(
+			"expected original monomial to keep coefficient 1.0; received %v",
+			m1.Coefficient,
+		)
+	}
+}
+
 /*
 TestMonomial_String1
 Description:
@@ -1670,6 +1707,33 @@ func TestMonomial_String2(t *testing.T) {
 	_ = m1.String()
 }
 
+/*
+TestMonomial_String3
+Description:
+
+	Verifies that the Monomial.String function separates the variable
+	factors with spaces (so that the string can be parsed back).
+*/
+func TestMonomial_String3(t *testing.T) {
+	// Constants
+	env := symbolic.MakeBasicEnvironment("TestMonomial_String3")
+	v1 := symbolic.NewVariable(&env)
+	v2 := symbolic.NewVariable(&env)
+	m1 := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{v1, v2},
+		Exponents:       []int{2, 1},
+	}
+
+	// Test
+	if m1.String() != "3 x_0^2 x_1" {
+		t.Errorf(
+			"expected string to be \"3 x_0^2 x_1\"; received %v",
+			m1.String(),
+		)
+	}
+}
+
 /*
 TestMonomial_At1
 Description:
@@ -1963,3 +2027,174 @@ func TestMonomial_AsSimplifiedExpression6(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestMonomial_Evaluate1
+Description:
+
+	Tests that the Evaluate() method of a monomial computes the
+	product of the coefficient and each variable raised to its exponent.
+	Here, we evaluate 3 x^2 y at x = 2, y = -1 (which should give -12).
+*/
+func TestMonomial_Evaluate1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{2, 1},
+	}
+
+	// Test
+	value := m1.Evaluate(map[symbolic.Variable]float64{x: 2.0, y: -1.0})
+	if value != -12.0 {
+		t.Errorf(
+			"expected %v.Evaluate() to return -12.0; received %v",
+			m1,
+			value,
+		)
+	}
+}
+
+/*
+TestMonomial_Evaluate2
+Description:
+
+	Tests that the Evaluate() method of a monomial panics with a
+	MissingVariableValueError when one of its variables is not in the values map.
+*/
+func TestMonomial_Evaluate2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m1 := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{2, 1},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("expected a panic when a variable is missing from the values map; received nil")
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf("expected a panic of type error; received %T", r)
+		}
+
+		expectedError := smErrors.MissingVariableValueError{
+			Variable:   y,
+			Expression: m1,
+		}
+		if rAsE.Error() != expectedError.Error() {
+			t.Errorf(
+				"expected error message to be %v; received %v",
+				expectedError.Error(),
+				rAsE.Error(),
+			)
+		}
+	}()
+	m1.Evaluate(map[symbolic.Variable]float64{x: 2.0})
+	t.Errorf("Problem! The function did not panic when a variable was missing")
+}
+
+/*
+TestMonomial_QuadraticRepresentation1
+Description:
+
+	Tests that the QuadraticRepresentation() method of the monomial 3 x y
+	places the coefficient in the off-diagonal elements of Q.
+*/
+func TestMonomial_QuadraticRepresentation1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	m := symbolic.Monomial{
+		Coefficient:     3.0,
+		VariableFactors: []symbolic.Variable{x, y},
+		Exponents:       []int{1, 1},
+	}
+
+	// Test
+	Q, c, r := m.QuadraticRepresentation([]symbolic.Variable{x, y})
+	if Q.At(0, 1) != 3.0 || Q.At(1, 0) != 3.0 {
+		t.Errorf(
+			"expected off-diagonal elements of Q to be 3.0; received %v and %v",
+			Q.At(0, 1),
+			Q.At(1, 0),
+		)
+	}
+
+	if Q.At(0, 0) != 0.0 || Q.At(1, 1) != 0.0 {
+		t.Errorf(
+			"expected diagonal elements of Q to be 0.0; received %v and %v",
+			Q.At(0, 0),
+			Q.At(1, 1),
+		)
+	}
+
+	if c.AtVec(0) != 0.0 || c.AtVec(1) != 0.0 {
+		t.Errorf(
+			"expected c to be zero; received [%v, %v]",
+			c.AtVec(0),
+			c.AtVec(1),
+		)
+	}
+
+	if r != 0.0 {
+		t.Errorf("expected r to be 0.0; received %v", r)
+	}
+}
+
+/*
+TestMonomial_QuadraticRepresentation2
+Description:
+
+	Tests that the QuadraticRepresentation() method panics when the
+	monomial has degree greater than 2.
+*/
+func TestMonomial_QuadraticRepresentation2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	m := symbolic.Monomial{
+		Coefficient:     1.0,
+		VariableFactors: []symbolic.Variable{x},
+		Exponents:       []int{3},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic; received nil",
+			)
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic with an error; received %v",
+				r,
+			)
+		}
+
+		expectedError := smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticRepresentation",
+			Expression: m,
+		}
+		if !strings.Contains(rAsE.Error(), expectedError.Error()) {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic with error \"%v\"; received \"%v\"",
+				expectedError,
+				rAsE,
+			)
+		}
+	}()
+
+	m.QuadraticRepresentation()
+}
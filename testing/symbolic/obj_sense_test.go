@@ -0,0 +1,71 @@
+package symbolic_test
+
+/*
+obj_sense_test.go
+Description:
+	Tests for the functions mentioned in the obj_sense.go file.
+*/
+
+import (
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"testing"
+)
+
+/*
+TestObjSense_String1
+Description:
+
+	Tests that the string of a SenseMinimize object is equal to "Minimize".
+*/
+func TestObjSense_String1(t *testing.T) {
+	// Constants
+	sense0 := symbolic.SenseMinimize
+
+	// Test
+	if sense0.String() != "Minimize" {
+		t.Errorf(
+			"Expected sense.String() to be \"Minimize\"; received %v",
+			sense0.String(),
+		)
+	}
+}
+
+/*
+TestObjSense_Check1
+Description:
+
+	Tests that the Check() method returns nil for both of the allowed
+	objective senses.
+*/
+func TestObjSense_Check1(t *testing.T) {
+	// Test
+	for _, sense := range []symbolic.ObjSense{symbolic.SenseMinimize, symbolic.SenseMaximize} {
+		if err := sense.Check(); err != nil {
+			t.Errorf(
+				"Expected sense.Check() to return nil for %v; received %v",
+				sense,
+				err,
+			)
+		}
+	}
+}
+
+/*
+TestObjSense_Check2
+Description:
+
+	Tests that the Check() method returns an error for an unknown
+	objective sense.
+*/
+func TestObjSense_Check2(t *testing.T) {
+	// Constants
+	sense0 := symbolic.ObjSense("Find")
+
+	// Test
+	if err := sense0.Check(); err == nil {
+		t.Errorf(
+			"Expected sense.Check() to return an error for %v; received nil",
+			sense0,
+		)
+	}
+}
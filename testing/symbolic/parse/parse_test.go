@@ -0,0 +1,378 @@
+package parse_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/parse"
+)
+
+/*
+parse_test.go
+Description:
+
+	Tests for the functions mentioned in the symbolic/parse/parse.go file.
+*/
+
+/*
+TestParseExpression1
+Description:
+
+	Tests that the ParseExpression function can read back the output of
+	Polynomial.String() and that the result evaluates to the same values.
+*/
+func TestParseExpression1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseExpression1")
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewVariable(env)
+	p := x.Power(2).Multiply(3.0).Plus(x.Multiply(y).Multiply(-2.5)).Plus(y).Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	e, err := parse.ParseExpression(p.String(), env)
+	if err != nil {
+		t.Errorf("expected ParseExpression(%q) to succeed; received error %v", p.String(), err)
+	}
+
+	values := map[symbolic.Variable]float64{x: 1.5, y: -2.0}
+	eAsSE, tf := e.(symbolic.ScalarExpression)
+	if !tf {
+		t.Errorf("expected a scalar expression; received %T", e)
+	}
+
+	if eAsSE.Evaluate(values) != p.Evaluate(values) {
+		t.Errorf(
+			"expected parsed expression %v to evaluate to %v; received %v",
+			e,
+			p.Evaluate(values),
+			eAsSE.Evaluate(values),
+		)
+	}
+
+	if len(env.AllTrackedVariables()) != 2 {
+		t.Errorf(
+			"expected the existing variables to be reused; the environment now tracks %v variables",
+			len(env.AllTrackedVariables()),
+		)
+	}
+}
+
+/*
+TestParseExpression2
+Description:
+
+	Tests that the ParseExpression function creates new variables in the
+	environment for unknown identifiers and respects operator precedence
+	(including implicit multiplication and unary minus).
+*/
+func TestParseExpression2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseExpression2")
+
+	// Test
+	e, err := parse.ParseExpression("-a^2 + 2(a - b) * b", env)
+	if err != nil {
+		t.Errorf("expected ParseExpression to succeed; received error %v", err)
+	}
+
+	trackedVars := env.AllTrackedVariables()
+	if len(trackedVars) != 2 || trackedVars[0].Name != "a" || trackedVars[1].Name != "b" {
+		t.Errorf(
+			"expected the environment to track the variables a and b; received %v",
+			trackedVars,
+		)
+	}
+
+	// -(3^2) + 2 * (3 - 4) * 4 = -17
+	values := map[symbolic.Variable]float64{trackedVars[0]: 3.0, trackedVars[1]: 4.0}
+	value := e.(symbolic.ScalarExpression).Evaluate(values)
+	if value != -17.0 {
+		t.Errorf(
+			"expected %v to evaluate to -17; received %v",
+			e,
+			value,
+		)
+	}
+}
+
+/*
+TestParseExpression3
+Description:
+
+	Tests that the ParseExpression function builds a VariableMatrix from a
+	matrix literal and a KVector from a single column literal.
+*/
+func TestParseExpression3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseExpression3")
+
+	// Test
+	e, err := parse.ParseExpression("[a, b; c, d]", env)
+	if err != nil {
+		t.Errorf("expected ParseExpression to succeed; received error %v", err)
+	}
+
+	vm, tf := e.(symbolic.VariableMatrix)
+	if !tf {
+		t.Fatalf("expected a VariableMatrix; received %T", e)
+	}
+
+	if vm.Dims()[0] != 2 || vm.Dims()[1] != 2 {
+		t.Errorf("expected a 2x2 matrix; received dimensions %v", vm.Dims())
+	}
+
+	e, err = parse.ParseExpression("[1; 2.5; -3]", env)
+	if err != nil {
+		t.Errorf("expected ParseExpression to succeed; received error %v", err)
+	}
+
+	kv, tf := e.(symbolic.KVector)
+	if !tf {
+		t.Errorf("expected a KVector; received %T", e)
+	}
+
+	if kv.Len() != 3 || kv[2] != -3.0 {
+		t.Errorf("expected [1, 2.5, -3]; received %v", kv)
+	}
+}
+
+/*
+TestParseExpression4
+Description:
+
+	Tests that the ParseExpression function returns a ParseError (with the
+	position of the offending token) when the input is not well formed.
+*/
+func TestParseExpression4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseExpression4")
+	testCases := []struct {
+		src      string
+		position int
+	}{
+		{"x +", 3},
+		{"(x + 1", 6},
+		{"x $ y", 2},
+		{"x^y", 2},
+		{"[a, b; c]", 8},
+		{"x <= 3", 2},
+	}
+
+	// Test
+	for _, testCase := range testCases {
+		_, err := parse.ParseExpression(testCase.src, env)
+		if err == nil {
+			t.Errorf("expected ParseExpression(%q) to return an error; received nil", testCase.src)
+			continue
+		}
+
+		parseErr, tf := err.(smErrors.ParseError)
+		if !tf {
+			t.Errorf("expected a ParseError for %q; received %T", testCase.src, err)
+			continue
+		}
+
+		if parseErr.Position != testCase.position {
+			t.Errorf(
+				"expected the error for %q to be at position %v; received %v (%v)",
+				testCase.src,
+				testCase.position,
+				parseErr.Position,
+				parseErr,
+			)
+		}
+	}
+}
+
+/*
+TestParseExpression5
+Description:
+
+	Tests that the ParseExpression function converts the panics of the
+	symbolic package (here, adding vectors of different lengths) into errors.
+*/
+func TestParseExpression5(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseExpression5")
+
+	// Test
+	_, err := parse.ParseExpression("[a; b] + [1; 2; 3]", env)
+	if err == nil {
+		t.Errorf("expected ParseExpression to return an error; received nil")
+	}
+
+	if _, tf := err.(smErrors.ParseError); !tf {
+		t.Errorf("expected a ParseError; received %T", err)
+	}
+}
+
+/*
+TestParseExpression6
+Description:
+
+	Tests that the ParseExpression function rejects inputs that contain a
+	comparison operator (which are parsed with ParseConstraint instead).
+*/
+func TestParseExpression6(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseExpression6")
+	testCases := []string{
+		"x + y <= 4",
+		"2 x >= y - 1",
+		"[a; b] == [0; 1]",
+	}
+
+	// Test
+	for _, src := range testCases {
+		_, err := parse.ParseExpression(src, env)
+		parseErr, tf := err.(smErrors.ParseError)
+		if !tf {
+			t.Errorf("expected ParseExpression(%q) to return a ParseError; received %v", src, err)
+			continue
+		}
+
+		if !strings.Contains(parseErr.Message, "ParseConstraint") {
+			t.Errorf("expected the error for %q to mention ParseConstraint; received %v", src, parseErr)
+		}
+
+		if _, err := parse.ParseConstraint(src, env); err != nil {
+			t.Errorf("expected ParseConstraint(%q) to succeed; received error %v", src, err)
+		}
+	}
+}
+
+/*
+TestParseExpression7
+Description:
+
+	Tests that the ParseExpression function returns a ParseError (instead of
+	crashing or running for a very long time) for exponents that do not fit
+	in an int and for exponents larger than MaxExponent.
+*/
+func TestParseExpression7(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseExpression7")
+	testCases := []string{
+		"x_0^99999999999999999999",
+		"x_0^1e300",
+		fmt.Sprintf("x_0^%v", parse.MaxExponent+1),
+	}
+
+	// Test
+	for _, src := range testCases {
+		_, err := parse.ParseExpression(src, env)
+		parseErr, tf := err.(smErrors.ParseError)
+		if !tf {
+			t.Errorf("expected ParseExpression(%q) to return a ParseError; received %T (%v)", src, err, err)
+			continue
+		}
+
+		if parseErr.Position != 4 {
+			t.Errorf("expected the error of %q to be at position 4; received %v", src, parseErr.Position)
+		}
+	}
+
+	// The largest allowed exponent is accepted
+	if _, err := parse.ParseExpression(fmt.Sprintf("2^%v", parse.MaxExponent), env); err != nil {
+		t.Errorf("expected an exponent of %v to be accepted; received error %v", parse.MaxExponent, err)
+	}
+}
+
+/*
+TestParseConstraint1
+Description:
+
+	Tests that the ParseConstraint function creates a ScalarConstraint with
+	the correct sense for each of the comparison operators.
+*/
+func TestParseConstraint1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseConstraint1")
+	testCases := map[string]symbolic.ConstrSense{
+		"x + y <= 4":   symbolic.SenseLessThanEqual,
+		"2 x >= y - 1": symbolic.SenseGreaterThanEqual,
+		"x == 3":       symbolic.SenseEqual,
+		"x = 3":        symbolic.SenseEqual,
+	}
+
+	// Test
+	for src, expectedSense := range testCases {
+		c, err := parse.ParseConstraint(src, env)
+		if err != nil {
+			t.Errorf("expected ParseConstraint(%q) to succeed; received error %v", src, err)
+			continue
+		}
+
+		sc, tf := c.(symbolic.ScalarConstraint)
+		if !tf {
+			t.Errorf("expected a ScalarConstraint for %q; received %T", src, c)
+			continue
+		}
+
+		if sc.Sense != expectedSense {
+			t.Errorf(
+				"expected the sense of %q to be %v; received %v",
+				src,
+				expectedSense,
+				sc.Sense,
+			)
+		}
+	}
+}
+
+/*
+TestParseConstraint2
+Description:
+
+	Tests that the ParseConstraint function creates a VectorConstraint when
+	both sides are vector literals.
+*/
+func TestParseConstraint2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseConstraint2")
+
+	// Test
+	c, err := parse.ParseConstraint("[a; b] >= [0; 1]", env)
+	if err != nil {
+		t.Errorf("expected ParseConstraint to succeed; received error %v", err)
+	}
+
+	vc, tf := c.(symbolic.VectorConstraint)
+	if !tf {
+		t.Errorf("expected a VectorConstraint; received %T", c)
+	}
+
+	if vc.Len() != 2 || vc.Sense != symbolic.SenseGreaterThanEqual {
+		t.Errorf("expected a >= constraint of length 2; received %v", vc)
+	}
+}
+
+/*
+TestParseConstraint3
+Description:
+
+	Tests that the ParseConstraint function returns an error when the
+	input does not contain a comparison operator.
+*/
+func TestParseConstraint3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestParseConstraint3")
+
+	// Test
+	_, err := parse.ParseConstraint("x + y", env)
+	if err == nil {
+		t.Errorf("expected ParseConstraint to return an error; received nil")
+	}
+
+	parseErr, tf := err.(smErrors.ParseError)
+	if !tf {
+		t.Errorf("expected a ParseError; received %T", err)
+	}
+
+	if parseErr.Position != 5 {
+		t.Errorf("expected the error to be at position 5; received %v", parseErr.Position)
+	}
+}
@@ -0,0 +1,247 @@
+package symbolic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+polynomial_benchmark_test.go
+Description:
+
+	Benchmarks for the operations that combine the terms of polynomials
+	(Simplify, Plus and Multiply). The old, quadratic way of combining terms
+	(comparing every pair of monomials factor by factor) is kept here as
+	simplifyByPairwiseComparison, together with the old (unsorted) product of
+	monomials in plusByPairwiseComparison and multiplyByPairwiseComparison,
+	so that the two paths can be compared with:
+
+		go test ./testing/symbolic -run xxx -bench Polynomial
+*/
+
+// simplifyByPairwiseComparison Combines the matching terms of p by comparing each
+// monomial with all of the monomials found before it (the algorithm that
+// Polynomial.Simplify used before monomials were keyed by their exponent signature).
+func simplifyByPairwiseComparison(p symbolic.Polynomial) symbolic.Polynomial {
+	var out symbolic.Polynomial
+	for _, monomial := range p.Monomials {
+		matchIndex := -1
+		for ii, existing := range out.Monomials {
+			if haveSameFactors(existing, monomial) {
+				matchIndex = ii
+				break
+			}
+		}
+
+		if matchIndex == -1 {
+			out.Monomials = append(out.Monomials, monomial)
+			continue
+		}
+		out.Monomials[matchIndex].Coefficient += monomial.Coefficient
+	}
+	return out
+}
+
+// haveSameFactors Returns true if m1 and m2 contain the same variables with the same exponents.
+func haveSameFactors(m1, m2 symbolic.Monomial) bool {
+	if len(m1.VariableFactors) != len(m2.VariableFactors) {
+		return false
+	}
+
+	for ii, v := range m1.VariableFactors {
+		found := false
+		for jj, w := range m2.VariableFactors {
+			if v.ID == w.ID && m1.Exponents[ii] == m2.Exponents[jj] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// plusByPairwiseComparison Returns p1 + p2, combining the matching terms with
+// simplifyByPairwiseComparison (as Polynomial.Plus did before monomials were keyed).
+func plusByPairwiseComparison(p1, p2 symbolic.Polynomial) symbolic.Polynomial {
+	var sum symbolic.Polynomial
+	sum.Monomials = append(sum.Monomials, p1.Monomials...)
+	sum.Monomials = append(sum.Monomials, p2.Monomials...)
+	return simplifyByPairwiseComparison(sum)
+}
+
+// multiplyByPairwiseComparison Returns p1 * p2, multiplying each pair of terms with
+// multiplyUnsortedMonomials and combining the matching terms with
+// simplifyByPairwiseComparison (as Polynomial.Multiply did before monomials were
+// stored in canonical form).
+func multiplyByPairwiseComparison(p1, p2 symbolic.Polynomial) symbolic.Polynomial {
+	var product symbolic.Polynomial
+	for _, m1 := range p1.Monomials {
+		for _, m2 := range p2.Monomials {
+			product.Monomials = append(product.Monomials, multiplyUnsortedMonomials(m1, m2))
+		}
+	}
+	return simplifyByPairwiseComparison(product)
+}
+
+// multiplyUnsortedMonomials Returns m1 * m2, where the variables of the product are those
+// of m1 followed by the new variables of m2 (each found by a linear search).
+func multiplyUnsortedMonomials(m1, m2 symbolic.Monomial) symbolic.Monomial {
+	product := symbolic.Monomial{
+		Coefficient:     m1.Coefficient * m2.Coefficient,
+		VariableFactors: append([]symbolic.Variable{}, m1.VariableFactors...),
+		Exponents:       append([]int{}, m1.Exponents...),
+	}
+
+	for ii, v := range m2.VariableFactors {
+		foundIndex := -1
+		for jj, existing := range product.VariableFactors {
+			if existing.ID == v.ID {
+				foundIndex = jj
+				break
+			}
+		}
+
+		if foundIndex == -1 {
+			product.VariableFactors = append(product.VariableFactors, v)
+			product.Exponents = append(product.Exponents, m2.Exponents[ii])
+		} else {
+			product.Exponents[foundIndex] += m2.Exponents[ii]
+		}
+	}
+	return product
+}
+
+// benchmarkPolynomial Creates a polynomial with nTerms (unsimplified) quadratic terms
+// in nVariables variables, where each form appears twice.
+func benchmarkPolynomial(nTerms, nVariables int, env symbolic.Environment) symbolic.Polynomial {
+	x := symbolic.NewVariableVector(nVariables, env)
+
+	var p symbolic.Polynomial
+	for ii := 0; ii < nTerms; ii++ {
+		form := ii / 2
+		p.Monomials = append(p.Monomials, symbolic.Monomial{
+			Coefficient:     float64(ii%7 + 1),
+			VariableFactors: []symbolic.Variable{x[form%nVariables], x[(form/nVariables+form)%nVariables]},
+			Exponents:       []int{1, 2},
+		})
+	}
+	return p
+}
+
+/*
+BenchmarkPolynomial_Simplify
+Description:
+
+	Compares the hashed Simplify with the pairwise comparison of terms
+	for polynomials with an increasing number of terms.
+*/
+func BenchmarkPolynomial_Simplify(b *testing.B) {
+	for _, nTerms := range []int{100, 1000, 4000} {
+		env := symbolic.NewBasicEnvironment("BenchmarkPolynomial_Simplify")
+		p := benchmarkPolynomial(nTerms, 100, env)
+
+		b.Run(fmt.Sprintf("pairwise/terms=%v", nTerms), func(b *testing.B) {
+			for ii := 0; ii < b.N; ii++ {
+				simplifyByPairwiseComparison(p)
+			}
+		})
+
+		b.Run(fmt.Sprintf("hashed/terms=%v", nTerms), func(b *testing.B) {
+			for ii := 0; ii < b.N; ii++ {
+				p.Simplify()
+			}
+		})
+	}
+}
+
+/*
+BenchmarkPolynomial_Plus
+Description:
+
+	Compares the addition of two polynomials with many (partly matching) terms
+	with the pairwise comparison of terms.
+*/
+func BenchmarkPolynomial_Plus(b *testing.B) {
+	for _, nTerms := range []int{200, 2000} {
+		env := symbolic.NewBasicEnvironment("BenchmarkPolynomial_Plus")
+		p1 := benchmarkPolynomial(nTerms, 50, env).Simplify()
+		p2 := benchmarkPolynomial(nTerms, 50, env).Simplify()
+
+		b.Run(fmt.Sprintf("pairwise/terms=%v", nTerms), func(b *testing.B) {
+			for ii := 0; ii < b.N; ii++ {
+				plusByPairwiseComparison(p1, p2)
+			}
+		})
+
+		b.Run(fmt.Sprintf("hashed/terms=%v", nTerms), func(b *testing.B) {
+			for ii := 0; ii < b.N; ii++ {
+				p1.Plus(p2)
+			}
+		})
+	}
+}
+
+/*
+BenchmarkPolynomial_Multiply
+Description:
+
+	Compares the product of two polynomials with 25 and 100 terms each
+	with the unsorted product of monomials and the pairwise comparison of terms.
+*/
+func BenchmarkPolynomial_Multiply(b *testing.B) {
+	for _, nTerms := range []int{25, 100} {
+		env := symbolic.NewBasicEnvironment("BenchmarkPolynomial_Multiply")
+		x := symbolic.NewVariableVector(nTerms, env)
+
+		var p1, p2 symbolic.Polynomial
+		for ii := 0; ii < nTerms; ii++ {
+			p1.Monomials = append(p1.Monomials, x[ii].ToMonomial())
+			p2.Monomials = append(p2.Monomials, x[(ii*7)%nTerms].Power(2).(symbolic.Monomial))
+		}
+
+		b.Run(fmt.Sprintf("pairwise/terms=%v", nTerms), func(b *testing.B) {
+			for ii := 0; ii < b.N; ii++ {
+				multiplyByPairwiseComparison(p1, p2)
+			}
+		})
+
+		b.Run(fmt.Sprintf("hashed/terms=%v", nTerms), func(b *testing.B) {
+			for ii := 0; ii < b.N; ii++ {
+				p1.Multiply(p2)
+			}
+		})
+	}
+}
+
+/*
+BenchmarkPolynomial_QuadraticForm
+Description:
+
+	Measures the expansion of x^T Q x for a dense n x n matrix Q.
+*/
+func BenchmarkPolynomial_QuadraticForm(b *testing.B) {
+	for _, n := range []int{20, 60} {
+		env := symbolic.NewBasicEnvironment("BenchmarkPolynomial_QuadraticForm")
+		x := symbolic.NewVariableVector(n, env)
+
+		Q := symbolic.KMatrix{}
+		for ii := 0; ii < n; ii++ {
+			var row []symbolic.K
+			for jj := 0; jj < n; jj++ {
+				row = append(row, symbolic.K(float64(ii+jj+1)))
+			}
+			Q = append(Q, row)
+		}
+
+		b.Run(fmt.Sprintf("n=%v", n), func(b *testing.B) {
+			for ii := 0; ii < b.N; ii++ {
+				x.Transpose().Multiply(Q).Multiply(x)
+			}
+		})
+	}
+}
@@ -1877,3 +1877,70 @@ func TestPolynomialMatrix_AsSimplifiedExpression1(t *testing.T) {
 	}
 
 }
+
+/*
+TestPolynomialMatrix_Evaluate1
+Description:
+
+	Tests that the Evaluate() method of a polynomial matrix returns
+	a dense matrix containing the value of each polynomial.
+*/
+func TestPolynomialMatrix_Evaluate1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p1 := x.ToPolynomial()
+	var pm symbolic.PolynomialMatrix = [][]symbolic.Polynomial{
+		{p1, p1.Plus(1.0).(symbolic.Polynomial)},
+		{p1.Multiply(x).(symbolic.Monomial).ToPolynomial(), symbolic.K(4.0).ToPolynomial()},
+	}
+
+	// Test
+	values := pm.Evaluate(map[symbolic.Variable]float64{x: 3.0})
+	expected := mat.NewDense(2, 2, []float64{3.0, 4.0, 9.0, 4.0})
+	if !mat.Equal(&values, expected) {
+		t.Errorf(
+			"expected %v.Evaluate() to return %v; received %v",
+			pm,
+			mat.Formatted(expected),
+			mat.Formatted(&values),
+		)
+	}
+}
+
+/*
+TestPolynomialMatrix_Evaluate2
+Description:
+
+	Tests that the Evaluate() method of a polynomial matrix panics with a
+	MissingVariableValueError that names the matrix (and not one of its elements).
+*/
+func TestPolynomialMatrix_Evaluate2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	p1 := x.ToPolynomial()
+	var pm symbolic.PolynomialMatrix = [][]symbolic.Polynomial{
+		{p1, p1.Plus(1.0).(symbolic.Polynomial)},
+		{p1.Plus(y).(symbolic.Polynomial), symbolic.K(4.0).ToPolynomial()},
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		rAsE, tf := r.(smErrors.MissingVariableValueError)
+		if !tf {
+			t.Fatalf("expected a panic of type MissingVariableValueError; received %T", r)
+		}
+
+		if rAsE.Variable != y {
+			t.Errorf("expected the missing variable to be %v; received %v", y, rAsE.Variable)
+		}
+
+		rExpression, tf := rAsE.Expression.(symbolic.PolynomialMatrix)
+		if !tf || rExpression.String() != pm.String() {
+			t.Errorf("expected the error to name the matrix %v; received %v", pm, rAsE.Expression)
+		}
+	}()
+	pm.Evaluate(map[symbolic.Variable]float64{x: 3.0})
+	t.Errorf("Problem! The function did not panic when a variable was missing")
+}
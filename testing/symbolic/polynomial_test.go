@@ -2430,3 +2430,215 @@ func TestPolynomial_SubstituteAccordingTo1(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestPolynomial_Evaluate1
+Description:
+
+	Tests that the Evaluate() method of a polynomial created from a quadratic form
+	x^T Q x returns the correct value. With Q = I and x = [-3, 1], the value should be 10.
+*/
+func TestPolynomial_Evaluate1(t *testing.T) {
+	// Constants
+	N := 2
+	x := symbolic.NewVariableVector(N)
+	Q := getKMatrix.From(symbolic.Identity(N))
+	quadPoly := x.Transpose().Multiply(Q).Multiply(x).(symbolic.Polynomial)
+
+	// Test
+	value := quadPoly.Evaluate(
+		map[symbolic.Variable]float64{x[0]: -3.0, x[1]: 1.0},
+	)
+	if value != 10.0 {
+		t.Errorf(
+			"expected %v.Evaluate() to return 10.0; received %v",
+			quadPoly,
+			value,
+		)
+	}
+}
+
+/*
+TestPolynomial_EvaluateAt1
+Description:
+
+	Tests that the EvaluateAt() method of a polynomial uses the ordering of
+	the given slice of variables. We evaluate p = x0 + 2 x1 + 3 at the point
+	where x1 = 1 and x0 = 10 (given in the order [x1, x0]), which should give 15.
+*/
+func TestPolynomial_EvaluateAt1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+	p := x[0].Plus(x[1].Multiply(2.0)).Plus(3.0).(symbolic.Polynomial)
+
+	// Test
+	value := p.EvaluateAt(
+		*mat.NewVecDense(2, []float64{1.0, 10.0}),
+		[]symbolic.Variable{x[1], x[0]},
+	)
+	if value != 15.0 {
+		t.Errorf(
+			"expected %v.EvaluateAt() to return 15.0; received %v",
+			p,
+			value,
+		)
+	}
+}
+
+/*
+TestPolynomial_EvaluateAt2
+Description:
+
+	Tests that the EvaluateAt() method of a polynomial panics when the length
+	of the input vector does not match the number of variables.
+*/
+func TestPolynomial_EvaluateAt2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+	p := x[0].Plus(x[1].Multiply(2.0)).Plus(3.0).(symbolic.Polynomial)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("expected a panic when the input vector has the wrong length; received nil")
+		}
+
+		if _, tf := r.(smErrors.VectorDimensionError); !tf {
+			t.Errorf("expected a panic of type VectorDimensionError; received %T", r)
+		}
+	}()
+	p.EvaluateAt(*mat.NewVecDense(3, []float64{1.0, 2.0, 3.0}))
+	t.Errorf("Problem! The function did not panic when the input vector had the wrong length")
+}
+
+/*
+TestPolynomial_Evaluate2
+Description:
+
+	Tests that the Evaluate() method of a polynomial panics with a
+	MissingVariableValueError that names the polynomial (and not the
+	monomial containing the missing variable).
+*/
+func TestPolynomial_Evaluate2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+	p := x[0].Plus(x[1].Multiply(2.0)).Plus(3.0).(symbolic.Polynomial)
+
+	// Test
+	defer func() {
+		r := recover()
+		rAsE, tf := r.(smErrors.MissingVariableValueError)
+		if !tf {
+			t.Fatalf("expected a panic of type MissingVariableValueError; received %T", r)
+		}
+
+		if rAsE.Variable != x[1] {
+			t.Errorf("expected the missing variable to be %v; received %v", x[1], rAsE.Variable)
+		}
+
+		if !reflect.DeepEqual(rAsE.Expression, p) {
+			t.Errorf("expected the error to name the polynomial %v; received %v", p, rAsE.Expression)
+		}
+	}()
+	p.Evaluate(map[symbolic.Variable]float64{x[0]: 1.0})
+	t.Errorf("Problem! The function did not panic when a variable was missing")
+}
+
+/*
+TestPolynomial_QuadraticRepresentation1
+Description:
+
+	Tests that the QuadraticRepresentation() method correctly recovers
+	Q, c and r from the polynomial x^T P x + c^T x + r built with
+	the Transpose() and Multiply() methods. Because of the factor 0.5
+	in the representation, Q should be 2 * P.
+*/
+func TestPolynomial_QuadraticRepresentation1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(2)
+	P := mat.NewDense(2, 2, []float64{2.0, 1.0, 1.0, 3.0})
+	cIn := symbolic.KVector{4.0, 5.0}
+	quadratic := x.Transpose().Multiply(symbolic.DenseToKMatrix(*P)).Multiply(x)
+	p := quadratic.Plus(cIn.Transpose().Multiply(x)).Plus(6.0).(symbolic.Polynomial)
+
+	// Test
+	Q, c, r := p.QuadraticRepresentation(x)
+
+	expectedQ := []float64{4.0, 2.0, 2.0, 6.0}
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			if Q.At(ii, jj) != expectedQ[ii*2+jj] {
+				t.Errorf(
+					"expected Q[%v,%v] to be %v; received %v",
+					ii, jj,
+					expectedQ[ii*2+jj],
+					Q.At(ii, jj),
+				)
+			}
+		}
+	}
+
+	for ii := 0; ii < 2; ii++ {
+		if c.AtVec(ii) != float64(cIn[ii]) {
+			t.Errorf(
+				"expected c[%v] to be %v; received %v",
+				ii,
+				cIn[ii],
+				c.AtVec(ii),
+			)
+		}
+	}
+
+	if r != 6.0 {
+		t.Errorf(
+			"expected r to be 6.0; received %v",
+			r,
+		)
+	}
+}
+
+/*
+TestPolynomial_QuadraticRepresentation2
+Description:
+
+	Tests that the QuadraticRepresentation() method panics when the
+	polynomial has degree greater than 2.
+*/
+func TestPolynomial_QuadraticRepresentation2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	p := x.Power(3).Plus(x).(symbolic.Polynomial)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic; received nil",
+			)
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic with an error; received %v",
+				r,
+			)
+		}
+
+		expectedError := smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticRepresentation",
+			Expression: p,
+		}
+		if !strings.Contains(rAsE.Error(), expectedError.Error()) {
+			t.Errorf(
+				"expected QuadraticRepresentation() to panic with error \"%v\"; received \"%v\"",
+				expectedError,
+				rAsE,
+			)
+		}
+	}()
+
+	p.QuadraticRepresentation()
+}
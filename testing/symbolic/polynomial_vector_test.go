@@ -2105,3 +2105,121 @@ func TestPolynomialVector_AsSimplifiedExpression1(t *testing.T) {
 		}
 	}
 }
+
+/*
+TestPolynomialVector_Evaluate1
+Description:
+
+	Tests that the Evaluate() method of a polynomial vector returns
+	a vector containing the value of each polynomial.
+*/
+func TestPolynomialVector_Evaluate1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(y).(symbolic.Polynomial),
+		x.Multiply(y).Plus(1.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	values := pv.Evaluate(map[symbolic.Variable]float64{x: 2.0, y: 3.0})
+	expected := []float64{5.0, 7.0}
+	for ii, expectedII := range expected {
+		if values.AtVec(ii) != expectedII {
+			t.Errorf(
+				"expected element %v of %v.Evaluate() to be %v; received %v",
+				ii,
+				pv,
+				expectedII,
+				values.AtVec(ii),
+			)
+		}
+	}
+}
+
+/*
+TestPolynomialVector_Evaluate2
+Description:
+
+	Tests that the Evaluate() method of a polynomial vector panics with a
+	MissingVariableValueError that names the vector (and not one of its elements).
+*/
+func TestPolynomialVector_Evaluate2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(1.0).(symbolic.Polynomial),
+		x.Multiply(y).Plus(1.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		rAsE, tf := r.(smErrors.MissingVariableValueError)
+		if !tf {
+			t.Fatalf("expected a panic of type MissingVariableValueError; received %T", r)
+		}
+
+		if rAsE.Variable != y {
+			t.Errorf("expected the missing variable to be %v; received %v", y, rAsE.Variable)
+		}
+
+		rExpression, tf := rAsE.Expression.(symbolic.PolynomialVector)
+		if !tf || rExpression.String() != pv.String() {
+			t.Errorf("expected the error to name the vector %v; received %v", pv, rAsE.Expression)
+		}
+	}()
+	pv.Evaluate(map[symbolic.Variable]float64{x: 2.0})
+	t.Errorf("Problem! The function did not panic when a variable was missing")
+}
+
+/*
+TestPolynomialVector_DerivativeWrt1
+Description:
+
+	Tests that the DerivativeWrt() method of a polynomial vector returns
+	a PolynomialVector (even when some of the derivatives are constant)
+	and does not modify the original vector.
+*/
+func TestPolynomialVector_DerivativeWrt1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	pv := symbolic.PolynomialVector{
+		x.Plus(y).(symbolic.Polynomial),
+		x.Multiply(y).Plus(1.0).(symbolic.Polynomial),
+	}
+
+	// Test
+	derivative := pv.DerivativeWrt(x)
+	dpv, tf := derivative.(symbolic.PolynomialVector)
+	if !tf {
+		t.Errorf(
+			"expected derivative to be a PolynomialVector; received %T",
+			derivative,
+		)
+	}
+
+	values := dpv.Evaluate(map[symbolic.Variable]float64{x: 2.0, y: 3.0})
+	expected := []float64{1.0, 3.0}
+	for ii, expectedII := range expected {
+		if values.AtVec(ii) != expectedII {
+			t.Errorf(
+				"expected element %v of the derivative to be %v; received %v",
+				ii,
+				expectedII,
+				values.AtVec(ii),
+			)
+		}
+	}
+
+	// Verify that the original vector is unchanged
+	if len(pv[1].Variables()) != 2 {
+		t.Errorf(
+			"expected original polynomial to still contain 2 variables; received %v",
+			len(pv[1].Variables()),
+		)
+	}
+}
@@ -0,0 +1,253 @@
+package symbolic_test
+
+/*
+problem_test.go
+Description:
+	Tests for the Problem object (defined in symbolic/problem.go).
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestProblem_Check1
+Description:
+
+	Verifies that a new problem is valid (minimize 0) and that the Check() method
+	returns an error when the objective is not a scalar or there is no environment.
+*/
+func TestProblem_Check1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_Check1")
+	problem := symbolic.NewProblem("TestProblem_Check1", env)
+	x := problem.AddVariableVector(2)
+
+	// Test
+	if err := problem.Check(); err != nil {
+		t.Errorf("expected a new problem to be valid; received %v", err)
+	}
+
+	if problem.Sense != symbolic.SenseMinimize {
+		t.Errorf("expected the default sense to be %v; received %v", symbolic.SenseMinimize, problem.Sense)
+	}
+
+	problem.SetObjective(x, symbolic.SenseMinimize)
+	if err := problem.Check(); err == nil {
+		t.Errorf("expected an error for a vector objective; received nil")
+	}
+
+	problem.SetObjective(x[0], symbolic.SenseMinimize)
+	problem.Environment = nil
+	if err := problem.Check(); err == nil {
+		t.Errorf("expected an error for a problem without an environment; received nil")
+	}
+}
+
+/*
+TestProblem_Variables1
+Description:
+
+	Verifies that the variables of a problem are its decision variables (in the
+	order in which they were added) followed by the other variables of its
+	objective and constraints, without repetitions.
+*/
+func TestProblem_Variables1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_Variables1")
+	problem := symbolic.NewProblem("TestProblem_Variables1", env)
+	x := problem.AddVariableVector(2)
+	y := problem.AddVariableVector(1)
+	z := symbolic.NewVariable(env)
+
+	problem.SetObjective(z.Plus(x[1]), symbolic.SenseMaximize)
+	problem.AddConstraints(x[0].Plus(y[0]).LessEq(1.0))
+
+	// Test
+	variables := problem.Variables()
+	expected := []symbolic.Variable{x[0], x[1], y[0], z}
+	if len(variables) != len(expected) {
+		t.Fatalf("expected the variables %v; received %v", expected, variables)
+	}
+
+	for ii := range expected {
+		if variables[ii].ID != expected[ii].ID {
+			t.Errorf("expected variable %v to be %v; received %v", ii, expected[ii], variables[ii])
+		}
+	}
+}
+
+/*
+TestProblem_IsLP1
+Description:
+
+	Verifies the classification of problems: a linear problem is an LP (and a QP),
+	a problem with a quadratic objective is only a QP and a linear problem with a
+	binary variable is a MILP.
+*/
+func TestProblem_IsLP1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_IsLP1")
+	problem := symbolic.NewProblem("TestProblem_IsLP1", env)
+	x := problem.AddVariableVector(2)
+	problem.AddConstraints(x[0].Plus(x[1]).LessEq(1.0))
+
+	// Test
+	problem.SetObjective(x[0].Plus(x[1]), symbolic.SenseMinimize)
+	if !problem.IsLP() || !problem.IsQP() || problem.IsMILP() {
+		t.Errorf("expected the linear problem to be an LP and a QP, but not a MILP")
+	}
+
+	problem.SetObjective(x[0].Power(2).Plus(x[1]), symbolic.SenseMinimize)
+	if problem.IsLP() || !problem.IsQP() || problem.IsMILP() {
+		t.Errorf("expected the quadratic problem to only be a QP")
+	}
+
+	problem.SetObjective(x[0].Plus(x[1]), symbolic.SenseMinimize)
+	b := problem.AddBinaryVariableVector(1)
+	problem.AddConstraints(x[0].LessEq(b[0]))
+	if problem.IsLP() || problem.IsQP() || !problem.IsMILP() {
+		t.Errorf("expected the problem with a binary variable to only be a MILP")
+	}
+
+	problem.AddConstraints(x[0].Multiply(x[1]).LessEq(1.0))
+	if problem.IsMILP() {
+		t.Errorf("expected the problem with a quadratic constraint to not be a MILP")
+	}
+}
+
+/*
+TestProblem_LinearRepresentation1
+Description:
+
+	Verifies the matrix representation of the problem
+		maximize   x + 2 y
+		subject to x + y <= 4
+		           x - y >= -1
+		           x = 1,
+	which is c = (-1, -2), A = [[1, 1], [-1, 1]], b = (4, 1), C = [[1, 0]] and d = (1).
+*/
+func TestProblem_LinearRepresentation1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_LinearRepresentation1")
+	problem := symbolic.NewProblem("TestProblem_LinearRepresentation1", env)
+	x := problem.AddVariableVector(2)
+
+	problem.SetObjective(x[0].Plus(x[1].Multiply(2.0)), symbolic.SenseMaximize)
+	problem.AddConstraints(
+		x[0].Plus(x[1]).LessEq(4.0),
+		x[0].Minus(x[1]).GreaterEq(-1.0),
+		x[0].Eq(1.0),
+	)
+
+	// Test
+	c, A, b, C, d, err := problem.LinearRepresentation()
+	if err != nil {
+		t.Fatalf("expected LinearRepresentation to succeed; received %v", err)
+	}
+
+	if expected := mat.NewVecDense(2, []float64{-1.0, -2.0}); !mat.EqualApprox(&c, expected, 1e-12) {
+		t.Errorf("expected c = %v; received %v", mat.Formatted(expected), mat.Formatted(&c))
+	}
+
+	if expected := mat.NewDense(2, 2, []float64{1.0, 1.0, -1.0, 1.0}); !mat.EqualApprox(&A, expected, 1e-12) {
+		t.Errorf("expected A = %v; received %v", mat.Formatted(expected), mat.Formatted(&A))
+	}
+
+	if expected := mat.NewVecDense(2, []float64{4.0, 1.0}); !mat.EqualApprox(&b, expected, 1e-12) {
+		t.Errorf("expected b = %v; received %v", mat.Formatted(expected), mat.Formatted(&b))
+	}
+
+	if expected := mat.NewDense(1, 2, []float64{1.0, 0.0}); !mat.EqualApprox(&C, expected, 1e-12) {
+		t.Errorf("expected C = %v; received %v", mat.Formatted(expected), mat.Formatted(&C))
+	}
+
+	if expected := mat.NewVecDense(1, []float64{1.0}); !mat.EqualApprox(&d, expected, 1e-12) {
+		t.Errorf("expected d = %v; received %v", mat.Formatted(expected), mat.Formatted(&d))
+	}
+}
+
+/*
+TestProblem_LinearRepresentation2
+Description:
+
+	Verifies that the bounds of the variables are included in A x <= b for
+		minimize   x + y
+		subject to x + y <= 4
+		           0 <= x <= 3,
+	which gives A = [[1, 1], [1, 0], [-1, 0]] and b = (4, 3, 0)
+	(y is unbounded, so it does not add any rows).
+*/
+func TestProblem_LinearRepresentation2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_LinearRepresentation2")
+	problem := symbolic.NewProblem("TestProblem_LinearRepresentation2", env)
+	x := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 3.0, "x", env)
+	y := symbolic.NewVariable(env)
+
+	problem.SetObjective(x.Plus(y), symbolic.SenseMinimize)
+	problem.AddConstraints(x.Plus(y).LessEq(4.0))
+
+	// Test
+	_, A, b, _, _, err := problem.LinearRepresentation()
+	if err != nil {
+		t.Fatalf("expected LinearRepresentation to succeed; received %v", err)
+	}
+
+	if expected := mat.NewDense(3, 2, []float64{1.0, 1.0, 1.0, 0.0, -1.0, 0.0}); !mat.EqualApprox(&A, expected, 1e-12) {
+		t.Errorf("expected A = %v; received %v", mat.Formatted(expected), mat.Formatted(&A))
+	}
+
+	if expected := mat.NewVecDense(3, []float64{4.0, 3.0, 0.0}); !mat.EqualApprox(&b, expected, 1e-12) {
+		t.Errorf("expected b = %v; received %v", mat.Formatted(expected), mat.Formatted(&b))
+	}
+}
+
+/*
+TestProblem_LinearRepresentation3
+Description:
+
+	Verifies that LinearRepresentation returns an error when the problem is not
+	an LP (here, because one of its variables is binary).
+*/
+func TestProblem_LinearRepresentation3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_LinearRepresentation3")
+	problem := symbolic.NewProblem("TestProblem_LinearRepresentation3", env)
+	x := problem.AddBinaryVariableVector(2)
+
+	problem.SetObjective(x[0].Plus(x[1]), symbolic.SenseMinimize)
+
+	// Test
+	if _, _, _, _, _, err := problem.LinearRepresentation(); err == nil {
+		t.Errorf("expected LinearRepresentation to return an error for a problem with binary variables; received nil")
+	}
+}
+
+/*
+TestProblem_LinearRepresentation4
+Description:
+
+	Verifies that LinearRepresentation returns an error (instead of panicking)
+	when the problem does not contain any variables.
+*/
+func TestProblem_LinearRepresentation4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_LinearRepresentation4")
+	problem := symbolic.NewProblem("TestProblem_LinearRepresentation4", env)
+	problem.SetObjective(symbolic.K(3.0), symbolic.SenseMinimize)
+
+	// Test
+	_, _, _, _, _, err := problem.LinearRepresentation()
+	if err == nil {
+		t.Fatalf("expected LinearRepresentation to return an error for a problem without variables; received nil")
+	}
+
+	if !strings.Contains(err.Error(), "does not contain any") {
+		t.Errorf("expected the error to mention the missing variables; received %v", err)
+	}
+}
@@ -0,0 +1,361 @@
+package symbolic_test
+
+/*
+rational_expression_test.go
+Description:
+	Tests for the RationalExpression object and the Divide methods
+	(defined in symbolic/rational_expression.go).
+*/
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestRationalExpression_Check1
+Description:
+
+	Verifies that the Check() method returns a DivisionByZeroError when the
+	denominator is zero.
+*/
+func TestRationalExpression_Check1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Check1")
+	x := symbolic.NewVariable(env)
+	re := symbolic.RationalExpression{
+		Numerator:   x.ToPolynomial(),
+		Denominator: symbolic.K(0.0).ToPolynomial(),
+	}
+
+	// Test
+	err := re.Check()
+	if _, tf := err.(smErrors.DivisionByZeroError); !tf {
+		t.Errorf("expected a DivisionByZeroError; received %v", err)
+	}
+}
+
+/*
+TestRationalExpression_Divide1
+Description:
+
+	Verifies that dividing a constant by a polynomial creates a
+	RationalExpression which evaluates to the expected value.
+*/
+func TestRationalExpression_Divide1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Divide1")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	result := symbolic.K(1.0).Divide(x.Plus(1.0))
+	resultAsRE, tf := result.(symbolic.RationalExpression)
+	if !tf {
+		t.Errorf("expected a RationalExpression; received %T", result)
+	}
+
+	value := resultAsRE.Evaluate(map[symbolic.Variable]float64{x: 3.0})
+	if value != 0.25 {
+		t.Errorf("expected 1/(1+3) = 0.25; received %v", value)
+	}
+
+	if symbolic.IsPolynomialLike(resultAsRE) {
+		t.Errorf("expected %v to not be polynomial like", resultAsRE)
+	}
+}
+
+/*
+TestRationalExpression_Divide2
+Description:
+
+	Verifies that dividing a variable by a variable creates the
+	RationalExpression x/y with the expected string.
+*/
+func TestRationalExpression_Divide2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Divide2")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	result := x[0].Divide(x[1])
+	expected := x[0].String() + "/(" + x[1].String() + ")"
+	if result.String() != expected {
+		t.Errorf("expected %v; received %v", expected, result)
+	}
+}
+
+/*
+TestRationalExpression_Divide3
+Description:
+
+	Verifies that dividing by zero panics with a DivisionByZeroError.
+*/
+func TestRationalExpression_Divide3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Divide3")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.DivisionByZeroError); !tf {
+			t.Errorf("expected a DivisionByZeroError; received %v", r)
+		}
+	}()
+
+	x.Divide(0.0)
+}
+
+/*
+TestRationalExpression_Divide4
+Description:
+
+	Verifies that dividing a polynomial by a divisor of it that differs by a
+	constant factor returns that constant.
+*/
+func TestRationalExpression_Divide4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Divide4")
+	x := symbolic.NewVariable(env)
+	p := x.Multiply(2.0).Plus(2.0).(symbolic.Polynomial)
+
+	// Test
+	result := p.Divide(x.Plus(1.0))
+	if !result.Equals(symbolic.K(2.0), 1e-12) {
+		t.Errorf("expected (2x + 2)/(x + 1) to be 2; received %v", result)
+	}
+
+	if _, tf := result.(symbolic.K); !tf {
+		t.Errorf("expected a K; received %T", result)
+	}
+}
+
+/*
+TestRationalExpression_AsSimplifiedExpression1
+Description:
+
+	Verifies that the common monomial factors of the numerator and denominator
+	are cancelled: (x^2 y)/(x + x^2) = (x y)/(1 + x).
+*/
+func TestRationalExpression_AsSimplifiedExpression1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_AsSimplifiedExpression1")
+	x := symbolic.NewVariableVector(2, env)
+	re := symbolic.RationalExpression{
+		Numerator:   x[0].Power(2).Multiply(x[1]).(symbolic.Monomial).ToPolynomial(),
+		Denominator: x[0].Plus(x[0].Power(2)).(symbolic.Polynomial),
+	}
+
+	// Test
+	result := re.AsSimplifiedExpression()
+	resultAsRE, tf := result.(symbolic.RationalExpression)
+	if !tf {
+		t.Errorf("expected a RationalExpression; received %T", result)
+	}
+
+	if resultAsRE.Numerator.Degree() != 2 {
+		t.Errorf("expected the numerator to be x_0 x_1; received %v", resultAsRE.Numerator)
+	}
+
+	if resultAsRE.Denominator.Degree() != 1 || len(resultAsRE.Denominator.Monomials) != 2 {
+		t.Errorf("expected the denominator to be x_0 + 1; received %v", resultAsRE.Denominator)
+	}
+
+	if !result.Equals(x[0].Multiply(x[1]).(symbolic.Monomial).Divide(x[0].Plus(1.0)), 1e-12) {
+		t.Errorf("expected %v to equal (x_0 x_1)/(x_0 + 1)", result)
+	}
+}
+
+/*
+TestRationalExpression_Plus1
+Description:
+
+	Verifies that 1/x + 1/y = (x + y)/(x y) and that subtracting a rational
+	expression from itself gives zero.
+*/
+func TestRationalExpression_Plus1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Plus1")
+	x := symbolic.NewVariableVector(2, env)
+	invX := symbolic.K(1.0).Divide(x[0]).(symbolic.RationalExpression)
+	invY := symbolic.K(1.0).Divide(x[1]).(symbolic.RationalExpression)
+
+	// Test
+	sum := invX.Plus(invY)
+	expected := x[0].Plus(x[1]).(symbolic.Polynomial).Divide(x[0].Multiply(x[1]))
+	if !sum.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, sum)
+	}
+
+	difference := invX.Minus(invX)
+	if !difference.Equals(symbolic.K(0.0), 1e-12) {
+		t.Errorf("expected 0; received %v", difference)
+	}
+}
+
+/*
+TestRationalExpression_Multiply1
+Description:
+
+	Verifies that multiplying 1/(1 + x) by (1 + x) gives 1.
+*/
+func TestRationalExpression_Multiply1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Multiply1")
+	x := symbolic.NewVariable(env)
+	re := symbolic.K(1.0).Divide(x.Plus(1.0)).(symbolic.RationalExpression)
+
+	// Test
+	result := re.Multiply(x.Plus(1.0))
+	if !result.Equals(symbolic.K(1.0), 1e-12) {
+		t.Errorf("expected 1; received %v", result)
+	}
+}
+
+/*
+TestRationalExpression_DerivativeWrt1
+Description:
+
+	Verifies that the derivative of 1/(1 + x) is -1/(1 + x)^2 (quotient rule).
+*/
+func TestRationalExpression_DerivativeWrt1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_DerivativeWrt1")
+	x := symbolic.NewVariable(env)
+	re := symbolic.K(1.0).Divide(x.Plus(1.0)).(symbolic.RationalExpression)
+
+	// Test
+	derivative := re.DerivativeWrt(x)
+	expected := symbolic.K(-1.0).Divide(x.Plus(1.0).Power(2))
+	if !derivative.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, derivative)
+	}
+}
+
+/*
+TestRationalExpression_DerivativeWrt2
+Description:
+
+	Verifies that the derivative of x/(x^2 + y) with respect to each variable
+	agrees with a central finite difference.
+*/
+func TestRationalExpression_DerivativeWrt2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_DerivativeWrt2")
+	x := symbolic.NewVariableVector(2, env)
+	re := x[0].Divide(x[0].Power(2).Plus(x[1])).(symbolic.RationalExpression)
+	point := map[symbolic.Variable]float64{x[0]: 0.7, x[1]: 1.3}
+	h := 1e-6
+
+	// Test
+	for _, v := range x {
+		plus, minus := map[symbolic.Variable]float64{}, map[symbolic.Variable]float64{}
+		for key, value := range point {
+			plus[key], minus[key] = value, value
+		}
+		plus[v] += h
+		minus[v] -= h
+		expected := (re.Evaluate(plus) - re.Evaluate(minus)) / (2 * h)
+
+		derivative := re.DerivativeWrt(v).(symbolic.ScalarExpression)
+		if result := derivative.Evaluate(point); math.Abs(result-expected) > 1e-6 {
+			t.Errorf("expected the derivative wrt %v to be %v; received %v", v, expected, result)
+		}
+	}
+}
+
+/*
+TestRationalExpression_Power1
+Description:
+
+	Verifies that negative powers of monomials create Laurent monomials
+	(represented as rational expressions), e.g., (2 x)^-1 = 0.5/x.
+*/
+func TestRationalExpression_Power1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Power1")
+	x := symbolic.NewVariable(env)
+	m := x.Multiply(2.0).(symbolic.Monomial)
+
+	// Test
+	result := m.Power(-1)
+	expected := symbolic.RationalExpression{
+		Numerator:   symbolic.K(0.5).ToPolynomial(),
+		Denominator: x.ToPolynomial(),
+	}
+	if !result.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, result)
+	}
+
+	squared := x.Power(-2)
+	if value := squared.(symbolic.ScalarExpression).Evaluate(map[symbolic.Variable]float64{x: 2.0}); value != 0.25 {
+		t.Errorf("expected 2^-2 = 0.25; received %v", value)
+	}
+
+	// Raising the Laurent monomial back to the power -1 recovers the monomial
+	if inverse := result.Power(-1); !inverse.Equals(m, 1e-12) {
+		t.Errorf("expected %v; received %v", m, inverse)
+	}
+}
+
+/*
+TestRationalExpression_Power2
+Description:
+
+	Verifies that the Power method of each scalar expression panics with an
+	ExponentOverflowError (instead of recursing forever) when the exponent
+	is the minimum int, whose negation overflows.
+*/
+func TestRationalExpression_Power2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Power2")
+	x := symbolic.NewVariable(env)
+	expressions := []symbolic.ScalarExpression{
+		symbolic.K(2.0),
+		x,
+		x.Multiply(2.0).(symbolic.Monomial),
+		x.Plus(1.0).(symbolic.Polynomial),
+		symbolic.K(1.0).Divide(x.Plus(1.0)).(symbolic.RationalExpression),
+		symbolic.Sin(x).(symbolic.FunctionExpression),
+	}
+
+	// Test
+	for _, expression := range expressions {
+		func() {
+			defer func() {
+				r := recover()
+				if _, tf := r.(smErrors.ExponentOverflowError); !tf {
+					t.Errorf(
+						"expected %v.Power(math.MinInt) to panic with an ExponentOverflowError; received %v",
+						expression,
+						r,
+					)
+				}
+			}()
+			expression.Power(math.MinInt)
+		}()
+	}
+}
+
+/*
+TestRationalExpression_Substitute1
+Description:
+
+	Verifies that substituting a constant for the variable of 1/(1 + x)
+	produces the constant 1/(1 + c).
+*/
+func TestRationalExpression_Substitute1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalExpression_Substitute1")
+	x := symbolic.NewVariable(env)
+	re := symbolic.K(1.0).Divide(x.Plus(1.0)).(symbolic.RationalExpression)
+
+	// Test
+	result := re.Substitute(x, symbolic.K(1.0))
+	if !result.Equals(symbolic.K(0.5), 1e-12) {
+		t.Errorf("expected 0.5; received %v", result)
+	}
+}
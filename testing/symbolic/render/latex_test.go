@@ -0,0 +1,268 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/render"
+)
+
+/*
+latex_test.go
+Description:
+
+	Tests for the functions mentioned in the symbolic/render/latex.go file.
+*/
+
+/*
+TestLaTeX1
+Description:
+
+	Tests that the LaTeX function renders constants, variables, monomials and
+	polynomials with braced subscripts and superscripts.
+*/
+func TestLaTeX1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX1")
+	x := symbolic.NewVariableVector(2, env)
+	m := x[0].Power(2).Multiply(x[1]).Multiply(3.0).(symbolic.Monomial)
+	p := m.Plus(x[1].Multiply(-1.0)).Plus(2.0)
+	testCases := map[string]interface{}{
+		"2.5":                                   symbolic.K(2.5),
+		"x_{0}":                                 x[0],
+		"3 x_{0}^{2} x_{1}":                     m,
+		"2 + 3 x_{0}^{2} x_{1} - x_{1}":         p,
+		"-x_{1}":                                x[1].Multiply(-1.0),
+		`1 \times 10^{100}`:                     symbolic.Infinity,
+		`2.5 \times 10^{-7}`:                    symbolic.K(2.5e-7),
+		"x_{0} x_{1}":                           x[0].Multiply(x[1]),
+		"-0.5 x_{0}^{3}":                        x[0].Power(3).Multiply(-0.5),
+		"x_{0}^{2} + 2 x_{0} x_{1} + x_{1}^{2}": x[0].Plus(x[1]).Power(2),
+	}
+
+	// Test
+	for expected, e := range testCases {
+		if rendered := render.LaTeX(e); rendered != expected {
+			t.Errorf("expected LaTeX(%v) to be %q; received %q", e, expected, rendered)
+		}
+	}
+}
+
+/*
+TestLaTeX2
+Description:
+
+	Tests that the LaTeX function renders vectors as column vectors and
+	matrices with the bmatrix environment.
+*/
+func TestLaTeX2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX2")
+	x := symbolic.NewVariableVector(2, env)
+	testCases := map[string]interface{}{
+		`\begin{bmatrix} 1 \\ 2 \end{bmatrix}`:                symbolic.KVector{1.0, 2.0},
+		`\begin{bmatrix} x_{0} \\ x_{1} \end{bmatrix}`:        x,
+		`\begin{bmatrix} 1 & 0 \\ 0 & 1 \end{bmatrix}`:        symbolic.KMatrix{{1.0, 0.0}, {0.0, 1.0}},
+		`\begin{bmatrix} x_{0}^{2} & 1 + x_{1} \end{bmatrix}`: symbolic.PolynomialMatrix{{x[0].Power(2).(symbolic.Monomial).ToPolynomial(), x[1].Plus(1.0).(symbolic.Polynomial)}},
+	}
+
+	// Test
+	for expected, e := range testCases {
+		if rendered := render.LaTeX(e); rendered != expected {
+			t.Errorf("expected LaTeX(%v) to be %q; received %q", e, expected, rendered)
+		}
+	}
+}
+
+/*
+TestLaTeX3
+Description:
+
+	Tests that the LaTeX function renders scalar, vector and matrix constraints
+	with \le, \ge and =, range constraints as lower \le expression \le upper and
+	second-order cone constraints with a norm.
+*/
+func TestLaTeX3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX3")
+	x := symbolic.NewVariableVector(2, env)
+	vm := symbolic.VariableMatrix{{x[0]}, {x[1]}}
+	testCases := map[string]interface{}{
+		`x_{0} + x_{1} \le 4`: x[0].Plus(x[1]).LessEq(4.0),
+		`\begin{bmatrix} x_{0} \\ x_{1} \end{bmatrix} \ge \begin{bmatrix} 0 \\ 1 \end{bmatrix}`: x.GreaterEq(symbolic.KVector{0.0, 1.0}),
+		`\begin{bmatrix} x_{0} \\ x_{1} \end{bmatrix} = \begin{bmatrix} 1 \\ 2 \end{bmatrix}`:   vm.Eq(symbolic.KMatrix{{1.0}, {2.0}}),
+		`-1 \le x_{0} + x_{1} \le 3`: x[0].Plus(x[1]).(symbolic.ScalarExpression).Between(-1.0, 3.0),
+		`\left\| \begin{bmatrix} x_{0} \\ x_{1} \end{bmatrix} \right\|_{2} \le 1`: symbolic.NewSecondOrderConeConstraint(x, symbolic.K(1.0)),
+	}
+
+	// Test
+	for expected, c := range testCases {
+		if rendered := render.LaTeX(c); rendered != expected {
+			t.Errorf("expected LaTeX(%v) to be %q; received %q", c, expected, rendered)
+		}
+	}
+}
+
+/*
+TestLaTeX4
+Description:
+
+	Tests the Precision and UseIDs options as well as the rendering of
+	custom variable names.
+*/
+func TestLaTeX4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX4")
+	cost := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 1.0, "cost", env)
+	flow := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 1.0, "f_in_1", env)
+	e := cost.Multiply(1.0 / 3.0).Plus(flow)
+
+	// Test
+	if rendered := render.LaTeX(e); rendered != `0.3333333333333333 \mathrm{cost} + f_{in\_1}` {
+		t.Errorf("unexpected default rendering %q", rendered)
+	}
+
+	if rendered := render.LaTeX(e, render.Options{Precision: 3}); rendered != `0.333 \mathrm{cost} + f_{in\_1}` {
+		t.Errorf("unexpected rendering with precision 3: %q", rendered)
+	}
+
+	if rendered := render.LaTeX(e, render.Options{Precision: 2, UseIDs: true}); rendered != `0.33 x_{0} + x_{1}` {
+		t.Errorf("unexpected rendering with IDs: %q", rendered)
+	}
+}
+
+/*
+TestLaTeX5
+Description:
+
+	Tests that the LaTeX function panics when given an unsupported input
+	or more than one Options value.
+*/
+func TestLaTeX5(t *testing.T) {
+	// Constants
+	testCases := []func(){
+		func() { render.LaTeX("x") },
+		func() { render.LaTeX(symbolic.K(1.0), render.Options{}, render.Options{}) },
+		func() { render.LaTeX(symbolic.K(1.0), render.Options{Precision: -1}) },
+	}
+
+	// Test
+	for ii, testCase := range testCases {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected test case %v to panic; it did not", ii)
+				}
+			}()
+			testCase()
+		}()
+	}
+}
+
+/*
+TestLaTeX6
+Description:
+
+	Tests that the special characters of LaTeX in variable names (both in the
+	base of the name and in its subscript) are escaped.
+*/
+func TestLaTeX6(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX6")
+	testCases := map[string]string{
+		"p%":      `\mathrm{p\%}`,
+		"a&b_{1}": `\mathrm{a\&b}_{\{1\}}`,
+		"n#_x_y":  `\mathrm{n\#}_{x\_y}`,
+		`c\d_$`:   `\mathrm{c\backslash{}d}_{\$}`,
+		"{z}":     `\mathrm{\{z\}}`,
+		"x^2":     `\mathrm{x\text{\textasciicircum}2}`,
+		"a~b_c^d": `\mathrm{a\text{\textasciitilde}b}_{c\text{\textasciicircum}d}`,
+	}
+
+	// Test
+	for name, expected := range testCases {
+		v := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 1.0, name, env)
+		if rendered := render.LaTeX(v); rendered != expected {
+			t.Errorf("expected the variable %q to be rendered as %q; received %q", name, expected, rendered)
+		}
+	}
+}
+
+/*
+TestLaTeX7
+Description:
+
+	Tests that the infinite bounds of range constraints are rendered as
+	\infty and -\infty (instead of 1 \times 10^{100}).
+*/
+func TestLaTeX7(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX7")
+	x := symbolic.NewVariableVector(2, env)
+
+	scalarRange := symbolic.ScalarRangeConstraint{
+		LowerBound: -symbolic.Infinity,
+		Expression: x[0],
+		UpperBound: 3.0,
+	}
+	vectorRange := symbolic.VectorRangeConstraint{
+		LowerBound: symbolic.KVector{0.0, -symbolic.Infinity},
+		Expression: x,
+		UpperBound: symbolic.KVector{symbolic.Infinity, 1.0},
+	}
+
+	// Test
+	if rendered, expected := render.LaTeX(scalarRange), `-\infty \le x_{0} \le 3`; rendered != expected {
+		t.Errorf("expected %q; received %q", expected, rendered)
+	}
+
+	expected := `\begin{bmatrix} 0 \\ -\infty \end{bmatrix} \le \begin{bmatrix} x_{0} \\ x_{1} \end{bmatrix} \le \begin{bmatrix} \infty \\ 1 \end{bmatrix}`
+	if rendered := render.LaTeX(vectorRange); rendered != expected {
+		t.Errorf("expected %q; received %q", expected, rendered)
+	}
+}
+
+/*
+TestLaTeX_FunctionExpression1
+Description:
+
+	Tests that the LaTeX function renders the elementary functions with
+	their LaTeX commands and wraps sums in parentheses when they are factors.
+*/
+func TestLaTeX_FunctionExpression1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX_FunctionExpression1")
+	x := symbolic.NewVariableVector(2, env)
+	testCases := map[string]interface{}{
+		`\sin\left(x_{0}\right)`:                        symbolic.Sin(x[0]),
+		`\sqrt{x_{0}}`:                                  symbolic.Sqrt(x[0]),
+		`\left|x_{1}\right|`:                            symbolic.Abs(x[1]),
+		`x_{0} - \log\left(x_{1}\right)`:                x[0].Minus(symbolic.Log(x[1])),
+		`\left(1 + x_{1}\right) \exp\left(x_{0}\right)`: x[1].Plus(1.0).Multiply(symbolic.Exp(x[0])),
+	}
+
+	// Test
+	for expected, e := range testCases {
+		if rendered := render.LaTeX(e); rendered != expected {
+			t.Errorf("expected LaTeX(%v) to be %q; received %q", e, expected, rendered)
+		}
+	}
+}
+
+/*
+TestLaTeX_RationalExpression1
+Description:
+
+	Tests that the LaTeX function renders rational expressions with \frac.
+*/
+func TestLaTeX_RationalExpression1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLaTeX_RationalExpression1")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	expected := `\frac{1}{x_{0} + 1}`
+	if rendered := render.LaTeX(symbolic.K(1.0).Divide(x.Plus(1.0))); rendered != expected {
+		t.Errorf("expected %q; received %q", expected, rendered)
+	}
+}
@@ -0,0 +1,265 @@
+package safe_test
+
+import (
+	"math"
+	"runtime"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic/safe"
+)
+
+/*
+operators_test.go
+Description:
+
+	Tests for the functions mentioned in the symbolic/safe/operators.go file.
+*/
+
+/*
+TestPlus1
+Description:
+
+	Tests that the Plus function returns the same result as the Plus method
+	when the inputs are compatible.
+*/
+func TestPlus1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPlus1")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	sum, err := safe.Plus(x, symbolic.KVector{1.0, 2.0})
+	if err != nil {
+		t.Errorf("expected Plus to succeed; received error %v", err)
+	}
+
+	expected := x.Plus(symbolic.KVector{1.0, 2.0})
+	if sum.String() != expected.String() {
+		t.Errorf("expected %v; received %v", expected, sum)
+	}
+}
+
+/*
+TestPlus2
+Description:
+
+	Tests that the Plus function returns an error (instead of panicking) when
+	the dimensions of the inputs do not match or the input is not supported.
+*/
+func TestPlus2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPlus2")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	sum, err := safe.Plus(x, symbolic.KVector{1.0, 2.0, 3.0})
+	if err == nil {
+		t.Errorf("expected Plus to return an error; received %v", sum)
+	}
+
+	if _, tf := err.(smErrors.MatrixDimensionError); !tf {
+		t.Errorf("expected a MatrixDimensionError; received %T (%v)", err, err)
+	}
+
+	_, err = safe.Plus(x, "not an expression")
+	if _, tf := err.(smErrors.UnsupportedInputError); !tf {
+		t.Errorf("expected an UnsupportedInputError; received %T (%v)", err, err)
+	}
+
+	_, err = safe.Plus(nil, x)
+	if _, tf := err.(smErrors.UnsupportedInputError); !tf {
+		t.Errorf("expected an UnsupportedInputError for a nil expression; received %T (%v)", err, err)
+	}
+}
+
+/*
+TestPlus3
+Description:
+
+	Tests that the Plus function does not hide the runtime errors raised by
+	the symbolic package: adding a constant to a KMatrix with rows of
+	different lengths (which passes Check) must still panic with a runtime.Error.
+*/
+func TestPlus3(t *testing.T) {
+	// Constants
+	ragged := symbolic.KMatrix{{1.0, 2.0}, {3.0}}
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(runtime.Error); !tf {
+			t.Errorf("expected a panic with a runtime.Error; received %T (%v)", r, r)
+		}
+	}()
+
+	safe.Plus(ragged, 1.0)
+}
+
+/*
+TestMultiply1
+Description:
+
+	Tests that the Multiply function returns a MatrixDimensionError when
+	multiplying matrices of incompatible dimensions.
+*/
+func TestMultiply1(t *testing.T) {
+	// Constants
+	A := symbolic.KMatrix{{1.0, 2.0, 3.0}, {4.0, 5.0, 6.0}}
+
+	// Test
+	product, err := safe.Multiply(A, A)
+	if err == nil {
+		t.Errorf("expected Multiply to return an error; received %v", product)
+	}
+
+	if _, tf := err.(smErrors.MatrixDimensionError); !tf {
+		t.Errorf("expected a MatrixDimensionError; received %T (%v)", err, err)
+	}
+
+	product, err = safe.Multiply(A, A.Transpose())
+	if err != nil {
+		t.Errorf("expected Multiply to succeed; received error %v", err)
+	}
+
+	if product.Dims()[0] != 2 || product.Dims()[1] != 2 {
+		t.Errorf("expected a 2x2 product; received dimensions %v", product.Dims())
+	}
+}
+
+/*
+TestPower1
+Description:
+
+	Tests that the Power function returns a NegativeExponentError for a
+	negative power of a matrix.
+*/
+func TestPower1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPower1")
+	x := symbolic.NewVariable(env)
+	X := symbolic.NewVariableMatrix(2, 2, env)
+
+	// Test
+	_, err := safe.Power(X, -2)
+	if _, tf := err.(smErrors.NegativeExponentError); !tf {
+		t.Errorf("expected a NegativeExponentError; received %T (%v)", err, err)
+	}
+
+	squared, err := safe.Power(x, 2)
+	if err != nil {
+		t.Errorf("expected Power to succeed; received error %v", err)
+	}
+
+	if squared.String() != x.Power(2).String() {
+		t.Errorf("expected %v; received %v", x.Power(2), squared)
+	}
+}
+
+/*
+TestPower2
+Description:
+
+	Tests that the Power function returns an ExponentOverflowError for the
+	minimum int exponent, whose negation overflows.
+*/
+func TestPower2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestPower2")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	_, err := safe.Power(x, math.MinInt)
+	if _, tf := err.(smErrors.ExponentOverflowError); !tf {
+		t.Errorf("expected an ExponentOverflowError; received %T (%v)", err, err)
+	}
+}
+
+/*
+TestPower3
+Description:
+
+	Tests that the Power function returns the errors that the symbolic package
+	creates without a more specific type (here, for a power of a matrix that is
+	not square) as a smErrors.SymbolicError.
+*/
+func TestPower3(t *testing.T) {
+	// Constants
+	A := symbolic.KMatrix{{1.0, 2.0, 3.0}, {4.0, 5.0, 6.0}}
+
+	// Test
+	_, err := safe.Power(A, 2)
+	if _, tf := err.(smErrors.SymbolicError); !tf {
+		t.Errorf("expected a SymbolicError; received %T (%v)", err, err)
+	}
+}
+
+/*
+TestComparison1
+Description:
+
+	Tests that the Comparison function returns an error when the sides of the
+	constraint have different dimensions or when the sense is invalid, and
+	returns the constraint otherwise.
+*/
+func TestComparison1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestComparison1")
+	x := symbolic.NewVariableVector(3, env)
+
+	// Test
+	_, err := safe.LessEq(x, symbolic.KVector{1.0, 2.0})
+	if err == nil {
+		t.Errorf("expected LessEq to return an error; received nil")
+	}
+
+	_, err = safe.Comparison(x, symbolic.KVector{1.0, 2.0, 3.0}, symbolic.ConstrSense('!'))
+	if err == nil {
+		t.Errorf("expected Comparison to return an error for an invalid sense; received nil")
+	}
+
+	c, err := safe.GreaterEq(x, symbolic.KVector{1.0, 2.0, 3.0})
+	if err != nil {
+		t.Errorf("expected GreaterEq to succeed; received error %v", err)
+	}
+
+	if c.ConstrSense() != symbolic.SenseGreaterThanEqual {
+		t.Errorf("expected a >= constraint; received %v", c)
+	}
+}
+
+/*
+TestSubstitute1
+Description:
+
+	Tests that the Substitute and SubstituteAccordingTo functions return an
+	error (instead of panicking) when the replacement is not well-defined.
+*/
+func TestSubstitute1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSubstitute1")
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewVariable(env)
+	badVar := symbolic.Variable{ID: 10, Lower: 1.0, Upper: -1.0, Name: "bad"}
+
+	// Test
+	_, err := safe.Substitute(x.Plus(y), x, badVar)
+	if err == nil {
+		t.Errorf("expected Substitute to return an error; received nil")
+	}
+
+	_, err = safe.SubstituteAccordingTo(x.Plus(y), map[symbolic.Variable]symbolic.Expression{x: badVar})
+	if err == nil {
+		t.Errorf("expected SubstituteAccordingTo to return an error; received nil")
+	}
+
+	result, err := safe.Substitute(x.Plus(y), x, y)
+	if err != nil {
+		t.Errorf("expected Substitute to succeed; received error %v", err)
+	}
+
+	if result.String() != x.Plus(y).Substitute(x, y).String() {
+		t.Errorf("expected %v; received %v", x.Plus(y).Substitute(x, y), result)
+	}
+}
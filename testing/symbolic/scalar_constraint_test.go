@@ -2329,3 +2329,143 @@ func TestScalarConstraint_IsNonnegativityConstraint8(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestScalarConstraint_QuadraticConstraintRepresentation1
+Description:
+
+	Tests that the QuadraticConstraintRepresentation() method correctly
+	flips the sign of Q, c and b for the constraint x^2 + 2 y >= 3 + x y.
+*/
+func TestScalarConstraint_QuadraticConstraintRepresentation1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	lhs := x.Power(2).Plus(y.Multiply(2.0))
+	rhs := x.Multiply(y).Plus(3.0)
+	sc := lhs.(symbolic.Polynomial).GreaterEq(rhs).(symbolic.ScalarConstraint)
+
+	// Test
+	// The constraint is equivalent to -x^2 + x y - 2 y <= -3
+	Q, c, b := sc.QuadraticConstraintRepresentation([]symbolic.Variable{x, y})
+	expectedQ := []float64{-2.0, 1.0, 1.0, 0.0}
+	for ii := 0; ii < 2; ii++ {
+		for jj := 0; jj < 2; jj++ {
+			if Q.At(ii, jj) != expectedQ[ii*2+jj] {
+				t.Errorf(
+					"expected Q[%v,%v] to be %v; received %v",
+					ii, jj,
+					expectedQ[ii*2+jj],
+					Q.At(ii, jj),
+				)
+			}
+		}
+	}
+
+	if c.AtVec(0) != 0.0 || c.AtVec(1) != -2.0 {
+		t.Errorf(
+			"expected c to be [0, -2]; received [%v, %v]",
+			c.AtVec(0),
+			c.AtVec(1),
+		)
+	}
+
+	if b != -3.0 {
+		t.Errorf("expected b to be -3.0; received %v", b)
+	}
+}
+
+/*
+TestScalarConstraint_QuadraticConstraintRepresentation2
+Description:
+
+	Tests that the QuadraticConstraintRepresentation() method panics
+	when the left hand side of the constraint is not quadratic.
+*/
+func TestScalarConstraint_QuadraticConstraintRepresentation2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	lhs := x.Power(3)
+	sc := lhs.(symbolic.Monomial).LessEq(1.0).(symbolic.ScalarConstraint)
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected QuadraticConstraintRepresentation() to panic; received nil",
+			)
+		}
+
+		rAsE, tf := r.(error)
+		if !tf {
+			t.Errorf(
+				"expected QuadraticConstraintRepresentation() to panic with an error; received %v",
+				r,
+			)
+		}
+
+		expectedError := smErrors.QuadraticExpressionRequiredError{
+			Operation:  "QuadraticConstraintRepresentation",
+			Expression: sc.LeftHandSide,
+		}
+		if !strings.Contains(rAsE.Error(), expectedError.Error()) {
+			t.Errorf(
+				"expected QuadraticConstraintRepresentation() to panic with error \"%v\"; received \"%v\"",
+				expectedError,
+				rAsE,
+			)
+		}
+	}()
+
+	sc.QuadraticConstraintRepresentation()
+}
+
+/*
+TestScalarConstraint_IsConvex1
+Description:
+
+	Verifies that x^2 + y^2 <= 1 is convex, that x^2 + y^2 >= 1 is not
+	and that an equality constraint is only convex when it is affine.
+*/
+func TestScalarConstraint_IsConvex1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarConstraint_IsConvex1")
+	x := symbolic.NewVariableVector(2, env)
+	normSquared := x[0].Power(2).Plus(x[1].Power(2))
+
+	// Test
+	if sc := normSquared.LessEq(1.0); !sc.IsConvex() {
+		t.Errorf("expected %v to be convex", sc)
+	}
+
+	if sc := normSquared.GreaterEq(1.0); sc.IsConvex() {
+		t.Errorf("expected %v to not be convex", sc)
+	}
+
+	if sc := normSquared.Eq(1.0); sc.IsConvex() {
+		t.Errorf("expected %v to not be convex", sc)
+	}
+
+	if sc := x[0].Plus(x[1]).Eq(1.0); !sc.IsConvex() {
+		t.Errorf("expected %v to be convex", sc)
+	}
+}
+
+/*
+TestScalarConstraint_IsConvex2
+Description:
+
+	Verifies that log(x) >= y is convex (the hypograph of a concave function).
+*/
+func TestScalarConstraint_IsConvex2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarConstraint_IsConvex2")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	sc := symbolic.Log(x[0]).(symbolic.ScalarExpression).GreaterEq(x[1])
+	if !sc.IsConvex() {
+		t.Errorf("expected %v to be convex", sc)
+	}
+}
@@ -0,0 +1,338 @@
+package symbolic_test
+
+/*
+scalar_range_constraint_test.go
+Description:
+	Tests for the functions mentioned in the scalar_range_constraint.go file.
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestScalarRangeConstraint_Between1
+Description:
+
+	Verifies that Between creates a ScalarRangeConstraint with the given
+	bounds (given as float64, int and K) and that it is recognized as a constraint.
+*/
+func TestScalarRangeConstraint_Between1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_Between1")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	c := x.Between(-1.0, 2)
+	src, tf := c.(symbolic.ScalarRangeConstraint)
+	if !tf {
+		t.Fatalf("expected x.Between(-1, 2) to be a ScalarRangeConstraint; received %T", c)
+	}
+
+	if src.Lower() != -1.0 || src.Upper() != 2.0 {
+		t.Errorf("expected the bounds to be -1 and 2; received %v and %v", src.Lower(), src.Upper())
+	}
+
+	if !symbolic.IsConstraint(c) {
+		t.Errorf("expected IsConstraint to be true for %v", c)
+	}
+
+	if k := symbolic.K(3.0); k.Between(symbolic.K(1.0), 4.0).(symbolic.ScalarRangeConstraint).Expression != k {
+		t.Errorf("expected the expression of the range constraint to be %v", k)
+	}
+}
+
+/*
+TestScalarRangeConstraint_Right1
+Description:
+
+	Verifies that the sense of -1 <= x <= 2 is SenseRange (which is not a
+	valid comparison sense) and that its right hand side contains both bounds.
+*/
+func TestScalarRangeConstraint_Right1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_Right1")
+	x := symbolic.NewVariable(env)
+	src := x.Between(-1.0, 2.0)
+
+	// Test
+	if src.ConstrSense() != symbolic.SenseRange {
+		t.Errorf("expected the sense of %v to be SenseRange; received %v", src, src.ConstrSense())
+	}
+
+	if err := src.ConstrSense().Check(); err == nil {
+		t.Errorf("expected SenseRange to not be a valid comparison sense")
+	}
+
+	bounds, tf := src.Right().(symbolic.KVector)
+	if !tf || bounds.Len() != 2 || bounds[0] != -1.0 || bounds[1] != 2.0 {
+		t.Errorf("expected the right hand side of %v to be [-1 2]; received %v", src, src.Right())
+	}
+}
+
+/*
+TestScalarRangeConstraint_Check1
+Description:
+
+	Verifies that a range constraint whose lower bound is greater than its
+	upper bound is invalid and that Between panics when it is created.
+*/
+func TestScalarRangeConstraint_Check1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_Check1")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	src := symbolic.ScalarRangeConstraint{Expression: x, LowerBound: 2.0, UpperBound: 1.0}
+	if err := src.Check(); err == nil {
+		t.Errorf("expected an error for the range constraint %v <= %v <= %v; received nil", src.Lower(), x, src.Upper())
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected Between(2, 1) to panic; it did not")
+		}
+	}()
+
+	x.Between(2.0, 1.0)
+}
+
+/*
+TestScalarRangeConstraint_Between2
+Description:
+
+	Verifies that Between panics with an UnsupportedInputError when a bound
+	is not a constant.
+*/
+func TestScalarRangeConstraint_Between2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_Between2")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.UnsupportedInputError); !tf {
+			t.Errorf("expected an UnsupportedInputError; received %v", r)
+		}
+	}()
+
+	x[0].Between(0.0, x[1])
+}
+
+/*
+TestScalarRangeConstraint_AsScalarConstraints1
+Description:
+
+	Verifies the decomposition of range constraints into scalar constraints:
+	1 <= x <= 4 gives two constraints, -Infinity <= x <= 4 gives one (<=) and
+	2 <= x <= 2 gives one equality.
+*/
+func TestScalarRangeConstraint_AsScalarConstraints1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_AsScalarConstraints1")
+	x := symbolic.NewVariable(env)
+
+	testCases := []struct {
+		Constraint symbolic.ScalarRangeConstraint
+		Expected   []symbolic.ConstrSense
+	}{
+		{
+			symbolic.ScalarRangeConstraint{Expression: x, LowerBound: 1.0, UpperBound: 4.0},
+			[]symbolic.ConstrSense{symbolic.SenseGreaterThanEqual, symbolic.SenseLessThanEqual},
+		},
+		{
+			symbolic.ScalarRangeConstraint{Expression: x, LowerBound: -symbolic.Infinity, UpperBound: 4.0},
+			[]symbolic.ConstrSense{symbolic.SenseLessThanEqual},
+		},
+		{
+			symbolic.ScalarRangeConstraint{Expression: x, LowerBound: 2.0, UpperBound: 2.0},
+			[]symbolic.ConstrSense{symbolic.SenseEqual},
+		},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		halves := tc.Constraint.AsScalarConstraints()
+		if len(halves) != len(tc.Expected) {
+			t.Errorf("expected %v to have %v halves; received %v", tc.Constraint, len(tc.Expected), halves)
+			continue
+		}
+
+		for ii, half := range halves {
+			if half.Sense != tc.Expected[ii] {
+				t.Errorf("expected half %v of %v to have sense %v; received %v", ii, tc.Constraint, tc.Expected[ii], half.Sense)
+			}
+		}
+	}
+}
+
+/*
+TestScalarRangeConstraint_LinearRangeRepresentation1
+Description:
+
+	Verifies that the linear representation of 1 <= 2 x - y + 3 <= 5
+	is A = (2, -1), lo = -2 and hi = 2, and that infinite bounds stay infinite.
+*/
+func TestScalarRangeConstraint_LinearRangeRepresentation1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_LinearRangeRepresentation1")
+	x := symbolic.NewVariableVector(2, env)
+	e := x[0].Multiply(2.0).Minus(x[1]).Plus(3.0).(symbolic.ScalarExpression)
+
+	// Test
+	A, lo, hi := e.Between(1.0, 5.0).(symbolic.ScalarRangeConstraint).LinearRangeRepresentation(x)
+
+	if expected := mat.NewVecDense(2, []float64{2.0, -1.0}); !mat.EqualApprox(&A, expected, 1e-12) {
+		t.Errorf("expected A = %v; received %v", mat.Formatted(expected), mat.Formatted(&A))
+	}
+
+	if lo != -2.0 || hi != 2.0 {
+		t.Errorf("expected lo = -2 and hi = 2; received %v and %v", lo, hi)
+	}
+
+	_, lo, _ = e.Between(-symbolic.Infinity, 5.0).(symbolic.ScalarRangeConstraint).LinearRangeRepresentation(x)
+	if lo != float64(-symbolic.Infinity) {
+		t.Errorf("expected lo = -Infinity; received %v", lo)
+	}
+}
+
+/*
+TestScalarRangeConstraint_LinearRangeRepresentation2
+Description:
+
+	Verifies that LinearRangeRepresentation panics with a
+	LinearExpressionRequiredError when the expression is quadratic.
+*/
+func TestScalarRangeConstraint_LinearRangeRepresentation2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_LinearRangeRepresentation2")
+	x := symbolic.NewVariable(env)
+	src := symbolic.ScalarRangeConstraint{Expression: x.Power(2).(symbolic.ScalarExpression), LowerBound: 0.0, UpperBound: 1.0}
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.LinearExpressionRequiredError); !tf {
+			t.Errorf("expected a LinearExpressionRequiredError; received %v", r)
+		}
+	}()
+
+	src.LinearRangeRepresentation()
+}
+
+/*
+TestScalarRangeConstraint_Substitute1
+Description:
+
+	Verifies that substituting y = 2 x into 0 <= x + y <= 3 gives a range
+	constraint on 3 x with the same bounds.
+*/
+func TestScalarRangeConstraint_Substitute1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_Substitute1")
+	x := symbolic.NewVariableVector(2, env)
+	src := x[0].Plus(x[1]).(symbolic.ScalarExpression).Between(0.0, 3.0)
+
+	// Test
+	substituted, tf := src.Substitute(x[1], x[0].Multiply(2.0).(symbolic.ScalarExpression)).(symbolic.ScalarRangeConstraint)
+	if !tf {
+		t.Fatalf("expected the substituted constraint to be a ScalarRangeConstraint; received %T", substituted)
+	}
+
+	if vars := substituted.Variables(); len(vars) != 1 || vars[0].ID != x[0].ID {
+		t.Errorf("expected the substituted constraint to only contain %v; received %v", x[0], vars)
+	}
+
+	if value := substituted.Expression.Evaluate(map[symbolic.Variable]float64{x[0]: 1.0}); value != 3.0 {
+		t.Errorf("expected the substituted expression to be 3 at x = 1; received %v", value)
+	}
+
+	if substituted.Lower() != 0.0 || substituted.Upper() != 3.0 {
+		t.Errorf("expected the bounds to be unchanged; received %v and %v", substituted.Lower(), substituted.Upper())
+	}
+}
+
+/*
+TestScalarRangeConstraint_AsSimplifiedConstraint1
+Description:
+
+	Verifies that simplifying 1 <= x + 3 <= Infinity moves the constant into
+	the bounds (-2 <= x <= Infinity) without changing the infinite bound.
+*/
+func TestScalarRangeConstraint_AsSimplifiedConstraint1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_AsSimplifiedConstraint1")
+	x := symbolic.NewVariable(env)
+	src := x.Plus(3.0).(symbolic.ScalarExpression).Between(1.0, symbolic.Infinity)
+
+	// Test
+	simplified := src.AsSimplifiedConstraint().(symbolic.ScalarRangeConstraint)
+
+	if simplified.Expression.Constant() != 0.0 {
+		t.Errorf("expected the simplified expression to have no constant; received %v", simplified.Expression)
+	}
+
+	if simplified.Lower() != -2.0 || simplified.Upper() != symbolic.Infinity {
+		t.Errorf("expected the bounds to be -2 and Infinity; received %v and %v", simplified.Lower(), simplified.Upper())
+	}
+}
+
+/*
+TestScalarRangeConstraint_ImpliesThisIsAlsoSatisfied1
+Description:
+
+	Verifies the implications of range constraints:
+	1 <= x + y <= 2 implies 0 <= x + y <= 3 but not 1.5 <= x + y <= 3,
+	1 <= x <= 2 implies x <= 5, and x <= 1 implies -Infinity <= x <= 2.
+*/
+func TestScalarRangeConstraint_ImpliesThisIsAlsoSatisfied1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_ImpliesThisIsAlsoSatisfied1")
+	x := symbolic.NewVariableVector(2, env)
+	sum := x[0].Plus(x[1]).(symbolic.ScalarExpression)
+
+	// Test
+	if !sum.Between(1.0, 2.0).ImpliesThisIsAlsoSatisfied(sum.Between(0.0, 3.0)) {
+		t.Errorf("expected 1 <= x + y <= 2 to imply 0 <= x + y <= 3")
+	}
+
+	if sum.Between(1.0, 2.0).ImpliesThisIsAlsoSatisfied(sum.Between(1.5, 3.0)) {
+		t.Errorf("expected 1 <= x + y <= 2 to not imply 1.5 <= x + y <= 3")
+	}
+
+	if !x[0].Between(1.0, 2.0).ImpliesThisIsAlsoSatisfied(x[0].LessEq(5.0)) {
+		t.Errorf("expected 1 <= x <= 2 to imply x <= 5")
+	}
+
+	if !x[0].LessEq(1.0).ImpliesThisIsAlsoSatisfied(x[0].Between(-symbolic.Infinity, 2.0)) {
+		t.Errorf("expected x <= 1 to imply -Infinity <= x <= 2")
+	}
+}
+
+/*
+TestScalarRangeConstraint_IsConvex1
+Description:
+
+	Verifies that x^2 <= 1 (written as -Infinity <= x^2 <= 1) is convex,
+	while 0.5 <= x^2 <= 1 is not.
+*/
+func TestScalarRangeConstraint_IsConvex1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestScalarRangeConstraint_IsConvex1")
+	x := symbolic.NewVariable(env)
+	square := x.Power(2).(symbolic.ScalarExpression)
+
+	// Test
+	if !square.Between(-symbolic.Infinity, 1.0).IsConvex() {
+		t.Errorf("expected -Infinity <= x^2 <= 1 to be convex")
+	}
+
+	if square.Between(0.5, 1.0).IsConvex() {
+		t.Errorf("expected 0.5 <= x^2 <= 1 to not be convex")
+	}
+}
@@ -0,0 +1,230 @@
+package symbolic_test
+
+/*
+second_order_cone_constraint_test.go
+Description:
+	Tests for the functions mentioned in the second_order_cone_constraint.go file.
+*/
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestSecondOrderConeConstraint_Check1
+Description:
+
+	Verifies that a second-order cone constraint without a bound is invalid,
+	that a valid one is recognized as a (nonlinear) constraint and that it
+	prevents a problem from being an LP.
+*/
+func TestSecondOrderConeConstraint_Check1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSecondOrderConeConstraint_Check1")
+	problem := symbolic.NewProblem("TestSecondOrderConeConstraint_Check1", env)
+	x := problem.AddVariableVector(2)
+
+	// Test
+	invalid := symbolic.SecondOrderConeConstraint{Vector: x}
+	if err := invalid.Check(); err == nil {
+		t.Errorf("expected an error for a second-order cone constraint without a bound; received nil")
+	}
+
+	soc := symbolic.NewSecondOrderConeConstraint(x, symbolic.K(1.0))
+	if !symbolic.IsConstraint(soc) {
+		t.Errorf("expected IsConstraint to be true for %v", soc)
+	}
+
+	if soc.IsLinear() {
+		t.Errorf("expected %v to not be linear", soc)
+	}
+
+	problem.SetObjective(x[0], symbolic.SenseMinimize)
+	problem.AddConstraints(soc)
+	if problem.IsLP() {
+		t.Errorf("expected a problem with a second-order cone constraint to not be an LP")
+	}
+}
+
+/*
+TestSecondOrderConeConstraint_IsConvex1
+Description:
+
+	Verifies that ||(x, y)||_2 <= t is convex, while ||(x, y)||_2 <= t^2
+	(whose bound is not concave) and ||(x^2, y)||_2 <= t are not recognized as convex.
+*/
+func TestSecondOrderConeConstraint_IsConvex1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSecondOrderConeConstraint_IsConvex1")
+	x := symbolic.NewVariableVector(3, env)
+	v := symbolic.VariableVector{x[0], x[1]}
+
+	// Test
+	if !symbolic.NewSecondOrderConeConstraint(v, x[2]).IsConvex() {
+		t.Errorf("expected ||(x, y)||_2 <= t to be convex")
+	}
+
+	if symbolic.NewSecondOrderConeConstraint(v, x[2].Power(2).(symbolic.ScalarExpression)).IsConvex() {
+		t.Errorf("expected ||(x, y)||_2 <= t^2 to not be convex")
+	}
+
+	nonlinear := symbolic.VStack(x[0].Power(2), x[1]).(symbolic.VectorExpression)
+	if symbolic.NewSecondOrderConeConstraint(nonlinear, x[2]).IsConvex() {
+		t.Errorf("expected ||(x^2, y)||_2 <= t to not be convex")
+	}
+}
+
+/*
+TestSecondOrderConeConstraint_LinearConeRepresentation1
+Description:
+
+	Verifies that the canonical representation of ||(x + 1, 2 y)||_2 <= x + 3
+	is A = [[1, 0], [0, 2]], b = (1, 0), c = (1, 0) and d = 3.
+*/
+func TestSecondOrderConeConstraint_LinearConeRepresentation1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSecondOrderConeConstraint_LinearConeRepresentation1")
+	x := symbolic.NewVariableVector(2, env)
+	v := symbolic.VStack(x[0].Plus(1.0), x[1].Multiply(2.0)).(symbolic.VectorExpression)
+	soc := symbolic.NewSecondOrderConeConstraint(v, x[0].Plus(3.0).(symbolic.ScalarExpression))
+
+	// Test
+	A, b, c, d := soc.LinearConeRepresentation()
+
+	if expected := mat.NewDense(2, 2, []float64{1.0, 0.0, 0.0, 2.0}); !mat.EqualApprox(&A, expected, 1e-12) {
+		t.Errorf("expected A = %v; received %v", mat.Formatted(expected), mat.Formatted(&A))
+	}
+
+	if expected := mat.NewVecDense(2, []float64{1.0, 0.0}); !mat.EqualApprox(&b, expected, 1e-12) {
+		t.Errorf("expected b = %v; received %v", mat.Formatted(expected), mat.Formatted(&b))
+	}
+
+	if expected := mat.NewVecDense(2, []float64{1.0, 0.0}); !mat.EqualApprox(&c, expected, 1e-12) {
+		t.Errorf("expected c = %v; received %v", mat.Formatted(expected), mat.Formatted(&c))
+	}
+
+	if d != 3.0 {
+		t.Errorf("expected d = 3; received %v", d)
+	}
+}
+
+/*
+TestSecondOrderConeConstraint_LinearConeRepresentation2
+Description:
+
+	Verifies that LinearConeRepresentation panics with a
+	LinearExpressionRequiredError when the bound is quadratic.
+*/
+func TestSecondOrderConeConstraint_LinearConeRepresentation2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSecondOrderConeConstraint_LinearConeRepresentation2")
+	x := symbolic.NewVariableVector(2, env)
+	soc := symbolic.NewSecondOrderConeConstraint(x, x[0].Power(2).(symbolic.ScalarExpression))
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.LinearExpressionRequiredError); !tf {
+			t.Errorf("expected a LinearExpressionRequiredError; received %v", r)
+		}
+	}()
+
+	soc.LinearConeRepresentation()
+}
+
+/*
+TestSecondOrderConeConstraint_IsSatisfiedBy1
+Description:
+
+	Verifies the feasibility test of ||(x, y)||_2 <= t at (3, 4, 5), which is on
+	the boundary of the cone, and at (3, 4, 4.9), which is outside of it.
+*/
+func TestSecondOrderConeConstraint_IsSatisfiedBy1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSecondOrderConeConstraint_IsSatisfiedBy1")
+	x := symbolic.NewVariableVector(3, env)
+	soc := symbolic.NewSecondOrderConeConstraint(symbolic.VariableVector{x[0], x[1]}, x[2])
+
+	// Test
+	onBoundary := map[symbolic.Variable]float64{x[0]: 3.0, x[1]: 4.0, x[2]: 5.0}
+	if residual := soc.Residual(onBoundary); math.Abs(residual) > 1e-12 {
+		t.Errorf("expected the residual at %v to be 0; received %v", onBoundary, residual)
+	}
+
+	if !soc.IsSatisfiedBy(onBoundary, 1e-9) {
+		t.Errorf("expected %v to be satisfied at %v", soc, onBoundary)
+	}
+
+	outside := map[symbolic.Variable]float64{x[0]: 3.0, x[1]: 4.0, x[2]: 4.9}
+	if soc.IsSatisfiedBy(outside, 1e-9) {
+		t.Errorf("expected %v to not be satisfied at %v", soc, outside)
+	}
+}
+
+/*
+TestSecondOrderConeConstraint_Substitute1
+Description:
+
+	Verifies that substituting t = 2 x into ||(x, y)||_2 <= t gives a
+	second-order cone constraint that no longer contains t.
+*/
+func TestSecondOrderConeConstraint_Substitute1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSecondOrderConeConstraint_Substitute1")
+	x := symbolic.NewVariableVector(3, env)
+	soc := symbolic.NewSecondOrderConeConstraint(symbolic.VariableVector{x[0], x[1]}, x[2])
+
+	// Test
+	substituted, tf := soc.Substitute(x[2], x[0].Multiply(2.0).(symbolic.ScalarExpression)).(symbolic.SecondOrderConeConstraint)
+	if !tf {
+		t.Fatalf("expected the substituted constraint to be a SecondOrderConeConstraint; received %T", substituted)
+	}
+
+	vars := substituted.Variables()
+	if len(vars) != 2 || vars[0].ID != x[0].ID || vars[1].ID != x[1].ID {
+		t.Errorf("expected the substituted constraint to contain %v and %v; received %v", x[0], x[1], vars)
+	}
+
+	if !substituted.IsSatisfiedBy(map[symbolic.Variable]float64{x[0]: 1.0, x[1]: 1.0}, 1e-9) {
+		t.Errorf("expected %v to be satisfied at x = y = 1", substituted)
+	}
+}
+
+/*
+TestNewRotatedSecondOrderConeConstraint1
+Description:
+
+	Verifies that the rotated cone ||v||_2^2 <= 2 y z, y, z >= 0 is satisfied at
+	v = (1, 1), y = z = 1 and violated at y = 0.5, z = 1 and at y = z = -1
+	(where 2 y z = 2, but y and z are negative).
+*/
+func TestNewRotatedSecondOrderConeConstraint1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestNewRotatedSecondOrderConeConstraint1")
+	v := symbolic.NewVariableVector(2, env)
+	y := symbolic.NewVariable(env)
+	z := symbolic.NewVariable(env)
+	soc := symbolic.NewRotatedSecondOrderConeConstraint(v, y, z)
+
+	testCases := []struct {
+		Y, Z     float64
+		Expected bool
+	}{
+		{1.0, 1.0, true},
+		{0.5, 1.0, false},
+		{-1.0, -1.0, false},
+	}
+
+	// Test
+	for _, tc := range testCases {
+		values := map[symbolic.Variable]float64{v[0]: 1.0, v[1]: 1.0, y: tc.Y, z: tc.Z}
+		if satisfied := soc.IsSatisfiedBy(values, 1e-9); satisfied != tc.Expected {
+			t.Errorf("expected IsSatisfiedBy(%v) to be %v; received %v", values, tc.Expected, satisfied)
+		}
+	}
+}
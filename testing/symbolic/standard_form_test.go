@@ -0,0 +1,184 @@
+package symbolic_test
+
+/*
+standard_form_test.go
+Description:
+	Tests for the conversion of linear programs into standard form
+	(defined in symbolic/standard_form.go).
+*/
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestToStandardForm1
+Description:
+
+	Verifies the standard form of the problem
+		minimize   x + 2 y - w + 5
+		subject to x + y <= 4
+		           x - w >= -2
+		           x + y + w = 3
+		           w >= 0
+	where x is free and 1 <= y <= 3. x is split into two variables, y is shifted
+	and its upper bound becomes a row, and the two inequalities receive slacks,
+	so the standard form has 4 rows and 7 variables (3 of them slacks). The point
+	x = y = w = 1 corresponds to z = (1, 0, 0, 1, 2, 2, 2).
+*/
+func TestToStandardForm1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestToStandardForm1")
+	x := symbolic.NewVariable(env)
+	y := symbolic.NewCustomVariable(symbolic.Continuous, 1.0, 3.0, "y", env)
+	w := symbolic.NewVariable(env)
+
+	objective := x.Plus(y.Multiply(2.0)).Minus(w).Plus(5.0)
+	constraints := []symbolic.Constraint{
+		x.Plus(y).LessEq(4.0),
+		x.Minus(w).GreaterEq(-2.0),
+		x.Plus(y).Plus(w).Eq(3.0),
+		w.GreaterEq(0.0),
+	}
+
+	// Test
+	sf, err := symbolic.ToStandardForm(objective.(symbolic.ScalarExpression), constraints, env)
+	if err != nil {
+		t.Fatalf("expected ToStandardForm to succeed; received error %v", err)
+	}
+
+	if nRows, nCols := sf.A.Dims(); nRows != 4 || nCols != 7 {
+		t.Fatalf("expected A to be 4 x 7; received %v x %v", nRows, nCols)
+	}
+
+	if len(sf.Variables) != 7 || len(sf.SlackVariables) != 3 {
+		t.Errorf(
+			"expected 7 variables (3 slacks); received %v (%v slacks)",
+			len(sf.Variables), len(sf.SlackVariables),
+		)
+	}
+
+	for ii := 0; ii < sf.B.Len(); ii++ {
+		if sf.B.AtVec(ii) < 0 {
+			t.Errorf("expected b >= 0; received b[%v] = %v", ii, sf.B.AtVec(ii))
+		}
+	}
+
+	z := mat.NewVecDense(7, []float64{1.0, 0.0, 0.0, 1.0, 2.0, 2.0, 2.0})
+	var Az mat.VecDense
+	Az.MulVec(&sf.A, z)
+	if !mat.EqualApprox(&Az, &sf.B, 1e-12) {
+		t.Errorf("expected A z = %v; received %v", mat.Formatted(&sf.B), mat.Formatted(&Az))
+	}
+
+	values := sf.OriginalValues(*z)
+	for _, v := range []symbolic.Variable{x, y, w} {
+		if math.Abs(values[v]-1.0) > 1e-12 {
+			t.Errorf("expected %v = 1; received %v", v, values[v])
+		}
+	}
+
+	if value := mat.Dot(&sf.C, z) + sf.Offset; math.Abs(value-7.0) > 1e-12 {
+		t.Errorf("expected the objective of the standard form to be 7; received %v", value)
+	}
+}
+
+/*
+TestToStandardForm2
+Description:
+
+	Verifies that ToStandardForm returns a LinearExpressionRequiredError when the
+	objective is not linear.
+*/
+func TestToStandardForm2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestToStandardForm2")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	_, err := symbolic.ToStandardForm(
+		x.Power(2).(symbolic.ScalarExpression),
+		[]symbolic.Constraint{x.LessEq(1.0)},
+		env,
+	)
+	if _, tf := err.(smErrors.LinearExpressionRequiredError); !tf {
+		t.Errorf("expected a LinearExpressionRequiredError; received %v", err)
+	}
+}
+
+/*
+TestToStandardForm3
+Description:
+
+	Verifies that ToStandardForm returns an UnsupportedConstraintError (instead of
+	panicking) when one of the constraints is a second-order cone constraint.
+*/
+func TestToStandardForm3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestToStandardForm3")
+	x := symbolic.NewVariableVector(2, env)
+	y := symbolic.NewVariable(env)
+
+	// Test
+	_, err := symbolic.ToStandardForm(
+		y,
+		[]symbolic.Constraint{x[0].LessEq(1.0), symbolic.NewSecondOrderConeConstraint(x, y)},
+		env,
+	)
+	if _, tf := err.(smErrors.UnsupportedConstraintError); !tf {
+		t.Errorf("expected an UnsupportedConstraintError; received %v", err)
+	}
+}
+
+/*
+TestProblem_ToStandardForm1
+Description:
+
+	Verifies the standard form of the problem
+		maximize   u
+		subject to u >= -1
+	where u <= 2. u is mirrored (u = 2 - z), so the standard form is
+		minimize   z - 2
+		subject to z + s = 3.
+*/
+func TestProblem_ToStandardForm1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestProblem_ToStandardForm1")
+	problem := symbolic.NewProblem("TestProblem_ToStandardForm1", env)
+	u := symbolic.NewCustomVariable(symbolic.Continuous, float64(-symbolic.Infinity), 2.0, "u", env)
+
+	problem.SetObjective(u, symbolic.SenseMaximize)
+	problem.AddConstraints(u.GreaterEq(-1.0))
+
+	// Test
+	sf, err := problem.ToStandardForm()
+	if err != nil {
+		t.Fatalf("expected ToStandardForm to succeed; received error %v", err)
+	}
+
+	if expected := mat.NewVecDense(2, []float64{1.0, 0.0}); !mat.EqualApprox(&sf.C, expected, 1e-12) {
+		t.Errorf("expected c = %v; received %v", mat.Formatted(expected), mat.Formatted(&sf.C))
+	}
+
+	if sf.Offset != -2.0 {
+		t.Errorf("expected the offset to be -2; received %v", sf.Offset)
+	}
+
+	if expected := mat.NewDense(1, 2, []float64{1.0, 1.0}); !mat.EqualApprox(&sf.A, expected, 1e-12) {
+		t.Errorf("expected A = %v; received %v", mat.Formatted(expected), mat.Formatted(&sf.A))
+	}
+
+	if expected := mat.NewVecDense(1, []float64{3.0}); !mat.EqualApprox(&sf.B, expected, 1e-12) {
+		t.Errorf("expected b = %v; received %v", mat.Formatted(expected), mat.Formatted(&sf.B))
+	}
+
+	values := sf.OriginalValues(*mat.NewVecDense(2, []float64{3.0, 0.0}))
+	if values[u] != -1.0 {
+		t.Errorf("expected u = -1 at z = 3; received %v", values[u])
+	}
+}
@@ -0,0 +1,249 @@
+package symbolic_test
+
+/*
+taylor_test.go
+Description:
+	Tests for TaylorExpand and Linearize (defined in symbolic/taylor.go).
+*/
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestTaylorExpand1
+Description:
+
+	Verifies that the third-order Taylor polynomial of exp(x) around 0 is
+	1 + d + d^2/2 + d^3/6, where d = x - 0 is the deviation variable of x.
+*/
+func TestTaylorExpand1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestTaylorExpand1")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	expansion, deviations := symbolic.TaylorExpandWithDeviations(symbolic.Exp(x), map[symbolic.Variable]float64{x: 0.0}, 3)
+	if _, tf := expansion.(symbolic.Polynomial); !tf {
+		t.Errorf("expected a Polynomial; received %T", expansion)
+	}
+
+	d := deviations[x]
+	expected := symbolic.K(1.0).Plus(d).Plus(d.Power(2).Multiply(0.5)).Plus(d.Power(3).Multiply(1.0 / 6.0))
+	if !expansion.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, expansion)
+	}
+}
+
+/*
+TestTaylorExpand2
+Description:
+
+	Verifies that the Taylor expansion of a polynomial around any point
+	reproduces the polynomial (after substituting d = x - x0) when the order
+	is at least its degree, and that the first-order expansion of x^2 around 3
+	is the tangent line 9 + 6 d.
+*/
+func TestTaylorExpand2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestTaylorExpand2")
+	x := symbolic.NewVariableVector(2, env)
+	p := x[0].Power(3).Multiply(x[1]).Plus(x[0].Multiply(x[1]).Multiply(2.0)).Plus(5.0)
+	point := map[symbolic.Variable]float64{x[0]: 1.0, x[1]: -2.0}
+
+	// Test
+	expansion, deviations := symbolic.TaylorExpandWithDeviations(p, point, 4)
+	backSubstitution := map[symbolic.Variable]symbolic.Expression{}
+	for v, d := range deviations {
+		backSubstitution[d] = v.Minus(point[v])
+	}
+
+	if inX := expansion.SubstituteAccordingTo(backSubstitution); !inX.Equals(p, 1e-12) {
+		t.Errorf("expected %v; received %v", p, inX)
+	}
+
+	tangent, deviations := symbolic.TaylorExpandWithDeviations(x[0].Power(2), map[symbolic.Variable]float64{x[0]: 3.0}, 1)
+	if expected := deviations[x[0]].Multiply(6.0).Plus(9.0); !tangent.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, tangent)
+	}
+}
+
+/*
+TestTaylorExpand3
+Description:
+
+	Verifies that the first-order expansion of the vector (sin(x), x y) around
+	(0, 1) is the PolynomialVector (d_x, d_x), in terms of the deviation
+	variable d_x = x - 0.
+*/
+func TestTaylorExpand3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestTaylorExpand3")
+	x := symbolic.NewVariableVector(2, env)
+	f := symbolic.VStack(symbolic.Sin(x[0]), x[0].Multiply(x[1]))
+	point := map[symbolic.Variable]float64{x[0]: 0.0, x[1]: 1.0}
+
+	// Test
+	expansion, deviations := symbolic.TaylorExpandWithDeviations(f, point, 1)
+	expansionAsPV, tf := expansion.(symbolic.PolynomialVector)
+	if !tf {
+		t.Fatalf("expected a PolynomialVector; received %T", expansion)
+	}
+
+	dx := deviations[x[0]]
+	for ii := 0; ii < expansionAsPV.Len(); ii++ {
+		if !expansionAsPV[ii].Equals(dx, 1e-12) {
+			t.Errorf("expected element %v to be %v; received %v", ii, dx, expansionAsPV[ii])
+		}
+	}
+}
+
+/*
+TestTaylorExpand4
+Description:
+
+	Verifies that TaylorExpand panics with a MissingVariableValueError when the
+	point does not contain a value for one of the variables of the expression.
+*/
+func TestTaylorExpand4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestTaylorExpand4")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.MissingVariableValueError); !tf {
+			t.Errorf("expected a MissingVariableValueError; received %v", r)
+		}
+	}()
+
+	symbolic.TaylorExpand(x[0].Multiply(x[1]), map[symbolic.Variable]float64{x[0]: 1.0}, 2)
+}
+
+/*
+TestTaylorExpand5
+Description:
+
+	Verifies that the second-order expansion of exp(x) around 1 is
+	e + e (x - 1) + (e / 2) (x - 1)^2, written in terms of x itself,
+	and that TaylorExpand does not add variables to the environment of x.
+*/
+func TestTaylorExpand5(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestTaylorExpand5")
+	x := symbolic.NewVariable(env)
+
+	// Test
+	expansion := symbolic.TaylorExpand(symbolic.Exp(x), map[symbolic.Variable]float64{x: 1.0}, 2)
+	if vars := expansion.Variables(); len(vars) != 1 || vars[0] != x {
+		t.Errorf("expected the expansion to only contain %v; received %v", x, expansion)
+	}
+
+	if tracked := env.AllTrackedVariables(); len(tracked) != 1 {
+		t.Errorf("expected TaylorExpand to not create variables; the environment tracks %v", tracked)
+	}
+
+	d := x.Minus(1.0)
+	expected := symbolic.K(math.E).Plus(d.Multiply(math.E)).Plus(d.Power(2).Multiply(math.E / 2.0))
+	if !expansion.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, expansion)
+	}
+}
+
+/*
+TestTaylorExpand6
+Description:
+
+	Verifies that TaylorExpand writes the expansion in the deviation variables
+	given by the caller (e.g., the ones created by TaylorExpandWithDeviations),
+	so that relinearizing around a new point does not create new variables.
+*/
+func TestTaylorExpand6(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestTaylorExpand6")
+	x := symbolic.NewVariable(env)
+	f := x.Power(2)
+
+	// Test
+	_, deviations := symbolic.TaylorExpandWithDeviations(f, map[symbolic.Variable]float64{x: 3.0}, 1)
+	nTracked := len(env.AllTrackedVariables())
+
+	tangent := symbolic.TaylorExpand(f, map[symbolic.Variable]float64{x: 2.0}, 1, deviations)
+	if expected := deviations[x].Multiply(4.0).Plus(4.0); !tangent.Equals(expected, 1e-12) {
+		t.Errorf("expected %v; received %v", expected, tangent)
+	}
+
+	if tracked := env.AllTrackedVariables(); len(tracked) != nTracked {
+		t.Errorf("expected TaylorExpand to reuse the given deviation variables; the environment tracks %v", tracked)
+	}
+}
+
+/*
+TestLinearize1
+Description:
+
+	Verifies the linearization of the pendulum dynamics (x_1, -sin(x_0) + u)
+	around the upright position x0 = (pi, 0), u0 = 0:
+	A = [[0, 1], [1, 0]], B = [[0], [1]] and c = (0, 0).
+*/
+func TestLinearize1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLinearize1")
+	x := symbolic.NewVariableVector(2, env)
+	u := symbolic.NewVariableVector(1, env)
+	f := symbolic.VStack(
+		x[1],
+		symbolic.Sin(x[0]).Multiply(-1.0).Plus(u[0]),
+	).(symbolic.VectorExpression)
+	x0 := mat.NewVecDense(2, []float64{math.Pi, 0.0})
+	u0 := mat.NewVecDense(1, []float64{0.0})
+
+	// Test
+	A, B, c := symbolic.Linearize(f, x, u, *x0, *u0)
+
+	expectedA := mat.NewDense(2, 2, []float64{0.0, 1.0, 1.0, 0.0})
+	if !mat.EqualApprox(&A, expectedA, 1e-12) {
+		t.Errorf("expected A = %v; received %v", mat.Formatted(expectedA), mat.Formatted(&A))
+	}
+
+	expectedB := mat.NewDense(2, 1, []float64{0.0, 1.0})
+	if !mat.EqualApprox(&B, expectedB, 1e-12) {
+		t.Errorf("expected B = %v; received %v", mat.Formatted(expectedB), mat.Formatted(&B))
+	}
+
+	expectedC := mat.NewVecDense(2, []float64{0.0, 0.0})
+	if !mat.EqualApprox(&c, expectedC, 1e-12) {
+		t.Errorf("expected c = %v; received %v", mat.Formatted(expectedC), mat.Formatted(&c))
+	}
+}
+
+/*
+TestLinearize2
+Description:
+
+	Verifies that Linearize panics with a VectorDimensionError when the
+	operating point does not have the dimension of the state.
+*/
+func TestLinearize2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestLinearize2")
+	x := symbolic.NewVariableVector(2, env)
+	u := symbolic.NewVariableVector(1, env)
+	f := x.Plus(u[0]).(symbolic.VectorExpression)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.VectorDimensionError); !tf {
+			t.Errorf("expected a VectorDimensionError; received %v", r)
+		}
+	}()
+
+	symbolic.Linearize(f, x, u, *mat.NewVecDense(3, nil), *mat.NewVecDense(1, nil))
+}
@@ -0,0 +1,251 @@
+package symbolic_test
+
+/*
+univariate_polynomial_test.go
+Description:
+	Tests for the functions defined in symbolic/univariate_polynomial.go
+	(DivideWithRemainder, GCD, SquareFreeFactorization and RationalRoots).
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestDivideWithRemainder1
+Description:
+
+	Verifies that (x^3 - 2x^2 - 4) / (x - 3) gives the quotient x^2 + x + 3
+	and the remainder 5.
+*/
+func TestDivideWithRemainder1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestDivideWithRemainder1")
+	x := symbolic.NewVariable(env)
+	p := x.Power(3).Minus(x.Power(2).Multiply(2.0)).Minus(4.0).(symbolic.Polynomial)
+	d := x.Minus(3.0).(symbolic.Polynomial)
+
+	// Test
+	q, r := symbolic.DivideWithRemainder(p, d, x)
+
+	expectedQ := x.Power(2).Plus(x).Plus(3.0)
+	if !q.Equals(expectedQ, 1e-12) {
+		t.Errorf("expected the quotient %v; received %v", expectedQ, q)
+	}
+
+	if !r.Equals(symbolic.K(5.0), 1e-12) {
+		t.Errorf("expected the remainder 5; received %v", r)
+	}
+
+	// p = q d + r
+	if reconstructed := q.Multiply(d).Plus(r); !reconstructed.Equals(p, 1e-12) {
+		t.Errorf("expected q d + r = %v; received %v", p, reconstructed)
+	}
+}
+
+/*
+TestDivideWithRemainder2
+Description:
+
+	Verifies that dividing a polynomial by one of a higher degree gives a
+	zero quotient and leaves the polynomial as the remainder.
+*/
+func TestDivideWithRemainder2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestDivideWithRemainder2")
+	x := symbolic.NewVariable(env)
+	p := x.Plus(1.0).(symbolic.Polynomial)
+	d := x.Power(2).Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	q, r := symbolic.DivideWithRemainder(p, d, x)
+	if !q.Equals(symbolic.K(0.0), 1e-12) {
+		t.Errorf("expected the quotient 0; received %v", q)
+	}
+
+	if !r.Equals(p, 1e-12) {
+		t.Errorf("expected the remainder %v; received %v", p, r)
+	}
+}
+
+/*
+TestDivideWithRemainder3
+Description:
+
+	Verifies that DivideWithRemainder panics with a UnivariatePolynomialRequiredError
+	when the polynomial contains a variable other than v.
+*/
+func TestDivideWithRemainder3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestDivideWithRemainder3")
+	x := symbolic.NewVariableVector(2, env)
+	p := x[0].Multiply(x[1]).Plus(1.0).(symbolic.Polynomial)
+	d := x[0].Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.UnivariatePolynomialRequiredError); !tf {
+			t.Errorf("expected a UnivariatePolynomialRequiredError; received %v", r)
+		}
+	}()
+
+	symbolic.DivideWithRemainder(p, d, x[0])
+}
+
+/*
+TestGCD1
+Description:
+
+	Verifies that the GCD of (x - 1)^2 (x + 2) and (x - 1)(x + 3) is x - 1.
+*/
+func TestGCD1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestGCD1")
+	x := symbolic.NewVariable(env)
+	p1 := x.Minus(1.0).Power(2).Multiply(x.Plus(2.0)).(symbolic.Polynomial)
+	p2 := x.Minus(1.0).Multiply(x.Plus(3.0)).Multiply(2.0).(symbolic.Polynomial)
+
+	// Test
+	gcd := symbolic.GCD(p1, p2, x)
+	if !gcd.Equals(x.Minus(1.0), 1e-9) {
+		t.Errorf("expected the GCD to be x - 1; received %v", gcd)
+	}
+}
+
+/*
+TestGCD2
+Description:
+
+	Verifies that the GCD of two coprime polynomials is 1.
+*/
+func TestGCD2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestGCD2")
+	x := symbolic.NewVariable(env)
+	p1 := x.Power(2).Plus(1.0).(symbolic.Polynomial)
+	p2 := x.Minus(2.0).(symbolic.Polynomial)
+
+	// Test
+	gcd := symbolic.GCD(p1, p2, x)
+	if !gcd.Equals(symbolic.K(1.0), 1e-9) {
+		t.Errorf("expected the GCD to be 1; received %v", gcd)
+	}
+}
+
+/*
+TestSquareFreeFactorization1
+Description:
+
+	Verifies that the square-free factorization of 3 (x + 1)(x - 2)^3 is
+	3 * (x + 1)^1 * (x - 2)^3.
+*/
+func TestSquareFreeFactorization1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSquareFreeFactorization1")
+	x := symbolic.NewVariable(env)
+	p := x.Plus(1.0).Multiply(x.Minus(2.0).Power(3)).Multiply(3.0).(symbolic.Polynomial)
+
+	// Test
+	lead, factors := symbolic.SquareFreeFactorization(p, x)
+	if lead != 3.0 {
+		t.Errorf("expected the leading coefficient 3; received %v", lead)
+	}
+
+	if len(factors) != 2 {
+		t.Fatalf("expected 2 factors; received %v", factors)
+	}
+
+	if factors[0].Multiplicity != 1 || !factors[0].Factor.Equals(x.Plus(1.0), 1e-9) {
+		t.Errorf("expected the first factor to be (x + 1)^1; received (%v)^%v", factors[0].Factor, factors[0].Multiplicity)
+	}
+
+	if factors[1].Multiplicity != 3 || !factors[1].Factor.Equals(x.Minus(2.0), 1e-9) {
+		t.Errorf("expected the second factor to be (x - 2)^3; received (%v)^%v", factors[1].Factor, factors[1].Multiplicity)
+	}
+}
+
+/*
+TestSquareFreeFactorization2
+Description:
+
+	Verifies that the product of the square-free factors (with their multiplicities)
+	reconstructs the polynomial x^2 (x^2 + 1)^2.
+*/
+func TestSquareFreeFactorization2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestSquareFreeFactorization2")
+	x := symbolic.NewVariable(env)
+	p := x.Power(2).Multiply(x.Power(2).Plus(1.0).Power(2)).(symbolic.Polynomial)
+
+	// Test
+	lead, factors := symbolic.SquareFreeFactorization(p, x)
+	var product symbolic.Expression = symbolic.K(lead)
+	for _, factor := range factors {
+		if factor.Multiplicity != 2 {
+			t.Errorf("expected every factor to have multiplicity 2; received (%v)^%v", factor.Factor, factor.Multiplicity)
+		}
+		product = product.Multiply(factor.Factor.Power(factor.Multiplicity))
+	}
+
+	if !product.Equals(p, 1e-9) {
+		t.Errorf("expected the product of the factors to be %v; received %v", p, product)
+	}
+}
+
+/*
+TestRationalRoots1
+Description:
+
+	Verifies that the rational roots of (2x - 1)(x + 3)^2 (x^2 + 1) are
+	-3, -3 and 1/2.
+*/
+func TestRationalRoots1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalRoots1")
+	x := symbolic.NewVariable(env)
+	p := x.Multiply(2.0).Minus(1.0).Multiply(x.Plus(3.0).Power(2)).Multiply(x.Power(2).Plus(1.0)).(symbolic.Polynomial)
+
+	// Test
+	roots := symbolic.RationalRoots(p, x)
+	expected := []float64{-3.0, -3.0, 0.5}
+	if len(roots) != len(expected) {
+		t.Fatalf("expected the roots %v; received %v", expected, roots)
+	}
+
+	for ii := range expected {
+		if roots[ii] != expected[ii] {
+			t.Errorf("expected root %v to be %v; received %v", ii, expected[ii], roots[ii])
+		}
+	}
+}
+
+/*
+TestRationalRoots2
+Description:
+
+	Verifies that the roots at zero are extracted and that a polynomial with
+	non-integer coefficients (0.5 x^2 - 0.125 x) is handled: its roots are 0 and 0.25.
+*/
+func TestRationalRoots2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRationalRoots2")
+	x := symbolic.NewVariable(env)
+	p := x.Power(2).Multiply(0.5).Minus(x.Multiply(0.125)).(symbolic.Polynomial)
+
+	// Test
+	roots := symbolic.RationalRoots(p, x)
+	expected := []float64{0.0, 0.25}
+	if len(roots) != len(expected) {
+		t.Fatalf("expected the roots %v; received %v", expected, roots)
+	}
+
+	for ii := range expected {
+		if roots[ii] != expected[ii] {
+			t.Errorf("expected root %v to be %v; received %v", ii, expected[ii], roots[ii])
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package symbolic_test
+
+/*
+univariate_roots_test.go
+Description:
+	Tests for the functions defined in symbolic/univariate_roots.go
+	(CompanionMatrix, Roots, CountRealRootsInInterval and RealRootsInInterval).
+*/
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+)
+
+/*
+TestCompanionMatrix1
+Description:
+
+	Verifies that the companion matrix of 2x^3 - 4x + 6 (whose monic form is
+	x^3 - 2x + 3) has ones on its subdiagonal and -3, 2, 0 in its last column.
+*/
+func TestCompanionMatrix1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestCompanionMatrix1")
+	x := symbolic.NewVariable(env)
+	p := x.Power(3).Multiply(2.0).Minus(x.Multiply(4.0)).Plus(6.0).(symbolic.Polynomial)
+
+	// Test
+	companion := symbolic.CompanionMatrix(p, x)
+	expected := [][]float64{
+		{0.0, 0.0, -3.0},
+		{1.0, 0.0, 2.0},
+		{0.0, 1.0, 0.0},
+	}
+	for ii := range expected {
+		for jj := range expected[ii] {
+			if companion.At(ii, jj) != expected[ii][jj] {
+				t.Errorf("expected the (%v,%v) element to be %v; received %v", ii, jj, expected[ii][jj], companion.At(ii, jj))
+			}
+		}
+	}
+}
+
+/*
+TestRoots1
+Description:
+
+	Verifies that the roots of (x - 1)(x + 2)(x^2 + 1) are -2, -i, i and 1
+	(in that order).
+*/
+func TestRoots1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRoots1")
+	x := symbolic.NewVariable(env)
+	p := x.Minus(1.0).Multiply(x.Plus(2.0)).Multiply(x.Power(2).Plus(1.0)).(symbolic.Polynomial)
+
+	// Test
+	roots := symbolic.Roots(p, x)
+	expected := []complex128{-2, -1i, 1i, 1}
+	if len(roots) != len(expected) {
+		t.Fatalf("expected the roots %v; received %v", expected, roots)
+	}
+
+	for ii := range expected {
+		if cmplx.Abs(roots[ii]-expected[ii]) > 1e-9 {
+			t.Errorf("expected root %v to be %v; received %v", ii, expected[ii], roots[ii])
+		}
+	}
+}
+
+/*
+TestRoots2
+Description:
+
+	Verifies that a constant polynomial has no roots.
+*/
+func TestRoots2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRoots2")
+	x := symbolic.NewVariable(env)
+	p := symbolic.K(3.0).ToPolynomial()
+
+	// Test
+	if roots := symbolic.Roots(p, x); len(roots) != 0 {
+		t.Errorf("expected no roots; received %v", roots)
+	}
+}
+
+/*
+TestRoots3
+Description:
+
+	Verifies that Roots panics with a UnivariatePolynomialRequiredError
+	when the polynomial contains a variable other than v.
+*/
+func TestRoots3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRoots3")
+	x := symbolic.NewVariableVector(2, env)
+	p := x[0].Power(2).Plus(x[1]).(symbolic.Polynomial)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.UnivariatePolynomialRequiredError); !tf {
+			t.Errorf("expected a UnivariatePolynomialRequiredError; received %v", r)
+		}
+	}()
+
+	symbolic.Roots(p, x[0])
+}
+
+/*
+TestCountRealRootsInInterval1
+Description:
+
+	Verifies that the distinct real roots of (x - 1)(x + 2)(x - 3)^2 are counted
+	(once each) on the whole real line and on the half-open interval (1, 3],
+	which excludes the root at 1 and includes the double root at 3.
+*/
+func TestCountRealRootsInInterval1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestCountRealRootsInInterval1")
+	x := symbolic.NewVariable(env)
+	p := x.Minus(1.0).Multiply(x.Plus(2.0)).Multiply(x.Minus(3.0).Power(2)).(symbolic.Polynomial)
+
+	// Test
+	if count := symbolic.CountRealRootsInInterval(p, x, math.Inf(-1), math.Inf(1)); count != 3 {
+		t.Errorf("expected 3 distinct real roots; received %v", count)
+	}
+
+	if count := symbolic.CountRealRootsInInterval(p, x, 1.0, 3.0); count != 1 {
+		t.Errorf("expected 1 root in (1, 3]; received %v", count)
+	}
+
+	if count := symbolic.CountRealRootsInInterval(p, x, 3.0, 1.0); count != 0 {
+		t.Errorf("expected no roots in an empty interval; received %v", count)
+	}
+}
+
+/*
+TestCountRealRootsInInterval2
+Description:
+
+	Verifies that x^2 + 1 has no real roots.
+*/
+func TestCountRealRootsInInterval2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestCountRealRootsInInterval2")
+	x := symbolic.NewVariable(env)
+	p := x.Power(2).Plus(1.0).(symbolic.Polynomial)
+
+	// Test
+	if count := symbolic.CountRealRootsInInterval(p, x, math.Inf(-1), math.Inf(1)); count != 0 {
+		t.Errorf("expected no real roots; received %v", count)
+	}
+}
+
+/*
+TestRealRootsInInterval1
+Description:
+
+	Verifies that the real roots of x^2 - 2 are found to full precision.
+*/
+func TestRealRootsInInterval1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRealRootsInInterval1")
+	x := symbolic.NewVariable(env)
+	p := x.Power(2).Minus(2.0).(symbolic.Polynomial)
+
+	// Test
+	roots := symbolic.RealRootsInInterval(p, x, -10.0, 10.0)
+	expected := []float64{-math.Sqrt2, math.Sqrt2}
+	if len(roots) != len(expected) {
+		t.Fatalf("expected the roots %v; received %v", expected, roots)
+	}
+
+	for ii := range expected {
+		if math.Abs(roots[ii]-expected[ii]) > 1e-15 {
+			t.Errorf("expected root %v to be %v; received %v", ii, expected[ii], roots[ii])
+		}
+	}
+}
+
+/*
+TestRealRootsInInterval2
+Description:
+
+	Verifies that the roots of the (ill-conditioned) polynomial (x - 1)(x - 2)...(x - 10)
+	in the interval (2, 5] are 3, 4 and 5.
+*/
+func TestRealRootsInInterval2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestRealRootsInInterval2")
+	x := symbolic.NewVariable(env)
+	var p symbolic.Expression = symbolic.K(1.0)
+	for ii := 1; ii <= 10; ii++ {
+		p = p.Multiply(x.Minus(float64(ii)))
+	}
+
+	// Test
+	roots := symbolic.RealRootsInInterval(p.(symbolic.Polynomial), x, 2.0, 5.0)
+	expected := []float64{3.0, 4.0, 5.0}
+	if len(roots) != len(expected) {
+		t.Fatalf("expected the roots %v; received %v", expected, roots)
+	}
+
+	for ii := range expected {
+		if math.Abs(roots[ii]-expected[ii]) > 1e-9 {
+			t.Errorf("expected root %v to be %v; received %v", ii, expected[ii], roots[ii])
+		}
+	}
+}
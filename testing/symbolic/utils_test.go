@@ -404,7 +404,7 @@ Description:
 */
 func TestUtils_CheckSubstitutionMap1(t *testing.T) {
 	// Constants
-	var currentEnv symbolic.Environment = &symbolic.DefaultEnvironment
+	var currentEnv symbolic.Environment = symbolic.DefaultEnvironment
 	badVar := symbolic.Variable{
 		ID:          2,
 		Lower:       -1,
@@ -450,7 +450,7 @@ Description:
 func TestUtils_CheckSubstitutionMap2(t *testing.T) {
 	// Constants
 	goodVar := symbolic.NewVariable()
-	var currentEnv symbolic.Environment = &symbolic.DefaultEnvironment
+	var currentEnv symbolic.Environment = symbolic.DefaultEnvironment
 	badVar := symbolic.Variable{
 		ID:          2,
 		Lower:       -1,
@@ -1345,3 +1345,28 @@ func TestVariableMatrix_Eq2(t *testing.T) {
 		t.Errorf("Expected Eq to return a MatrixConstraint; received %T", mc0)
 	}
 }
+
+/*
+TestVariableMatrix_EvaluateAt1
+Description:
+
+	Tests that the EvaluateAt() method of a variable matrix places the
+	values of each variable in the correct position of the output matrix.
+*/
+func TestVariableMatrix_EvaluateAt1(t *testing.T) {
+	// Constants
+	vm := symbolic.NewVariableMatrix(2, 2)
+	wrt := []symbolic.Variable{vm[0][0], vm[0][1], vm[1][0], vm[1][1]}
+
+	// Test
+	values := vm.EvaluateAt(*mat.NewVecDense(4, []float64{1, 2, 3, 4}), wrt)
+	expected := mat.NewDense(2, 2, []float64{1, 2, 3, 4})
+	if !mat.Equal(&values, expected) {
+		t.Errorf(
+			"expected %v.EvaluateAt() to return %v; received %v",
+			vm,
+			mat.Formatted(expected),
+			mat.Formatted(&values),
+		)
+	}
+}
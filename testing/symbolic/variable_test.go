@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
 	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
 	"gonum.org/v1/gonum/mat"
 )
@@ -1354,6 +1355,181 @@ func TestVariable_NewBinaryVariable2(t *testing.T) {
 	}
 }
 
+/*
+TestVariable_NewCustomVariable1
+Description:
+
+	Tests that the NewCustomVariable() method creates a variable with the
+	given type, bounds and name, and that the environment tracks it.
+*/
+func TestVariable_NewCustomVariable1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("test-env")
+	symbolic.NewVariable(env)
+	x := symbolic.NewCustomVariable(symbolic.Integer, -2.0, 5.0, "count", env)
+
+	// Test
+	if x.Type != symbolic.Integer || x.Lower != -2.0 || x.Upper != 5.0 || x.Name != "count" {
+		t.Errorf(
+			"expected %v to be an integer variable in [-2, 5] named \"count\"; received type %v, bounds [%v, %v], name %v",
+			x,
+			x.Type,
+			x.Lower,
+			x.Upper,
+			x.Name,
+		)
+	}
+
+	if x.ID != 1 {
+		t.Errorf(
+			"expected %v to have ID 1; received %v",
+			x,
+			x.ID,
+		)
+	}
+
+	trackedVars := env.AllTrackedVariables()
+	if len(trackedVars) != 2 || trackedVars[1].Name != "count" {
+		t.Errorf(
+			"expected the environment to track the new variable; received %v",
+			trackedVars,
+		)
+	}
+}
+
+/*
+TestVariable_NewCustomVariable2
+Description:
+
+	Tests that the NewCustomVariable() method panics when the lower bound
+	is above the upper bound.
+*/
+func TestVariable_NewCustomVariable2(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("test-env")
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf(
+				"expected NewCustomVariable() to panic; received nil",
+			)
+		}
+	}()
+
+	symbolic.NewCustomVariable(symbolic.Continuous, 1.0, 0.0, "x", env)
+}
+
+/*
+TestVariable_NewCustomVariable3
+Description:
+
+	Tests that the NewCustomVariable() method panics with a DuplicateVariableError
+	when the environment already has a variable with the same name.
+*/
+func TestVariable_NewCustomVariable3(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("test-env")
+	symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 1.0, "x", env)
+
+	// Test
+	defer func() {
+		r := recover()
+		if _, tf := r.(smErrors.DuplicateVariableError); !tf {
+			t.Errorf(
+				"expected NewCustomVariable() to panic with a DuplicateVariableError; received %v",
+				r,
+			)
+		}
+	}()
+
+	symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 1.0, "x", env)
+}
+
+/*
+TestVariable_NewCustomVariable4
+Description:
+
+	Tests that the NewCustomVariable() method accepts a fixed variable (with
+	equal lower and upper bounds) and that the fixed variable can be used in
+	expressions, while the zero Variable (which has no environment) is still
+	not well-defined.
+*/
+func TestVariable_NewCustomVariable4(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("test-env")
+	x := symbolic.NewCustomVariable(symbolic.Continuous, 1.5, 1.5, "x", env)
+
+	// Test
+	if err := x.Check(); err != nil {
+		t.Errorf("expected the fixed variable to be well-defined; received error %v", err)
+	}
+
+	if value := x.Plus(1.0).(symbolic.ScalarExpression).Evaluate(map[symbolic.Variable]float64{x: 1.5}); value != 2.5 {
+		t.Errorf("expected x + 1 to be 2.5; received %v", value)
+	}
+
+	if err := (symbolic.Variable{}).Check(); err == nil {
+		t.Errorf("expected the zero Variable to not be well-defined")
+	}
+}
+
+/*
+TestVariable_Check1
+Description:
+
+	Tests that the Check() method accepts fixed variables (with equal bounds)
+	whether or not they belong to an environment, and rejects variables whose
+	lower bound is above their upper bound (or the zero Variable).
+*/
+func TestVariable_Check1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("test-env")
+	fixedInEnv := symbolic.NewCustomVariable(symbolic.Continuous, 1.0, 1.0, "y", env)
+	fixedNoEnv := symbolic.Variable{ID: 3, Lower: 1, Upper: 1, Name: "z"}
+	inverted := symbolic.Variable{ID: 4, Lower: 2, Upper: 1, Name: "w"}
+
+	// Test
+	for _, v := range []symbolic.Variable{fixedInEnv, fixedNoEnv} {
+		if err := v.Check(); err != nil {
+			t.Errorf("expected the fixed variable %v to be well-defined; received error %v", v, err)
+		}
+	}
+
+	err := inverted.Check()
+	if err == nil || !strings.Contains(err.Error(), "lower bound (2) of variable must be less than upper bound (1)") {
+		t.Errorf("expected the variable %v to not be well-defined; received error %v", inverted, err)
+	}
+
+	if err := (symbolic.Variable{}).Check(); err == nil {
+		t.Errorf("expected the zero Variable to not be well-defined")
+	}
+}
+
+/*
+TestVariable_NewVariable1
+Description:
+
+	Tests that the NewVariable() method skips the IDs whose default name
+	(x_ID) is already used by a custom variable.
+*/
+func TestVariable_NewVariable1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("test-env")
+	custom := symbolic.NewCustomVariable(symbolic.Continuous, 0.0, 1.0, "x_1", env)
+
+	// Test
+	x := symbolic.NewVariable(env)
+	if x.Name == custom.Name {
+		t.Errorf("expected the new variable to have a different name than %v", custom)
+	}
+
+	if x.ID != 2 || x.Name != "x_2" {
+		t.Errorf("expected the new variable to be x_2 (with ID 2); received %v (with ID %v)", x, x.ID)
+	}
+}
+
 /*
 TestVariable_DerivativeWrt1
 Description:
@@ -1845,3 +2021,127 @@ func TestVariable_Power1(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestVariable_Evaluate1
+Description:
+
+	Tests that the Evaluate() method of a variable returns the
+	value given in the values map.
+*/
+func TestVariable_Evaluate1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	values := map[symbolic.Variable]float64{
+		x: 2.5,
+		y: -1.0,
+	}
+
+	// Test
+	if value := x.Evaluate(values); value != 2.5 {
+		t.Errorf(
+			"expected %v.Evaluate() to return 2.5; received %v",
+			x,
+			value,
+		)
+	}
+}
+
+/*
+TestVariable_Evaluate2
+Description:
+
+	Tests that the Evaluate() method of a variable panics with a
+	MissingVariableValueError when the variable is not in the values map.
+*/
+func TestVariable_Evaluate2(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+	values := map[symbolic.Variable]float64{
+		y: -1.0,
+	}
+
+	// Test
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Errorf("expected a panic when the variable is missing from the values map; received nil")
+		}
+
+		rAsE, tf := r.(smErrors.MissingVariableValueError)
+		if !tf {
+			t.Errorf("expected a panic of type MissingVariableValueError; received %T", r)
+		}
+
+		if rAsE.Variable.(symbolic.Variable).ID != x.ID {
+			t.Errorf(
+				"expected the missing variable to be %v; received %v",
+				x,
+				rAsE.Variable,
+			)
+		}
+	}()
+	x.Evaluate(values)
+	t.Errorf("Problem! The function did not panic when the variable was missing")
+}
+
+/*
+TestVariable_EvaluateAt1
+Description:
+
+	Tests that the EvaluateAt() method of a variable picks out the
+	correct element of the input vector when a slice of variables is given.
+*/
+func TestVariable_EvaluateAt1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariableVector(3)
+
+	// Test
+	value := x[1].EvaluateAt(
+		*mat.NewVecDense(3, []float64{1.0, 2.0, 3.0}),
+		x,
+	)
+	if value != 2.0 {
+		t.Errorf(
+			"expected %v.EvaluateAt() to return 2.0; received %v",
+			x[1],
+			value,
+		)
+	}
+}
+
+/*
+TestVariable_QuadraticRepresentation1
+Description:
+
+	Tests that the QuadraticRepresentation() method of a variable returns
+	a zero Q, a vector c with a single 1.0 and r = 0.
+*/
+func TestVariable_QuadraticRepresentation1(t *testing.T) {
+	// Constants
+	x := symbolic.NewVariable()
+	y := symbolic.NewVariable()
+
+	// Test
+	Q, c, r := y.QuadraticRepresentation([]symbolic.Variable{x, y})
+	if !mat.Equal(&Q, mat.NewDense(2, 2, nil)) {
+		t.Errorf(
+			"expected Q to be zero; received %v",
+			mat.Formatted(&Q),
+		)
+	}
+
+	if c.AtVec(0) != 0.0 || c.AtVec(1) != 1.0 {
+		t.Errorf(
+			"expected c to be [0, 1]; received [%v, %v]",
+			c.AtVec(0),
+			c.AtVec(1),
+		)
+	}
+
+	if r != 0.0 {
+		t.Errorf("expected r to be 0.0; received %v", r)
+	}
+}
@@ -1415,3 +1415,27 @@ func TestVectorConstraint_ImpliesThisIsAlsoSatisfied4(t *testing.T) {
 		)
 	}
 }
+
+/*
+TestVectorConstraint_IsConvex1
+Description:
+
+	Verifies that a vector constraint is convex when each of its elements is
+	convex, and not convex as soon as one of its elements is not.
+*/
+func TestVectorConstraint_IsConvex1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestVectorConstraint_IsConvex1")
+	x := symbolic.NewVariableVector(2, env)
+
+	// Test
+	convex := symbolic.VStack(x[0].Power(2), x[1]).(symbolic.VectorExpression).LessEq(symbolic.KVector{1.0, 1.0})
+	if !convex.IsConvex() {
+		t.Errorf("expected %v to be convex", convex)
+	}
+
+	nonConvex := symbolic.VStack(x[0].Power(2), x[1].Power(2)).(symbolic.VectorExpression).GreaterEq(symbolic.KVector{1.0, 1.0})
+	if nonConvex.IsConvex() {
+		t.Errorf("expected %v to not be convex", nonConvex)
+	}
+}
@@ -0,0 +1,187 @@
+package symbolic_test
+
+/*
+vector_range_constraint_test.go
+Description:
+	Tests for the functions mentioned in the vector_range_constraint.go file.
+*/
+
+import (
+	"testing"
+
+	"github.com/MatProGo-dev/SymbolicMath.go/smErrors"
+	"github.com/MatProGo-dev/SymbolicMath.go/symbolic"
+	"gonum.org/v1/gonum/mat"
+)
+
+/*
+TestVectorRangeConstraint_Between1
+Description:
+
+	Verifies that Between broadcasts a constant bound to all of the elements of
+	the vector expression and accepts a mat.VecDense as a bound.
+*/
+func TestVectorRangeConstraint_Between1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestVectorRangeConstraint_Between1")
+	x := symbolic.NewVariableVector(3, env)
+
+	// Test
+	c := x.Between(0.0, *mat.NewVecDense(3, []float64{1.0, 2.0, 3.0}))
+	vrc, tf := c.(symbolic.VectorRangeConstraint)
+	if !tf {
+		t.Fatalf("expected x.Between(...) to be a VectorRangeConstraint; received %T", c)
+	}
+
+	for ii := 0; ii < vrc.Len(); ii++ {
+		if vrc.Lower()[ii] != 0.0 || vrc.Upper()[ii] != symbolic.K(ii+1) {
+			t.Errorf(
+				"expected the bounds of element %v to be 0 and %v; received %v and %v",
+				ii, ii+1, vrc.Lower()[ii], vrc.Upper()[ii],
+			)
+		}
+	}
+
+	if element := vrc.AtVec(1); element.Expression.(symbolic.Variable).ID != x[1].ID || element.Upper() != 2.0 {
+		t.Errorf("expected element 1 to be 0 <= %v <= 2; received %v", x[1], element)
+	}
+}
+
+/*
+TestVectorRangeConstraint_Right1
+Description:
+
+	Verifies that the right hand side of 0 <= (x, y) <= (1, 2) is the matrix
+	whose columns are the lower and the upper bounds, and that its sense is SenseRange.
+*/
+func TestVectorRangeConstraint_Right1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestVectorRangeConstraint_Right1")
+	x := symbolic.NewVariableVector(2, env)
+	vrc := x.Between(0.0, symbolic.KVector{1.0, 2.0})
+
+	// Test
+	if vrc.ConstrSense() != symbolic.SenseRange {
+		t.Errorf("expected the sense of %v to be SenseRange; received %v", vrc, vrc.ConstrSense())
+	}
+
+	bounds, tf := vrc.Right().(symbolic.KMatrix)
+	if !tf {
+		t.Fatalf("expected the right hand side of %v to be a KMatrix; received %T", vrc, vrc.Right())
+	}
+
+	expected := symbolic.KMatrix{{0.0, 1.0}, {0.0, 2.0}}
+	for ii := range expected {
+		if bounds[ii][0] != expected[ii][0] || bounds[ii][1] != expected[ii][1] {
+			t.Errorf("expected the right hand side of %v to be %v; received %v", vrc, expected, bounds)
+		}
+	}
+}
+
+/*
+TestVectorRangeConstraint_Check1
+Description:
+
+	Verifies that Check returns a VectorDimensionError when the bounds do not
+	have the same length as the expression.
+*/
+func TestVectorRangeConstraint_Check1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestVectorRangeConstraint_Check1")
+	x := symbolic.NewVariableVector(3, env)
+	vrc := symbolic.VectorRangeConstraint{
+		Expression: x,
+		LowerBound: symbolic.KVector{0.0, 0.0},
+		UpperBound: symbolic.KVector{1.0, 1.0, 1.0},
+	}
+
+	// Test
+	err := vrc.Check()
+	if _, tf := err.(smErrors.VectorDimensionError); !tf {
+		t.Errorf("expected a VectorDimensionError; received %v", err)
+	}
+}
+
+/*
+TestVectorRangeConstraint_LinearRangeRepresentation1
+Description:
+
+	Verifies the linear representation of
+		(-1, 0) <= (x + y + 1, 2 y) <= (1, 4),
+	which is A = [[1, 1], [0, 2]], lo = (-2, 0) and hi = (0, 4).
+*/
+func TestVectorRangeConstraint_LinearRangeRepresentation1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestVectorRangeConstraint_LinearRangeRepresentation1")
+	x := symbolic.NewVariableVector(2, env)
+	e := symbolic.VStack(x[0].Plus(x[1]).Plus(1.0), x[1].Multiply(2.0)).(symbolic.VectorExpression)
+
+	// Test
+	vrc := e.Between(symbolic.KVector{-1.0, 0.0}, symbolic.KVector{1.0, 4.0}).(symbolic.VectorRangeConstraint)
+	A, lo, hi := vrc.LinearRangeRepresentation(x)
+
+	if expected := mat.NewDense(2, 2, []float64{1.0, 1.0, 0.0, 2.0}); !mat.EqualApprox(&A, expected, 1e-12) {
+		t.Errorf("expected A = %v; received %v", mat.Formatted(expected), mat.Formatted(&A))
+	}
+
+	if expected := mat.NewVecDense(2, []float64{-2.0, 0.0}); !mat.EqualApprox(&lo, expected, 1e-12) {
+		t.Errorf("expected lo = %v; received %v", mat.Formatted(expected), mat.Formatted(&lo))
+	}
+
+	if expected := mat.NewVecDense(2, []float64{0.0, 4.0}); !mat.EqualApprox(&hi, expected, 1e-12) {
+		t.Errorf("expected hi = %v; received %v", mat.Formatted(expected), mat.Formatted(&hi))
+	}
+}
+
+/*
+TestVectorRangeConstraint_SubstituteAccordingTo1
+Description:
+
+	Verifies that substituting x = 2 y in 0 <= (x, y) <= 1 gives a range
+	constraint that only contains y and keeps its bounds.
+*/
+func TestVectorRangeConstraint_SubstituteAccordingTo1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestVectorRangeConstraint_SubstituteAccordingTo1")
+	x := symbolic.NewVariableVector(2, env)
+	vrc := x.Between(0.0, 1.0)
+
+	// Test
+	substituted := vrc.SubstituteAccordingTo(
+		map[symbolic.Variable]symbolic.Expression{x[0]: x[1].Multiply(2.0)},
+	).(symbolic.VectorRangeConstraint)
+
+	if vars := substituted.Variables(); len(vars) != 1 || vars[0].ID != x[1].ID {
+		t.Errorf("expected the substituted constraint to only contain %v; received %v", x[1], vars)
+	}
+
+	if substituted.Lower()[0] != 0.0 || substituted.Upper()[1] != 1.0 {
+		t.Errorf("expected the bounds to be unchanged; received %v and %v", substituted.Lower(), substituted.Upper())
+	}
+}
+
+/*
+TestVectorRangeConstraint_ImpliesThisIsAlsoSatisfied1
+Description:
+
+	Verifies that 0 <= (x, y) <= 1 implies x <= 2 and 0 <= y <= 3, but not x >= 1.
+*/
+func TestVectorRangeConstraint_ImpliesThisIsAlsoSatisfied1(t *testing.T) {
+	// Constants
+	env := symbolic.NewBasicEnvironment("TestVectorRangeConstraint_ImpliesThisIsAlsoSatisfied1")
+	x := symbolic.NewVariableVector(2, env)
+	vrc := x.Between(0.0, 1.0)
+
+	// Test
+	if !vrc.ImpliesThisIsAlsoSatisfied(x[0].LessEq(2.0)) {
+		t.Errorf("expected %v to imply x <= 2", vrc)
+	}
+
+	if !vrc.ImpliesThisIsAlsoSatisfied(x[1].Between(0.0, 3.0)) {
+		t.Errorf("expected %v to imply 0 <= y <= 3", vrc)
+	}
+
+	if vrc.ImpliesThisIsAlsoSatisfied(x[0].GreaterEq(1.0)) {
+		t.Errorf("expected %v to not imply x >= 1", vrc)
+	}
+}